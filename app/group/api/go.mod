@@ -2,7 +2,10 @@ module SkyeIM/app/group/api
 
 go 1.25.4
 
-require github.com/zeromicro/go-zero v1.9.4
+require (
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
+	github.com/zeromicro/go-zero v1.9.4
+)
 
 require (
 	github.com/beorn7/perks v1.0.1 // indirect
@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"net/http"
+
+	"SkyeIM/app/group/api/internal/logic/announcement"
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 删除群公告
+func DeleteAnnouncementHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AnnouncementIdReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := announcement.NewDeleteAnnouncementLogic(r.Context(), svcCtx)
+		resp, err := l.DeleteAnnouncement(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
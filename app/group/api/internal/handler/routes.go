@@ -6,16 +6,74 @@ package handler
 import (
 	"net/http"
 
+	announcement "SkyeIM/app/group/api/internal/handler/announcement"
 	groupmgmt "SkyeIM/app/group/api/internal/handler/groupmgmt"
 	invitation "SkyeIM/app/group/api/internal/handler/invitation"
+	invitelink "SkyeIM/app/group/api/internal/handler/invitelink"
 	joinrequest "SkyeIM/app/group/api/internal/handler/joinrequest"
 	membermgmt "SkyeIM/app/group/api/internal/handler/membermgmt"
+	permission "SkyeIM/app/group/api/internal/handler/permission"
 	"SkyeIM/app/group/api/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 确认群公告
+				Method:  http.MethodPost,
+				Path:    "/announcement/confirm",
+				Handler: announcement.ConfirmAnnouncementHandler(serverCtx),
+			},
+			{
+				// 获取群公告确认情况
+				Method:  http.MethodGet,
+				Path:    "/announcement/confirmations",
+				Handler: announcement.GetAnnouncementConfirmationsHandler(serverCtx),
+			},
+			{
+				// 删除群公告
+				Method:  http.MethodPost,
+				Path:    "/announcement/delete",
+				Handler: announcement.DeleteAnnouncementHandler(serverCtx),
+			},
+			{
+				// 获取群公告编辑历史
+				Method:  http.MethodGet,
+				Path:    "/announcement/history",
+				Handler: announcement.GetAnnouncementHistoryHandler(serverCtx),
+			},
+			{
+				// 获取群公告列表
+				Method:  http.MethodGet,
+				Path:    "/announcement/list",
+				Handler: announcement.GetAnnouncementListHandler(serverCtx),
+			},
+			{
+				// 置顶/取消置顶群公告
+				Method:  http.MethodPost,
+				Path:    "/announcement/pin",
+				Handler: announcement.PinAnnouncementHandler(serverCtx),
+			},
+			{
+				// 发布群公告
+				Method:  http.MethodPost,
+				Path:    "/announcement/publish",
+				Handler: announcement.PublishAnnouncementHandler(serverCtx),
+			},
+			{
+				// 编辑群公告
+				Method:  http.MethodPost,
+				Path:    "/announcement/update",
+				Handler: announcement.UpdateAnnouncementHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/group"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -96,6 +154,49 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/group"),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 创建群邀请链接
+				Method:  http.MethodPost,
+				Path:    "/invite/link/create",
+				Handler: invitelink.CreateInviteLinkHandler(serverCtx),
+			},
+			{
+				// 按邀请码获取邀请链接和群组信息
+				Method:  http.MethodGet,
+				Path:    "/invite/link/info",
+				Handler: invitelink.GetInviteLinkHandler(serverCtx),
+			},
+			{
+				// 通过邀请链接入群
+				Method:  http.MethodPost,
+				Path:    "/invite/link/join",
+				Handler: invitelink.RedeemInviteLinkHandler(serverCtx),
+			},
+			{
+				// 获取群邀请链接列表
+				Method:  http.MethodGet,
+				Path:    "/invite/link/list",
+				Handler: invitelink.GetInviteLinksHandler(serverCtx),
+			},
+			{
+				// 获取邀请链接二维码（PNG 图片）
+				Method:  http.MethodGet,
+				Path:    "/invite/link/qrcode",
+				Handler: invitelink.GetInviteLinkQrCodeHandler(serverCtx),
+			},
+			{
+				// 撤销群邀请链接
+				Method:  http.MethodPost,
+				Path:    "/invite/link/revoke",
+				Handler: invitelink.RevokeInviteLinkHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/group"),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -104,6 +205,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/join/handle",
 				Handler: joinrequest.HandleJoinRequestHandler(serverCtx),
 			},
+			{
+				// 设置入群方式
+				Method:  http.MethodPost,
+				Path:    "/join/policy",
+				Handler: joinrequest.SetJoinPolicyHandler(serverCtx),
+			},
+			{
+				// 获取入群设置
+				Method:  http.MethodGet,
+				Path:    "/join/policy",
+				Handler: joinrequest.GetJoinPolicyHandler(serverCtx),
+			},
 			{
 				// 获取所有管理群组的入群申请
 				Method:  http.MethodGet,
@@ -165,6 +278,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/member/role",
 				Handler: membermgmt.SetMemberRoleHandler(serverCtx),
 			},
+			{
+				// 设置全员禁言
+				Method:  http.MethodPost,
+				Path:    "/mute/all",
+				Handler: membermgmt.SetGroupMuteAllHandler(serverCtx),
+			},
+			{
+				// 转让群主
+				Method:  http.MethodPost,
+				Path:    "/owner/transfer",
+				Handler: membermgmt.TransferOwnershipHandler(serverCtx),
+			},
 			{
 				// 退出群组
 				Method:  http.MethodPost,
@@ -181,4 +306,23 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/group"),
 	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 设置群权限（仅群主）
+				Method:  http.MethodPost,
+				Path:    "/permission",
+				Handler: permission.SetGroupPermissionsHandler(serverCtx),
+			},
+			{
+				// 获取群权限配置和当前用户的有效权限
+				Method:  http.MethodGet,
+				Path:    "/permission",
+				Handler: permission.GetGroupPermissionsHandler(serverCtx),
+			},
+		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/api/v1/group"),
+	)
 }
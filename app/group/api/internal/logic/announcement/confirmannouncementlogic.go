@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmAnnouncementLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 确认群公告
+func NewConfirmAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmAnnouncementLogic {
+	return &ConfirmAnnouncementLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ConfirmAnnouncementLogic) ConfirmAnnouncement(req *types.AnnouncementIdReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	_, err = l.svcCtx.GroupRpc.ConfirmAnnouncement(l.ctx, &groupclient.ConfirmAnnouncementReq{
+		AnnouncementId: req.AnnouncementId,
+		UserId:         uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "已确认",
+	}, nil
+}
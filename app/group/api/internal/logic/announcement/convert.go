@@ -0,0 +1,23 @@
+package announcement
+
+import (
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+)
+
+// toAnnouncementInfo 群公告 RPC 结构转换为 API 返回结构
+func toAnnouncementInfo(a *groupclient.AnnouncementInfo) types.AnnouncementInfo {
+	return types.AnnouncementInfo{
+		Id:             a.Id,
+		GroupId:        a.GroupId,
+		AuthorId:       a.AuthorId,
+		EditorId:       a.EditorId,
+		Content:        a.Content,
+		RequireConfirm: a.RequireConfirm,
+		Pinned:         a.Pinned,
+		Version:        a.Version,
+		Confirmed:      a.Confirmed,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
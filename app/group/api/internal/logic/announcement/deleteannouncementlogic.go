@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteAnnouncementLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除群公告
+func NewDeleteAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAnnouncementLogic {
+	return &DeleteAnnouncementLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteAnnouncementLogic) DeleteAnnouncement(req *types.AnnouncementIdReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	_, err = l.svcCtx.GroupRpc.DeleteAnnouncement(l.ctx, &groupclient.DeleteAnnouncementReq{
+		AnnouncementId: req.AnnouncementId,
+		OperatorId:     uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "删除成功",
+	}, nil
+}
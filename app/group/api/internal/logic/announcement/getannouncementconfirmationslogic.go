@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAnnouncementConfirmationsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群公告确认情况
+func NewGetAnnouncementConfirmationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnnouncementConfirmationsLogic {
+	return &GetAnnouncementConfirmationsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAnnouncementConfirmationsLogic) GetAnnouncementConfirmations(req *types.AnnouncementQueryReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.GetAnnouncementConfirmations(l.ctx, &groupclient.GetAnnouncementConfirmationsReq{
+		AnnouncementId: req.AnnouncementId,
+		OperatorId:     uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed := make([]types.AnnouncementConfirmInfo, 0, len(rpcResp.Confirmed))
+	for _, v := range rpcResp.Confirmed {
+		confirmed = append(confirmed, types.AnnouncementConfirmInfo{
+			UserId:      v.UserId,
+			ConfirmedAt: v.ConfirmedAt,
+		})
+	}
+	unconfirmed := rpcResp.UnconfirmedIds
+	if unconfirmed == nil {
+		unconfirmed = []int64{}
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "查询成功",
+		Data: types.GetAnnouncementConfirmationsResp{
+			Version:        rpcResp.Version,
+			Confirmed:      confirmed,
+			UnconfirmedIds: unconfirmed,
+		},
+	}, nil
+}
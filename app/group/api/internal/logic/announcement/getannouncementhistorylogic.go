@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAnnouncementHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群公告编辑历史
+func NewGetAnnouncementHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnnouncementHistoryLogic {
+	return &GetAnnouncementHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAnnouncementHistoryLogic) GetAnnouncementHistory(req *types.AnnouncementQueryReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.GetAnnouncementHistory(l.ctx, &groupclient.GetAnnouncementHistoryReq{
+		AnnouncementId: req.AnnouncementId,
+		UserId:         uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.AnnouncementVersion, 0, len(rpcResp.List))
+	for _, v := range rpcResp.List {
+		list = append(list, types.AnnouncementVersion{
+			Version:   v.Version,
+			EditorId:  v.EditorId,
+			Content:   v.Content,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "查询成功",
+		Data:    types.GetAnnouncementHistoryResp{List: list},
+	}, nil
+}
@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAnnouncementListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群公告列表
+func NewGetAnnouncementListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnnouncementListLogic {
+	return &GetAnnouncementListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAnnouncementListLogic) GetAnnouncementList(req *types.GetAnnouncementListReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.GetAnnouncementList(l.ctx, &groupclient.GetAnnouncementListReq{
+		GroupId:  req.GroupId,
+		UserId:   uid,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.AnnouncementInfo, 0, len(rpcResp.List))
+	for _, v := range rpcResp.List {
+		list = append(list, toAnnouncementInfo(v))
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "查询成功",
+		Data: types.GetAnnouncementListResp{
+			List:  list,
+			Total: rpcResp.Total,
+		},
+	}, nil
+}
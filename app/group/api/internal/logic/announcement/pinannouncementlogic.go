@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PinAnnouncementLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 置顶/取消置顶群公告
+func NewPinAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PinAnnouncementLogic {
+	return &PinAnnouncementLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PinAnnouncementLogic) PinAnnouncement(req *types.PinAnnouncementReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	_, err = l.svcCtx.GroupRpc.PinAnnouncement(l.ctx, &groupclient.PinAnnouncementReq{
+		AnnouncementId: req.AnnouncementId,
+		OperatorId:     uid,
+		Pinned:         req.Pinned,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := "已取消置顶"
+	if req.Pinned {
+		message = "已置顶"
+	}
+	return &types.Response{
+		Code:    0,
+		Message: message,
+	}, nil
+}
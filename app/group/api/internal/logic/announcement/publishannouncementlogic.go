@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublishAnnouncementLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 发布群公告
+func NewPublishAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishAnnouncementLogic {
+	return &PublishAnnouncementLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PublishAnnouncementLogic) PublishAnnouncement(req *types.PublishAnnouncementReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.PublishAnnouncement(l.ctx, &groupclient.PublishAnnouncementReq{
+		GroupId:        req.GroupId,
+		OperatorId:     uid,
+		Content:        req.Content,
+		RequireConfirm: req.RequireConfirm,
+		Pin:            req.Pin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "发布成功",
+		Data:    toAnnouncementInfo(rpcResp.Announcement),
+	}, nil
+}
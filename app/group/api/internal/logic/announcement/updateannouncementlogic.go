@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package announcement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateAnnouncementLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 编辑群公告
+func NewUpdateAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateAnnouncementLogic {
+	return &UpdateAnnouncementLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateAnnouncementLogic) UpdateAnnouncement(req *types.UpdateAnnouncementReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.UpdateAnnouncement(l.ctx, &groupclient.UpdateAnnouncementReq{
+		AnnouncementId: req.AnnouncementId,
+		OperatorId:     uid,
+		Content:        req.Content,
+		RequireConfirm: req.RequireConfirm,
+		Version:        req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "编辑成功",
+		Data:    toAnnouncementInfo(rpcResp.Announcement),
+	}, nil
+}
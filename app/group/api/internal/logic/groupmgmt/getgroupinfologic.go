@@ -53,16 +53,20 @@ func (l *GetGroupInfoLogic) GetGroupInfo(req *types.GetGroupInfoReq) (resp *type
 		Code:    0,
 		Message: "获取成功",
 		Data: types.GroupInfo{
-			GroupId:     rpcRes.Group.GroupId,
-			Name:        rpcRes.Group.Name,
-			Avatar:      rpcRes.Group.Avatar,
-			OwnerId:     rpcRes.Group.OwnerId,
-			Description: rpcRes.Group.Description,
-			MaxMembers:  rpcRes.Group.MaxMembers,
-			MemberCount: rpcRes.Group.MemberCount,
-			Status:      rpcRes.Group.Status,
-			CreatedAt:   rpcRes.Group.CreatedAt,
-			UpdatedAt:   rpcRes.Group.UpdatedAt,
+			GroupId:      rpcRes.Group.GroupId,
+			Name:         rpcRes.Group.Name,
+			Avatar:       rpcRes.Group.Avatar,
+			OwnerId:      rpcRes.Group.OwnerId,
+			Description:  rpcRes.Group.Description,
+			MaxMembers:   rpcRes.Group.MaxMembers,
+			MemberCount:  rpcRes.Group.MemberCount,
+			Status:       rpcRes.Group.Status,
+			MuteAll:      rpcRes.Group.MuteAll,
+			JoinPolicy:   rpcRes.Group.JoinPolicy,
+			JoinQuestion: rpcRes.Group.JoinQuestion,
+			Discoverable: rpcRes.Group.Discoverable,
+			CreatedAt:    rpcRes.Group.CreatedAt,
+			UpdatedAt:    rpcRes.Group.UpdatedAt,
 		},
 	}, nil
 }
@@ -46,16 +46,20 @@ func (l *GetGroupListLogic) GetGroupList(req *types.GetGroupListReq) (resp *type
 	list := make([]types.GroupInfo, 0, len(rpcRes.Groups))
 	for _, v := range rpcRes.Groups {
 		list = append(list, types.GroupInfo{
-			GroupId:     v.GroupId,
-			Name:        v.Name,
-			Avatar:      v.Avatar,
-			OwnerId:     v.OwnerId,
-			Description: v.Description,
-			MaxMembers:  v.MaxMembers,
-			MemberCount: v.MemberCount,
-			Status:      v.Status,
-			CreatedAt:   v.CreatedAt,
-			UpdatedAt:   v.UpdatedAt,
+			GroupId:      v.GroupId,
+			Name:         v.Name,
+			Avatar:       v.Avatar,
+			OwnerId:      v.OwnerId,
+			Description:  v.Description,
+			MaxMembers:   v.MaxMembers,
+			MemberCount:  v.MemberCount,
+			Status:       v.Status,
+			MuteAll:      v.MuteAll,
+			JoinPolicy:   v.JoinPolicy,
+			JoinQuestion: v.JoinQuestion,
+			Discoverable: v.Discoverable,
+			CreatedAt:    v.CreatedAt,
+			UpdatedAt:    v.UpdatedAt,
 		})
 	}
 
@@ -40,16 +40,20 @@ func (l *SearchGroupLogic) SearchGroup(req *types.SearchGroupReq) (resp *types.R
 	var list []types.GroupInfo
 	for _, v := range rpcRes.Groups {
 		list = append(list, types.GroupInfo{
-			GroupId:     v.GroupId,
-			Name:        v.Name,
-			Avatar:      v.Avatar,
-			OwnerId:     v.OwnerId,
-			Description: v.Description,
-			MaxMembers:  v.MaxMembers,
-			MemberCount: v.MemberCount,
-			Status:      v.Status,
-			CreatedAt:   v.CreatedAt,
-			UpdatedAt:   v.UpdatedAt,
+			GroupId:      v.GroupId,
+			Name:         v.Name,
+			Avatar:       v.Avatar,
+			OwnerId:      v.OwnerId,
+			Description:  v.Description,
+			MaxMembers:   v.MaxMembers,
+			MemberCount:  v.MemberCount,
+			Status:       v.Status,
+			MuteAll:      v.MuteAll,
+			JoinPolicy:   v.JoinPolicy,
+			JoinQuestion: v.JoinQuestion,
+			Discoverable: v.Discoverable,
+			CreatedAt:    v.CreatedAt,
+			UpdatedAt:    v.UpdatedAt,
 		})
 	}
 
@@ -47,7 +47,10 @@ func (l *SearchGroupPreciseLogic) SearchGroupPrecise(req *types.SearchGroupPreci
 		UserId:  uid,
 	})
 	if err == nil && rpcRes.Group != nil {
-		groupInfo = rpcRes.Group
+		// 不可被搜索的群组不通过精确搜索返回（模糊搜索已在RPC中过滤）
+		if rpcRes.Group.Discoverable {
+			groupInfo = rpcRes.Group
+		}
 	} else {
 		searchRes, searchErr := l.svcCtx.GroupRpc.SearchGroup(l.ctx, &groupclient.SearchGroupReq{
 			Keyword: req.GroupId,
@@ -73,16 +76,20 @@ func (l *SearchGroupPreciseLogic) SearchGroupPrecise(req *types.SearchGroupPreci
 		Code:    0,
 		Message: "success",
 		Data: types.GroupInfo{
-			GroupId:     groupInfo.GroupId,
-			Name:        groupInfo.Name,
-			Avatar:      groupInfo.Avatar,
-			OwnerId:     groupInfo.OwnerId,
-			Description: groupInfo.Description,
-			MaxMembers:  groupInfo.MaxMembers,
-			MemberCount: groupInfo.MemberCount,
-			Status:      groupInfo.Status,
-			CreatedAt:   groupInfo.CreatedAt,
-			UpdatedAt:   groupInfo.UpdatedAt,
+			GroupId:      groupInfo.GroupId,
+			Name:         groupInfo.Name,
+			Avatar:       groupInfo.Avatar,
+			OwnerId:      groupInfo.OwnerId,
+			Description:  groupInfo.Description,
+			MaxMembers:   groupInfo.MaxMembers,
+			MemberCount:  groupInfo.MemberCount,
+			Status:       groupInfo.Status,
+			MuteAll:      groupInfo.MuteAll,
+			JoinPolicy:   groupInfo.JoinPolicy,
+			JoinQuestion: groupInfo.JoinQuestion,
+			Discoverable: groupInfo.Discoverable,
+			CreatedAt:    groupInfo.CreatedAt,
+			UpdatedAt:    groupInfo.UpdatedAt,
 		},
 	}, nil
 }
@@ -0,0 +1,23 @@
+package invitelink
+
+import (
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+)
+
+// toInviteLinkInfo 邀请链接 RPC 结构转换为 API 返回结构
+func toInviteLinkInfo(link *groupclient.InviteLinkInfo) types.InviteLinkInfo {
+	return types.InviteLinkInfo{
+		Id:          link.Id,
+		Code:        link.Code,
+		Url:         link.Url,
+		GroupId:     link.GroupId,
+		CreatorId:   link.CreatorId,
+		ExpireAt:    link.ExpireAt,
+		MaxUses:     link.MaxUses,
+		UseCount:    link.UseCount,
+		AutoApprove: link.AutoApprove,
+		Status:      link.Status,
+		CreatedAt:   link.CreatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package invitelink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateInviteLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建群邀请链接
+func NewCreateInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateInviteLinkLogic {
+	return &CreateInviteLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateInviteLinkLogic) CreateInviteLink(req *types.CreateInviteLinkReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.CreateInviteLink(l.ctx, &groupclient.CreateInviteLinkReq{
+		GroupId:       req.GroupId,
+		OperatorId:    uid,
+		ExpireSeconds: req.ExpireSeconds,
+		MaxUses:       req.MaxUses,
+		AutoApprove:   req.AutoApprove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "创建成功",
+		Data:    toInviteLinkInfo(rpcResp.Link),
+	}, nil
+}
@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package invitelink
+
+import (
+	"context"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetInviteLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 按邀请码获取邀请链接和群组信息
+func NewGetInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetInviteLinkLogic {
+	return &GetInviteLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetInviteLinkLogic) GetInviteLink(req *types.InviteLinkCodeReq) (resp *types.Response, err error) {
+	rpcResp, err := l.svcCtx.GroupRpc.GetInviteLink(l.ctx, &groupclient.GetInviteLinkReq{
+		Code: req.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "查询成功",
+		Data: types.GetInviteLinkResp{
+			Link: toInviteLinkInfo(rpcResp.Link),
+			Group: types.GroupInfo{
+				GroupId:      rpcResp.Group.GroupId,
+				Name:         rpcResp.Group.Name,
+				Avatar:       rpcResp.Group.Avatar,
+				OwnerId:      rpcResp.Group.OwnerId,
+				Description:  rpcResp.Group.Description,
+				MaxMembers:   rpcResp.Group.MaxMembers,
+				MemberCount:  rpcResp.Group.MemberCount,
+				Status:       rpcResp.Group.Status,
+				MuteAll:      rpcResp.Group.MuteAll,
+				JoinPolicy:   rpcResp.Group.JoinPolicy,
+				JoinQuestion: rpcResp.Group.JoinQuestion,
+				Discoverable: rpcResp.Group.Discoverable,
+				CreatedAt:    rpcResp.Group.CreatedAt,
+				UpdatedAt:    rpcResp.Group.UpdatedAt,
+			},
+		},
+	}, nil
+}
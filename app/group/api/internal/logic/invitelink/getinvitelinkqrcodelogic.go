@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package invitelink
+
+import (
+	"context"
+	"errors"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/skip2/go-qrcode"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// qrCodeSize 邀请链接二维码图片边长（像素）
+const qrCodeSize = 256
+
+// inviteLinkStatusValid 邀请链接有效状态
+const inviteLinkStatusValid = 1
+
+type GetInviteLinkQrCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取邀请链接二维码（PNG 图片）
+func NewGetInviteLinkQrCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetInviteLinkQrCodeLogic {
+	return &GetInviteLinkQrCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetInviteLinkQrCode 生成邀请链接二维码（PNG），二维码内容为分享链接，已失效的链接不生成
+func (l *GetInviteLinkQrCodeLogic) GetInviteLinkQrCode(req *types.InviteLinkCodeReq) ([]byte, error) {
+	rpcResp, err := l.svcCtx.GroupRpc.GetInviteLink(l.ctx, &groupclient.GetInviteLinkReq{
+		Code: req.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rpcResp.Link.Status != inviteLinkStatusValid {
+		return nil, errors.New("邀请链接已失效")
+	}
+
+	return qrcode.Encode(rpcResp.Link.Url, qrcode.Medium, qrCodeSize)
+}
@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package invitelink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetInviteLinksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群邀请链接列表
+func NewGetInviteLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetInviteLinksLogic {
+	return &GetInviteLinksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetInviteLinksLogic) GetInviteLinks(req *types.GetInviteLinksReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.GetInviteLinks(l.ctx, &groupclient.GetInviteLinksReq{
+		GroupId:    req.GroupId,
+		OperatorId: uid,
+		CreatorId:  req.CreatorId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.InviteLinkInfo, 0, len(rpcResp.List))
+	for _, v := range rpcResp.List {
+		list = append(list, toInviteLinkInfo(v))
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "查询成功",
+		Data: types.GetInviteLinksResp{
+			List:  list,
+			Total: rpcResp.Total,
+		},
+	}, nil
+}
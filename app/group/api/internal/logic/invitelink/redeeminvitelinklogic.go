@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package invitelink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RedeemInviteLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 通过邀请链接入群
+func NewRedeemInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeemInviteLinkLogic {
+	return &RedeemInviteLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RedeemInviteLinkLogic) RedeemInviteLink(req *types.RedeemInviteLinkReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.RedeemInviteLink(l.ctx, &groupclient.RedeemInviteLinkReq{
+		Code:    req.Code,
+		UserId:  uid,
+		Message: req.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := "申请已提交，等待管理员审核"
+	if rpcResp.Joined {
+		message = "入群成功"
+	}
+	return &types.Response{
+		Code:    0,
+		Message: message,
+		Data: types.RedeemInviteLinkResp{
+			GroupId:   rpcResp.GroupId,
+			Joined:    rpcResp.Joined,
+			RequestId: rpcResp.RequestId,
+		},
+	}, nil
+}
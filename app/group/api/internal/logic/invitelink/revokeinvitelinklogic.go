@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package invitelink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeInviteLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 撤销群邀请链接
+func NewRevokeInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeInviteLinkLogic {
+	return &RevokeInviteLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeInviteLinkLogic) RevokeInviteLink(req *types.RevokeInviteLinkReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	_, err = l.svcCtx.GroupRpc.RevokeInviteLink(l.ctx, &groupclient.RevokeInviteLinkReq{
+		LinkId:     req.LinkId,
+		OperatorId: uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "撤销成功",
+	}, nil
+}
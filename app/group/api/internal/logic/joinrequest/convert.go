@@ -0,0 +1,16 @@
+package joinrequest
+
+import (
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/group"
+)
+
+// toJoinPolicyInfo 入群设置 RPC 结构转换为 API 返回结构
+func toJoinPolicyInfo(policy *group.JoinPolicyInfo) types.JoinPolicyInfo {
+	return types.JoinPolicyInfo{
+		JoinPolicy:   policy.JoinPolicy,
+		JoinQuestion: policy.JoinQuestion,
+		JoinAnswer:   policy.JoinAnswer,
+		Discoverable: policy.Discoverable,
+	}
+}
@@ -62,6 +62,7 @@ func (l *GetGroupJoinRequestsLogic) GetGroupJoinRequests(req *types.GetJoinReque
 			GroupId:   item.GroupId,
 			UserId:    item.UserId,
 			Message:   item.Message,
+			Answer:    item.Answer,
 			Status:    item.Status,
 			HandlerId: item.HandlerId,
 			CreatedAt: item.CreatedAt,
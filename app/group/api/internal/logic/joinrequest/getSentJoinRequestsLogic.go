@@ -61,6 +61,7 @@ func (l *GetSentJoinRequestsLogic) GetSentJoinRequests(req *types.GetJoinRequest
 			GroupId:   item.GroupId,
 			UserId:    item.UserId,
 			Message:   item.Message,
+			Answer:    item.Answer,
 			Status:    item.Status,
 			HandlerId: item.HandlerId,
 			CreatedAt: item.CreatedAt,
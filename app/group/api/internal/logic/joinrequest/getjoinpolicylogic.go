@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package joinrequest
+
+import (
+	"context"
+	"encoding/json"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/group"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetJoinPolicyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取入群设置
+func NewGetJoinPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetJoinPolicyLogic {
+	return &GetJoinPolicyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetJoinPolicyLogic) GetJoinPolicy(req *types.GetJoinPolicyReq) (resp *types.Response, err error) {
+	// 提取JWT中的用户ID
+	userId, err := l.ctx.Value("userId").(json.Number).Int64()
+	if err != nil {
+		return &types.Response{
+			Code:    401,
+			Message: "未授权",
+		}, nil
+	}
+
+	// 调用RPC
+	rpcResp, err := l.svcCtx.GroupRpc.GetJoinPolicy(l.ctx, &group.GetJoinPolicyReq{
+		GroupId:    req.GroupId,
+		OperatorId: userId,
+	})
+
+	if err != nil {
+		return &types.Response{
+			Code:    500,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &types.Response{
+		Code:    200,
+		Message: "查询成功",
+		Data:    toJoinPolicyInfo(rpcResp.Policy),
+	}, nil
+}
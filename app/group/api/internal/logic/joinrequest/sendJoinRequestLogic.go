@@ -44,6 +44,7 @@ func (l *SendJoinRequestLogic) SendJoinRequest(req *types.SendJoinRequestReq) (r
 		GroupId: req.GroupId,
 		UserId:  userId,
 		Message: req.Message,
+		Answer:  req.Answer,
 	})
 
 	if err != nil {
@@ -53,11 +54,17 @@ func (l *SendJoinRequestLogic) SendJoinRequest(req *types.SendJoinRequestReq) (r
 		}, nil
 	}
 
+	message := "申请已发送"
+	if rpcResp.Joined {
+		message = "入群成功"
+	}
+
 	return &types.Response{
 		Code:    200,
-		Message: "申请已发送",
+		Message: message,
 		Data: types.SendJoinRequestResp{
 			RequestId: rpcResp.RequestId,
+			Joined:    rpcResp.Joined,
 		},
 	}, nil
 }
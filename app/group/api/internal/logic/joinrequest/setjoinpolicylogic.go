@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package joinrequest
+
+import (
+	"context"
+	"encoding/json"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/group"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetJoinPolicyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 设置入群方式
+func NewSetJoinPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetJoinPolicyLogic {
+	return &SetJoinPolicyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetJoinPolicyLogic) SetJoinPolicy(req *types.SetJoinPolicyReq) (resp *types.Response, err error) {
+	// 提取JWT中的用户ID
+	userId, err := l.ctx.Value("userId").(json.Number).Int64()
+	if err != nil {
+		return &types.Response{
+			Code:    401,
+			Message: "未授权",
+		}, nil
+	}
+
+	// 调用RPC
+	rpcResp, err := l.svcCtx.GroupRpc.SetJoinPolicy(l.ctx, &group.SetJoinPolicyReq{
+		GroupId:    req.GroupId,
+		OperatorId: userId,
+		Policy: &group.JoinPolicyInfo{
+			JoinPolicy:   req.JoinPolicy,
+			JoinQuestion: req.JoinQuestion,
+			JoinAnswer:   req.JoinAnswer,
+			Discoverable: req.Discoverable,
+		},
+	})
+
+	if err != nil {
+		return &types.Response{
+			Code:    500,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &types.Response{
+		Code:    200,
+		Message: "设置成功",
+		Data:    toJoinPolicyInfo(rpcResp.Policy),
+	}, nil
+}
@@ -49,14 +49,15 @@ func (l *GetMemberListLogic) GetMemberList(req *types.GetMemberListReq) (resp *t
 	for _, v := range rpcRes.Members {
 		joinedAt := time.Unix(v.JoinedAt, 0).Format("2006-01-02 15:04:05")
 		list = append(list, types.MemberInfo{
-			UserId:   v.UserId,
-			Nickname: v.Nickname,
-			Avatar:   "",
-			Role:     v.Role,
-			Mute:     v.Mute,
-			JoinTime: v.JoinedAt,
-			JoinedAt: joinedAt,
-			ReadSeq:  v.ReadSeq,
+			UserId:    v.UserId,
+			Nickname:  v.Nickname,
+			Avatar:    "",
+			Role:      v.Role,
+			Mute:      v.Mute,
+			MuteUntil: v.MuteUntil,
+			JoinTime:  v.JoinedAt,
+			JoinedAt:  joinedAt,
+			ReadSeq:   v.ReadSeq,
 		})
 	}
 
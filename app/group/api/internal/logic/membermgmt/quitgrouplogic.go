@@ -34,9 +34,10 @@ func (l *QuitGroupLogic) QuitGroup(req *types.QuitGroupReq) (resp *types.Respons
 	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
 	uid, _ := userId.Int64()
 
-	_, err = l.svcCtx.GroupRpc.QuitGroup(l.ctx, &groupclient.QuitGroupReq{
-		GroupId: req.GroupId,
-		UserId:  uid,
+	rpcResp, err := l.svcCtx.GroupRpc.QuitGroup(l.ctx, &groupclient.QuitGroupReq{
+		GroupId:      req.GroupId,
+		UserId:       uid,
+		AutoTransfer: req.AutoTransfer,
 	})
 	if err != nil {
 		return nil, err
@@ -45,5 +46,8 @@ func (l *QuitGroupLogic) QuitGroup(req *types.QuitGroupReq) (resp *types.Respons
 	return &types.Response{
 		Code:    0,
 		Message: "success",
+		Data: types.QuitGroupResp{
+			NewOwnerId: rpcResp.NewOwnerId,
+		},
 	}, nil
 }
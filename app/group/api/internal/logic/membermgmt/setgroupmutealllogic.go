@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package membermgmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetGroupMuteAllLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 设置全员禁言
+func NewSetGroupMuteAllLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetGroupMuteAllLogic {
+	return &SetGroupMuteAllLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetGroupMuteAllLogic) SetGroupMuteAll(req *types.SetGroupMuteAllReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	_, err = l.svcCtx.GroupRpc.SetGroupMuteAll(l.ctx, &groupclient.SetGroupMuteAllReq{
+		GroupId:    req.GroupId,
+		OperatorId: uid,
+		MuteAll:    req.MuteAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := "已关闭全员禁言"
+	if req.MuteAll == 1 {
+		message = "已开启全员禁言"
+	}
+	return &types.Response{
+		Code:    0,
+		Message: message,
+	}, nil
+}
@@ -33,11 +33,12 @@ func (l *SetMemberMuteLogic) SetMemberMute(req *types.SetMemberMuteReq) (resp *t
 	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
 	uid, _ := userId.Int64()
 
-	_, err = l.svcCtx.GroupRpc.SetMemberMute(l.ctx, &groupclient.SetMemberMuteReq{
+	rpcResp, err := l.svcCtx.GroupRpc.SetMemberMute(l.ctx, &groupclient.SetMemberMuteReq{
 		GroupId:    req.GroupId,
 		OperatorId: uid,
 		MemberId:   req.MemberId,
 		Mute:       req.Mute,
+		Duration:   req.Duration,
 	})
 
 	if err != nil {
@@ -47,5 +48,8 @@ func (l *SetMemberMuteLogic) SetMemberMute(req *types.SetMemberMuteReq) (resp *t
 	return &types.Response{
 		Code:    0,
 		Message: "设置成功",
+		Data: types.SetMemberMuteResp{
+			MuteUntil: rpcResp.MuteUntil,
+		},
 	}, nil
 }
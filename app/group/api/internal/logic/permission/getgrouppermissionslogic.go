@@ -0,0 +1,56 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetGroupPermissionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群权限配置和当前用户的有效权限
+func NewGetGroupPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupPermissionsLogic {
+	return &GetGroupPermissionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetGroupPermissionsLogic) GetGroupPermissions(req *types.GetGroupPermissionsReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	rpcResp, err := l.svcCtx.GroupRpc.GetGroupPermissions(l.ctx, &groupclient.GetGroupPermissionsReq{
+		GroupId: req.GroupId,
+		UserId:  uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "查询成功",
+		Data: types.GetGroupPermissionsResp{
+			Admin:       groupperm.Names(rpcResp.AdminPermissions),
+			Member:      groupperm.Names(rpcResp.MemberPermissions),
+			Permissions: groupperm.Names(rpcResp.Permissions),
+			Role:        rpcResp.Role,
+		},
+	}, nil
+}
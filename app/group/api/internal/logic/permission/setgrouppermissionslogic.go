@@ -0,0 +1,67 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package permission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"SkyeIM/app/group/api/internal/svc"
+	"SkyeIM/app/group/api/internal/types"
+	"SkyeIM/app/group/rpc/groupclient"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetGroupPermissionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 设置群权限（仅群主）
+func NewSetGroupPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetGroupPermissionsLogic {
+	return &SetGroupPermissionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetGroupPermissionsLogic) SetGroupPermissions(req *types.SetGroupPermissionsReq) (resp *types.Response, err error) {
+	userId := json.Number(fmt.Sprintf("%v", l.ctx.Value("userId")))
+	uid, _ := userId.Int64()
+
+	adminPermissions, err := groupperm.Parse(req.Admin)
+	if err != nil {
+		return &types.Response{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	memberPermissions, err := groupperm.Parse(req.Member)
+	if err != nil {
+		return &types.Response{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	_, err = l.svcCtx.GroupRpc.SetGroupPermissions(l.ctx, &groupclient.SetGroupPermissionsReq{
+		GroupId:           req.GroupId,
+		OperatorId:        uid,
+		AdminPermissions:  adminPermissions,
+		MemberPermissions: memberPermissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Response{
+		Code:    0,
+		Message: "设置成功",
+	}, nil
+}
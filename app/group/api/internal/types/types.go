@@ -3,6 +3,40 @@
 
 package types
 
+type AnnouncementConfirmInfo struct {
+	UserId      int64 `json:"userId"`
+	ConfirmedAt int64 `json:"confirmedAt"`
+}
+
+type AnnouncementIdReq struct {
+	AnnouncementId uint64 `json:"announcementId"`
+}
+
+type AnnouncementInfo struct {
+	Id             uint64 `json:"id"`
+	GroupId        string `json:"groupId"`
+	AuthorId       int64  `json:"authorId"`
+	EditorId       int64  `json:"editorId"`
+	Content        string `json:"content"`        // 公告内容（富文本，Markdown）
+	RequireConfirm bool   `json:"requireConfirm"` // 是否需要成员确认
+	Pinned         bool   `json:"pinned"`         // 是否为当前置顶公告
+	Version        int64  `json:"version"`        // 版本号，每次编辑加1
+	Confirmed      bool   `json:"confirmed"`      // 当前用户是否已确认当前版本
+	CreatedAt      int64  `json:"createdAt"`
+	UpdatedAt      int64  `json:"updatedAt"`
+}
+
+type AnnouncementQueryReq struct {
+	AnnouncementId uint64 `form:"announcementId"`
+}
+
+type AnnouncementVersion struct {
+	Version   int64  `json:"version"`
+	EditorId  int64  `json:"editorId"`
+	Content   string `json:"content"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
 type CreateGroupReq struct {
 	Name        string  `json:"name"`
 	Avatar      string  `json:"avatar,optional"`
@@ -15,10 +49,38 @@ type CreateGroupResp struct {
 	GroupId string `json:"groupId"`
 }
 
+type CreateInviteLinkReq struct {
+	GroupId       string `json:"groupId"`
+	ExpireSeconds int64  `json:"expireSeconds,optional"` // 有效期（秒），不传使用默认有效期（7天）
+	MaxUses       int32  `json:"maxUses,optional"`       // 最大使用次数，不传或0表示不限
+	AutoApprove   bool   `json:"autoApprove,optional"`   // 是否免审核直接入群
+}
+
 type DismissGroupReq struct {
 	GroupId string `json:"groupId"`
 }
 
+type GetAnnouncementConfirmationsResp struct {
+	Version        int64                     `json:"version"`        // 当前公告版本
+	Confirmed      []AnnouncementConfirmInfo `json:"confirmed"`      // 已确认当前版本的成员
+	UnconfirmedIds []int64                   `json:"unconfirmedIds"` // 尚未确认的成员ID
+}
+
+type GetAnnouncementHistoryResp struct {
+	List []AnnouncementVersion `json:"list"` // 按版本倒序
+}
+
+type GetAnnouncementListReq struct {
+	GroupId  string `form:"groupId"`
+	Page     int64  `form:"page,default=1"`
+	PageSize int64  `form:"pageSize,default=20"`
+}
+
+type GetAnnouncementListResp struct {
+	List  []AnnouncementInfo `json:"list"`
+	Total int64              `json:"total"`
+}
+
 type GetGroupInfoReq struct {
 	GroupId string `path:"groupId"`
 }
@@ -33,6 +95,17 @@ type GetGroupListResp struct {
 	Total int64       `json:"total"`
 }
 
+type GetGroupPermissionsReq struct {
+	GroupId string `form:"groupId"`
+}
+
+type GetGroupPermissionsResp struct {
+	Admin       []string `json:"admin"`       // 管理员拥有的权限名称
+	Member      []string `json:"member"`      // 普通成员拥有的权限名称
+	Permissions []string `json:"permissions"` // 当前用户的有效权限（群主为全部权限）
+	Role        int32    `json:"role"`        // 当前用户的角色: 1-群主 2-管理员 3-普通成员
+}
+
 type GetInvitationsReq struct {
 	Page     int32 `form:"page,default=1"`
 	PageSize int32 `form:"pageSize,default=20"`
@@ -43,6 +116,27 @@ type GetInvitationsResp struct {
 	Total int64                 `json:"total"`
 }
 
+type GetInviteLinkResp struct {
+	Link  InviteLinkInfo `json:"link"`
+	Group GroupInfo      `json:"group"` // 群组信息（落地页展示）
+}
+
+type GetInviteLinksReq struct {
+	GroupId   string `form:"groupId"`
+	CreatorId int64  `form:"creatorId,optional"` // 按创建人过滤，不传表示全部
+	Page      int64  `form:"page,default=1"`
+	PageSize  int64  `form:"pageSize,default=20"`
+}
+
+type GetInviteLinksResp struct {
+	List  []InviteLinkInfo `json:"list"`
+	Total int64            `json:"total"`
+}
+
+type GetJoinPolicyReq struct {
+	GroupId string `form:"groupId"`
+}
+
 type GetJoinRequestsReq struct {
 	Page     int32  `form:"page,default=1"`
 	PageSize int32  `form:"pageSize,default=20"`
@@ -66,16 +160,20 @@ type GetMemberListResp struct {
 }
 
 type GroupInfo struct {
-	GroupId     string `json:"groupId"`
-	Name        string `json:"name"`
-	Avatar      string `json:"avatar"`
-	OwnerId     int64  `json:"ownerId"`
-	Description string `json:"description"`
-	MemberCount int32  `json:"memberCount"`
-	MaxMembers  int32  `json:"maxMembers"`
-	Status      int32  `json:"status"`
-	CreatedAt   int64  `json:"createdAt"`
-	UpdatedAt   int64  `json:"updatedAt"`
+	GroupId      string `json:"groupId"`
+	Name         string `json:"name"`
+	Avatar       string `json:"avatar"`
+	OwnerId      int64  `json:"ownerId"`
+	Description  string `json:"description"`
+	MemberCount  int32  `json:"memberCount"`
+	MaxMembers   int32  `json:"maxMembers"`
+	Status       int32  `json:"status"`
+	MuteAll      int32  `json:"muteAll"`      // 全员禁言: 0-关闭 1-开启
+	JoinPolicy   int32  `json:"joinPolicy"`   // 入群方式: 1-自由加入 2-需要审核 3-回答问题 4-仅限邀请 5-禁止加入
+	JoinQuestion string `json:"joinQuestion"` // 入群问题（joinPolicy=3 时有效）
+	Discoverable bool   `json:"discoverable"` // 是否可被搜索
+	CreatedAt    int64  `json:"createdAt"`
+	UpdatedAt    int64  `json:"updatedAt"`
 }
 
 type GroupInvitationInfo struct {
@@ -101,6 +199,24 @@ type HandleJoinRequestReq struct {
 	Action    int64 `json:"action"` // 1-同意 2-拒绝
 }
 
+type InviteLinkCodeReq struct {
+	Code string `form:"code"`
+}
+
+type InviteLinkInfo struct {
+	Id          uint64 `json:"id"`
+	Code        string `json:"code"` // 邀请码
+	Url         string `json:"url"`  // 分享链接
+	GroupId     string `json:"groupId"`
+	CreatorId   int64  `json:"creatorId"`
+	ExpireAt    int64  `json:"expireAt"`    // 过期时间戳
+	MaxUses     int32  `json:"maxUses"`     // 最大使用次数，0 表示不限
+	UseCount    int32  `json:"useCount"`    // 已使用次数
+	AutoApprove bool   `json:"autoApprove"` // 是否免审核直接入群
+	Status      int32  `json:"status"`      // 1-有效 2-已撤销 3-已过期 4-次数已用完
+	CreatedAt   int64  `json:"createdAt"`
+}
+
 type InviteMembersReq struct {
 	GroupId   string  `json:"groupId"`
 	MemberIds []int64 `json:"memberIds"`
@@ -111,6 +227,13 @@ type InviteMembersResp struct {
 	FailedIds    []int64 `json:"failedIds"`
 }
 
+type JoinPolicyInfo struct {
+	JoinPolicy   int32  `json:"joinPolicy"`   // 入群方式: 1-自由加入 2-需要审核 3-回答问题 4-仅限邀请 5-禁止加入
+	JoinQuestion string `json:"joinQuestion"` // 入群问题
+	JoinAnswer   string `json:"joinAnswer"`   // 入群问题答案，为空时人工审核回答
+	Discoverable bool   `json:"discoverable"` // 是否可被搜索
+}
+
 type JoinRequestInfo struct {
 	Id          int64  `json:"id"`
 	GroupId     string `json:"groupId"`
@@ -120,6 +243,7 @@ type JoinRequestInfo struct {
 	UserName    string `json:"userName"`
 	UserAvatar  string `json:"userAvatar"`
 	Message     string `json:"message"`
+	Answer      string `json:"answer"` // 入群问题回答
 	Status      int64  `json:"status"`
 	HandlerId   int64  `json:"handlerId,optional"`
 	CreatedAt   int64  `json:"createdAt"`
@@ -131,18 +255,47 @@ type KickMemberReq struct {
 }
 
 type MemberInfo struct {
-	UserId   int64  `json:"userId"`
-	Nickname string `json:"nickname"`
-	Avatar   string `json:"avatar"`
-	Role     int32  `json:"role"`
-	Mute     int32  `json:"mute"`
-	JoinTime int64  `json:"joinTime"`
-	JoinedAt string `json:"joinedAt"`
-	ReadSeq  uint64 `json:"readSeq"`
+	UserId    int64  `json:"userId"`
+	Nickname  string `json:"nickname"`
+	Avatar    string `json:"avatar"`
+	Role      int32  `json:"role"`
+	Mute      int32  `json:"mute"`
+	MuteUntil int64  `json:"muteUntil"` // 禁言截止时间戳，0 表示永久禁言
+	JoinTime  int64  `json:"joinTime"`
+	JoinedAt  string `json:"joinedAt"`
+	ReadSeq   uint64 `json:"readSeq"`
+}
+
+type PinAnnouncementReq struct {
+	AnnouncementId uint64 `json:"announcementId"`
+	Pinned         bool   `json:"pinned"` // true-置顶 false-取消置顶
+}
+
+type PublishAnnouncementReq struct {
+	GroupId        string `json:"groupId"`
+	Content        string `json:"content"`
+	RequireConfirm bool   `json:"requireConfirm,optional"` // 是否需要成员确认
+	Pin            bool   `json:"pin,optional"`            // 是否置顶（取消其他公告的置顶）
 }
 
 type QuitGroupReq struct {
-	GroupId string `json:"groupId"`
+	GroupId      string `json:"groupId"`
+	AutoTransfer bool   `json:"autoTransfer,optional"` // 群主退群时自动将群主转让给入群最早的管理员
+}
+
+type QuitGroupResp struct {
+	NewOwnerId int64 `json:"newOwnerId"` // 自动转让时的新群主ID，未转让时为0
+}
+
+type RedeemInviteLinkReq struct {
+	Code    string `json:"code"`
+	Message string `json:"message,optional"` // 申请理由（链接需要审核时）
+}
+
+type RedeemInviteLinkResp struct {
+	GroupId   string `json:"groupId"`
+	Joined    bool   `json:"joined"`             // true-已直接入群 false-已提交入群申请
+	RequestId int64  `json:"requestId,optional"` // 入群申请ID（需要审核时）
 }
 
 type Response struct {
@@ -151,6 +304,10 @@ type Response struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
+type RevokeInviteLinkReq struct {
+	LinkId uint64 `json:"linkId"`
+}
+
 type SearchGroupPreciseReq struct {
 	GroupId string `form:"groupId"`
 }
@@ -177,16 +334,42 @@ type SendGroupInvitationResp struct {
 type SendJoinRequestReq struct {
 	GroupId string `json:"groupId"`
 	Message string `json:"message,optional"`
+	Answer  string `json:"answer,optional"` // 入群问题回答（入群方式为回答问题时必填）
 }
 
 type SendJoinRequestResp struct {
-	RequestId int64 `json:"requestId"`
+	RequestId int64 `json:"requestId"` // 申请ID（直接入群时为0）
+	Joined    bool  `json:"joined"`    // true-已直接入群 false-已提交入群申请
+}
+
+type SetGroupMuteAllReq struct {
+	GroupId string `json:"groupId"`
+	MuteAll int32  `json:"muteAll"` // 0-关闭 1-开启
+}
+
+type SetGroupPermissionsReq struct {
+	GroupId string   `json:"groupId"`
+	Admin   []string `json:"admin"`  // 管理员拥有的权限名称
+	Member  []string `json:"member"` // 普通成员拥有的权限名称
+}
+
+type SetJoinPolicyReq struct {
+	GroupId      string `json:"groupId"`
+	JoinPolicy   int32  `json:"joinPolicy"`
+	JoinQuestion string `json:"joinQuestion,optional"` // 入群方式为回答问题时必填
+	JoinAnswer   string `json:"joinAnswer,optional"`   // 不填时由管理员人工审核回答
+	Discoverable bool   `json:"discoverable"`
 }
 
 type SetMemberMuteReq struct {
 	GroupId  string `json:"groupId"`
 	MemberId int64  `json:"memberId"`
 	Mute     int32  `json:"mute"`
+	Duration int64  `json:"duration,optional"` // 禁言时长（秒），不传或0表示永久禁言，最长30天
+}
+
+type SetMemberMuteResp struct {
+	MuteUntil int64 `json:"muteUntil"` // 禁言截止时间戳，0 表示永久禁言
 }
 
 type SetMemberRoleReq struct {
@@ -195,6 +378,18 @@ type SetMemberRoleReq struct {
 	Role     int32  `json:"role"`
 }
 
+type TransferOwnershipReq struct {
+	GroupId    string `json:"groupId"`
+	NewOwnerId int64  `json:"newOwnerId"`
+}
+
+type UpdateAnnouncementReq struct {
+	AnnouncementId uint64 `json:"announcementId"`
+	Content        string `json:"content"`
+	RequireConfirm bool   `json:"requireConfirm,optional"`
+	Version        int64  `json:"version,optional"` // 编辑所基于的版本号，与当前版本不一致时拒绝（不传不校验）
+}
+
 type UpdateGroupReadSeqReq struct {
 	GroupId string `json:"groupId"`
 	ReadSeq uint64 `json:"readSeq"`
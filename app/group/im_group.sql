@@ -10,6 +10,13 @@ CREATE TABLE `im_group` (
   `member_count` int DEFAULT 0 COMMENT '当前成员数',
   `status` tinyint DEFAULT 1 COMMENT '状态: 1-正常 2-已解散',
   `invite_confirm_mode` tinyint DEFAULT 1 COMMENT '邀请确认模式: 0-直接加入 1-需要确认（默认）',
+  `mute_all` tinyint NOT NULL DEFAULT 0 COMMENT '全员禁言: 0-否 1-是（仅群主和管理员可以发言）',
+  `join_policy` tinyint NOT NULL DEFAULT 2 COMMENT '入群方式: 1-自由加入 2-需要审核（默认） 3-回答问题 4-仅限邀请 5-禁止加入',
+  `join_question` varchar(200) NOT NULL DEFAULT '' COMMENT '入群问题（join_policy=3时有效）',
+  `join_answer` varchar(200) NOT NULL DEFAULT '' COMMENT '入群问题答案: 为空时人工审核回答，否则自动校验',
+  `discoverable` tinyint NOT NULL DEFAULT 1 COMMENT '是否可被搜索: 0-否 1-是',
+  `admin_permissions` int NOT NULL DEFAULT 511 COMMENT '管理员权限位掩码: 1-邀请成员 2-移出成员 4-禁言 8-修改群信息 16-@全体成员 32-置顶消息 64-群公告 128-审批入群 256-内容审核 512-消息保留期限',
+  `member_permissions` int NOT NULL DEFAULT 1 COMMENT '普通成员权限位掩码（同管理员），群主始终拥有全部权限',
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
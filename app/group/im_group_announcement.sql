@@ -0,0 +1,15 @@
+CREATE TABLE `im_group_announcement` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `group_id` varchar(64) NOT NULL COMMENT '群组ID',
+  `author_id` bigint unsigned NOT NULL COMMENT '发布人ID',
+  `editor_id` bigint unsigned NOT NULL COMMENT '最后编辑人ID',
+  `content` text NOT NULL COMMENT '公告内容（富文本，Markdown）',
+  `require_confirm` tinyint NOT NULL DEFAULT 0 COMMENT '是否需要成员确认: 0-否 1-是',
+  `pinned` tinyint NOT NULL DEFAULT 0 COMMENT '是否为当前置顶公告（每个群最多一条）',
+  `version` int NOT NULL DEFAULT 1 COMMENT '版本号，每次编辑加1',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '状态: 1-正常 2-已删除',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_group_status` (`group_id`, `status`, `pinned`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告表';
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE `im_group_announcement_confirm` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `announcement_id` bigint unsigned NOT NULL COMMENT '公告ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '确认人ID',
+  `version` int NOT NULL COMMENT '确认时的公告版本（公告编辑后需重新确认）',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最近确认时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_announcement_user` (`announcement_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告确认表';
\ No newline at end of file
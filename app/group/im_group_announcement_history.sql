@@ -0,0 +1,10 @@
+CREATE TABLE `im_group_announcement_history` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `announcement_id` bigint unsigned NOT NULL COMMENT '公告ID',
+  `version` int NOT NULL COMMENT '版本号',
+  `editor_id` bigint unsigned NOT NULL COMMENT '编辑人ID',
+  `content` text NOT NULL COMMENT '该版本的公告内容',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_announcement_version` (`announcement_id`, `version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告编辑历史表';
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE `im_group_invite_link` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `code` varchar(32) NOT NULL COMMENT '邀请码（随机生成，用于分享链接和二维码）',
+  `group_id` varchar(64) NOT NULL COMMENT '群组ID',
+  `creator_id` bigint unsigned NOT NULL COMMENT '创建人ID（群主/管理员）',
+  `expire_at` datetime NOT NULL COMMENT '过期时间',
+  `max_uses` int NOT NULL DEFAULT 0 COMMENT '最大使用次数，0 表示不限',
+  `use_count` int NOT NULL DEFAULT 0 COMMENT '已使用次数',
+  `auto_approve` tinyint NOT NULL DEFAULT 0 COMMENT '通过链接直接入群: 0-否（提交入群申请） 1-是（免审核）',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '状态: 1-有效 2-已撤销',
+  `revoked_by` bigint unsigned NOT NULL DEFAULT 0 COMMENT '撤销人ID',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_code` (`code`),
+  KEY `idx_group_creator` (`group_id`, `creator_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群邀请链接表';
\ No newline at end of file
@@ -3,6 +3,7 @@ CREATE TABLE `im_group_join_request` (
   `group_id` varchar(64) NOT NULL COMMENT '群组ID',
   `user_id` bigint unsigned NOT NULL COMMENT '申请人ID',
   `message` varchar(200) DEFAULT '' COMMENT '申请理由',
+  `answer` varchar(200) NOT NULL DEFAULT '' COMMENT '入群问题回答（回答问题入群且需人工审核时）',
   `status` tinyint NOT NULL DEFAULT 0 COMMENT '0-待处理 1-已同意 2-已拒绝',
   `handler_id` bigint unsigned DEFAULT NULL COMMENT '处理人ID（群主/管理员）',
   `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -6,6 +6,7 @@ CREATE TABLE `im_group_member` (
   `role` tinyint DEFAULT 3 COMMENT '角色: 1-群主 2-管理员 3-普通成员',
   `nickname` varchar(50) DEFAULT NULL COMMENT '群昵称',
   `mute` tinyint DEFAULT 0 COMMENT '是否禁言: 0-否 1-是',
+  `mute_until` bigint NOT NULL DEFAULT 0 COMMENT '禁言截止时间戳(秒): 0-永久禁言（mute=1时有效），到期后自动解除',
   `read_seq` BIGINT UNSIGNED DEFAULT 0 COMMENT '已读消息Seq',
   `joined_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
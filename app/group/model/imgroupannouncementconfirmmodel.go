@@ -0,0 +1,94 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImGroupAnnouncementConfirmModel = (*customImGroupAnnouncementConfirmModel)(nil)
+
+type (
+	// ImGroupAnnouncementConfirmModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImGroupAnnouncementConfirmModel.
+	ImGroupAnnouncementConfirmModel interface {
+		imGroupAnnouncementConfirmModel
+		// 记录用户确认了公告的某个版本，已确认过旧版本时覆盖
+		Confirm(ctx context.Context, announcementId, userId uint64, version int64) error
+		// 查询确认了公告指定版本的记录
+		FindByAnnouncementVersion(ctx context.Context, announcementId uint64, version int64) ([]*ImGroupAnnouncementConfirm, error)
+		// 查询用户对多条公告的确认记录
+		FindByUserAndAnnouncements(ctx context.Context, userId uint64, announcementIds []uint64) ([]*ImGroupAnnouncementConfirm, error)
+	}
+
+	customImGroupAnnouncementConfirmModel struct {
+		*defaultImGroupAnnouncementConfirmModel
+	}
+)
+
+// NewImGroupAnnouncementConfirmModel returns a model for the database table.
+func NewImGroupAnnouncementConfirmModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupAnnouncementConfirmModel {
+	return &customImGroupAnnouncementConfirmModel{
+		defaultImGroupAnnouncementConfirmModel: newImGroupAnnouncementConfirmModel(conn, c, opts...),
+	}
+}
+
+// Confirm 记录用户确认了公告的某个版本（公告编辑后需重新确认，覆盖原确认版本）
+func (m *customImGroupAnnouncementConfirmModel) Confirm(ctx context.Context, announcementId, userId uint64, version int64) error {
+	keys := []string{fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementConfirmAnnouncementIdUserIdPrefix, announcementId, userId)}
+
+	// 重新确认时同时清理按主键缓存的记录
+	var id uint64
+	query := fmt.Sprintf("select `id` from %s where `announcement_id` = ? and `user_id` = ? limit 1", m.table)
+	switch err := m.QueryRowNoCacheCtx(ctx, &id, query, announcementId, userId); err {
+	case nil:
+		keys = append(keys, fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementConfirmIdPrefix, id))
+	case ErrNotFound:
+	default:
+		return err
+	}
+
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("insert into %s (`announcement_id`, `user_id`, `version`) values (?, ?, ?) on duplicate key update `version` = values(`version`)", m.table)
+		return conn.ExecCtx(ctx, query, announcementId, userId, version)
+	}, keys...)
+	return err
+}
+
+// FindByAnnouncementVersion 查询确认了公告指定版本的记录，按确认时间排序
+func (m *customImGroupAnnouncementConfirmModel) FindByAnnouncementVersion(ctx context.Context, announcementId uint64, version int64) ([]*ImGroupAnnouncementConfirm, error) {
+	var resp []*ImGroupAnnouncementConfirm
+	query := fmt.Sprintf("select %s from %s where `announcement_id` = ? and `version` = ? order by `updated_at` asc", imGroupAnnouncementConfirmRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, announcementId, version)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FindByUserAndAnnouncements 查询用户对多条公告的确认记录（任何版本）
+func (m *customImGroupAnnouncementConfirmModel) FindByUserAndAnnouncements(ctx context.Context, userId uint64, announcementIds []uint64) ([]*ImGroupAnnouncementConfirm, error) {
+	if len(announcementIds) == 0 {
+		return []*ImGroupAnnouncementConfirm{}, nil
+	}
+
+	placeholders := make([]string, len(announcementIds))
+	args := make([]interface{}, 0, len(announcementIds)+1)
+	args = append(args, userId)
+	for i, id := range announcementIds {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+
+	var resp []*ImGroupAnnouncementConfirm
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `announcement_id` in (%s)", imGroupAnnouncementConfirmRows, m.table, strings.Join(placeholders, ","))
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
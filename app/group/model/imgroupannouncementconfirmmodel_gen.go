@@ -0,0 +1,150 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imGroupAnnouncementConfirmFieldNames          = builder.RawFieldNames(&ImGroupAnnouncementConfirm{})
+	imGroupAnnouncementConfirmRows                = strings.Join(imGroupAnnouncementConfirmFieldNames, ",")
+	imGroupAnnouncementConfirmRowsExpectAutoSet   = strings.Join(stringx.Remove(imGroupAnnouncementConfirmFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imGroupAnnouncementConfirmRowsWithPlaceHolder = strings.Join(stringx.Remove(imGroupAnnouncementConfirmFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImGroupAnnouncementConfirmIdPrefix                   = "cache:imAuth:imGroupAnnouncementConfirm:id:"
+	cacheImAuthImGroupAnnouncementConfirmAnnouncementIdUserIdPrefix = "cache:imAuth:imGroupAnnouncementConfirm:announcementId:userId:"
+)
+
+type (
+	imGroupAnnouncementConfirmModel interface {
+		Insert(ctx context.Context, data *ImGroupAnnouncementConfirm) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImGroupAnnouncementConfirm, error)
+		FindOneByAnnouncementIdUserId(ctx context.Context, announcementId uint64, userId uint64) (*ImGroupAnnouncementConfirm, error)
+		Update(ctx context.Context, data *ImGroupAnnouncementConfirm) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImGroupAnnouncementConfirmModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImGroupAnnouncementConfirm struct {
+		Id             uint64    `db:"id"`
+		AnnouncementId uint64    `db:"announcement_id"` // 公告ID
+		UserId         uint64    `db:"user_id"`         // 确认人ID
+		Version        int64     `db:"version"`         // 确认时的公告版本（公告编辑后需重新确认）
+		CreatedAt      time.Time `db:"created_at"`
+		UpdatedAt      time.Time `db:"updated_at"` // 最近确认时间
+	}
+)
+
+func newImGroupAnnouncementConfirmModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImGroupAnnouncementConfirmModel {
+	return &defaultImGroupAnnouncementConfirmModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_group_announcement_confirm`",
+	}
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementConfirmAnnouncementIdUserIdPrefix, data.AnnouncementId, data.UserId)
+	imAuthImGroupAnnouncementConfirmIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementConfirmIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey, imAuthImGroupAnnouncementConfirmIdKey)
+	return err
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) FindOne(ctx context.Context, id uint64) (*ImGroupAnnouncementConfirm, error) {
+	imAuthImGroupAnnouncementConfirmIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementConfirmIdPrefix, id)
+	var resp ImGroupAnnouncementConfirm
+	err := m.QueryRowCtx(ctx, &resp, imAuthImGroupAnnouncementConfirmIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupAnnouncementConfirmRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) FindOneByAnnouncementIdUserId(ctx context.Context, announcementId uint64, userId uint64) (*ImGroupAnnouncementConfirm, error) {
+	imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementConfirmAnnouncementIdUserIdPrefix, announcementId, userId)
+	var resp ImGroupAnnouncementConfirm
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `announcement_id` = ? and `user_id` = ? limit 1", imGroupAnnouncementConfirmRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, announcementId, userId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) Insert(ctx context.Context, data *ImGroupAnnouncementConfirm) (sql.Result, error) {
+	imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementConfirmAnnouncementIdUserIdPrefix, data.AnnouncementId, data.UserId)
+	imAuthImGroupAnnouncementConfirmIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementConfirmIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, imGroupAnnouncementConfirmRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.AnnouncementId, data.UserId, data.Version)
+	}, imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey, imAuthImGroupAnnouncementConfirmIdKey)
+	return ret, err
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) Update(ctx context.Context, newData *ImGroupAnnouncementConfirm) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementConfirmAnnouncementIdUserIdPrefix, data.AnnouncementId, data.UserId)
+	imAuthImGroupAnnouncementConfirmIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementConfirmIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupAnnouncementConfirmRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.AnnouncementId, newData.UserId, newData.Version, newData.Id)
+	}, imAuthImGroupAnnouncementConfirmAnnouncementIdUserIdKey, imAuthImGroupAnnouncementConfirmIdKey)
+	return err
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementConfirmIdPrefix, primary)
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupAnnouncementConfirmRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImGroupAnnouncementConfirmModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImGroupAnnouncementHistoryModel = (*customImGroupAnnouncementHistoryModel)(nil)
+
+type (
+	// ImGroupAnnouncementHistoryModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImGroupAnnouncementHistoryModel.
+	ImGroupAnnouncementHistoryModel interface {
+		imGroupAnnouncementHistoryModel
+		// 查询公告的全部编辑历史
+		FindByAnnouncementId(ctx context.Context, announcementId uint64) ([]*ImGroupAnnouncementHistory, error)
+	}
+
+	customImGroupAnnouncementHistoryModel struct {
+		*defaultImGroupAnnouncementHistoryModel
+	}
+)
+
+// NewImGroupAnnouncementHistoryModel returns a model for the database table.
+func NewImGroupAnnouncementHistoryModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupAnnouncementHistoryModel {
+	return &customImGroupAnnouncementHistoryModel{
+		defaultImGroupAnnouncementHistoryModel: newImGroupAnnouncementHistoryModel(conn, c, opts...),
+	}
+}
+
+// FindByAnnouncementId 查询公告的全部编辑历史，按版本倒序
+func (m *customImGroupAnnouncementHistoryModel) FindByAnnouncementId(ctx context.Context, announcementId uint64) ([]*ImGroupAnnouncementHistory, error) {
+	var resp []*ImGroupAnnouncementHistory
+	query := fmt.Sprintf("select %s from %s where `announcement_id` = ? order by `version` desc", imGroupAnnouncementHistoryRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, announcementId)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,150 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imGroupAnnouncementHistoryFieldNames          = builder.RawFieldNames(&ImGroupAnnouncementHistory{})
+	imGroupAnnouncementHistoryRows                = strings.Join(imGroupAnnouncementHistoryFieldNames, ",")
+	imGroupAnnouncementHistoryRowsExpectAutoSet   = strings.Join(stringx.Remove(imGroupAnnouncementHistoryFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imGroupAnnouncementHistoryRowsWithPlaceHolder = strings.Join(stringx.Remove(imGroupAnnouncementHistoryFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImGroupAnnouncementHistoryIdPrefix                    = "cache:imAuth:imGroupAnnouncementHistory:id:"
+	cacheImAuthImGroupAnnouncementHistoryAnnouncementIdVersionPrefix = "cache:imAuth:imGroupAnnouncementHistory:announcementId:version:"
+)
+
+type (
+	imGroupAnnouncementHistoryModel interface {
+		Insert(ctx context.Context, data *ImGroupAnnouncementHistory) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImGroupAnnouncementHistory, error)
+		FindOneByAnnouncementIdVersion(ctx context.Context, announcementId uint64, version int64) (*ImGroupAnnouncementHistory, error)
+		Update(ctx context.Context, data *ImGroupAnnouncementHistory) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImGroupAnnouncementHistoryModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImGroupAnnouncementHistory struct {
+		Id             uint64    `db:"id"`
+		AnnouncementId uint64    `db:"announcement_id"` // 公告ID
+		Version        int64     `db:"version"`         // 版本号
+		EditorId       uint64    `db:"editor_id"`       // 编辑人ID
+		Content        string    `db:"content"`         // 该版本的公告内容
+		CreatedAt      time.Time `db:"created_at"`
+	}
+)
+
+func newImGroupAnnouncementHistoryModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImGroupAnnouncementHistoryModel {
+	return &defaultImGroupAnnouncementHistoryModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_group_announcement_history`",
+	}
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementHistoryAnnouncementIdVersionPrefix, data.AnnouncementId, data.Version)
+	imAuthImGroupAnnouncementHistoryIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementHistoryIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey, imAuthImGroupAnnouncementHistoryIdKey)
+	return err
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) FindOne(ctx context.Context, id uint64) (*ImGroupAnnouncementHistory, error) {
+	imAuthImGroupAnnouncementHistoryIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementHistoryIdPrefix, id)
+	var resp ImGroupAnnouncementHistory
+	err := m.QueryRowCtx(ctx, &resp, imAuthImGroupAnnouncementHistoryIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupAnnouncementHistoryRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) FindOneByAnnouncementIdVersion(ctx context.Context, announcementId uint64, version int64) (*ImGroupAnnouncementHistory, error) {
+	imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementHistoryAnnouncementIdVersionPrefix, announcementId, version)
+	var resp ImGroupAnnouncementHistory
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `announcement_id` = ? and `version` = ? limit 1", imGroupAnnouncementHistoryRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, announcementId, version); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) Insert(ctx context.Context, data *ImGroupAnnouncementHistory) (sql.Result, error) {
+	imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementHistoryAnnouncementIdVersionPrefix, data.AnnouncementId, data.Version)
+	imAuthImGroupAnnouncementHistoryIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementHistoryIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, imGroupAnnouncementHistoryRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.AnnouncementId, data.Version, data.EditorId, data.Content)
+	}, imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey, imAuthImGroupAnnouncementHistoryIdKey)
+	return ret, err
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) Update(ctx context.Context, newData *ImGroupAnnouncementHistory) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementHistoryAnnouncementIdVersionPrefix, data.AnnouncementId, data.Version)
+	imAuthImGroupAnnouncementHistoryIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementHistoryIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupAnnouncementHistoryRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.AnnouncementId, newData.Version, newData.EditorId, newData.Content, newData.Id)
+	}, imAuthImGroupAnnouncementHistoryAnnouncementIdVersionKey, imAuthImGroupAnnouncementHistoryIdKey)
+	return err
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementHistoryIdPrefix, primary)
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupAnnouncementHistoryRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImGroupAnnouncementHistoryModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,189 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImGroupAnnouncementModel = (*customImGroupAnnouncementModel)(nil)
+
+// imGroupAnnouncementHistoryTable 群公告编辑历史表名（发布和编辑事务中与公告表一起写入）
+const imGroupAnnouncementHistoryTable = "`im_group_announcement_history`"
+
+// ErrAnnouncementChanged 编辑公告时公告已被他人修改或删除
+var ErrAnnouncementChanged = errors.New("group announcement changed")
+
+type (
+	// ImGroupAnnouncementModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImGroupAnnouncementModel.
+	ImGroupAnnouncementModel interface {
+		imGroupAnnouncementModel
+		// 发布公告并写入第一版历史，置顶时取消群内其他公告的置顶
+		Publish(ctx context.Context, data *ImGroupAnnouncement) error
+		// 编辑公告内容，版本号加1并写入历史
+		Edit(ctx context.Context, data *ImGroupAnnouncement) error
+		// 设置或取消置顶，置顶时取消群内其他公告的置顶
+		SetPinned(ctx context.Context, data *ImGroupAnnouncement, pinned bool) error
+		// 按群组查询公告列表(分页)，置顶公告在前
+		FindByGroupId(ctx context.Context, groupId string, page, pageSize int64) ([]*ImGroupAnnouncement, error)
+		// 统计群组的公告数量
+		CountByGroupId(ctx context.Context, groupId string) (int64, error)
+	}
+
+	customImGroupAnnouncementModel struct {
+		*defaultImGroupAnnouncementModel
+	}
+)
+
+// NewImGroupAnnouncementModel returns a model for the database table.
+func NewImGroupAnnouncementModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupAnnouncementModel {
+	return &customImGroupAnnouncementModel{
+		defaultImGroupAnnouncementModel: newImGroupAnnouncementModel(conn, c, opts...),
+	}
+}
+
+// Publish 在同一事务中写入公告和第一版编辑历史，成功后 data.Id 为新公告ID
+func (m *customImGroupAnnouncementModel) Publish(ctx context.Context, data *ImGroupAnnouncement) error {
+	var unpinned []uint64
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if data.Pinned == 1 {
+			ids, err := m.unpinOthers(ctx, session, data.GroupId, 0)
+			if err != nil {
+				return err
+			}
+			unpinned = ids
+		}
+
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, imGroupAnnouncementRowsExpectAutoSet)
+		result, err := session.ExecCtx(ctx, query, data.GroupId, data.AuthorId, data.EditorId, data.Content,
+			data.RequireConfirm, data.Pinned, data.Version, data.Status)
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		data.Id = uint64(id)
+
+		return m.insertHistory(ctx, session, data)
+	})
+	if err != nil {
+		return err
+	}
+	return m.delCache(ctx, data, unpinned)
+}
+
+// Edit 以当前版本号为条件更新公告（内容、编辑人、是否需要确认），并写入新版本的历史
+// 并发编辑时只有一次成功，其余返回 ErrAnnouncementChanged；成功后 data.Version 为新版本号
+func (m *customImGroupAnnouncementModel) Edit(ctx context.Context, data *ImGroupAnnouncement) error {
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set `content` = ?, `editor_id` = ?, `require_confirm` = ?, `version` = `version` + 1 where `id` = ? and `version` = ? and `status` = 1", m.table)
+		result, err := session.ExecCtx(ctx, query, data.Content, data.EditorId, data.RequireConfirm, data.Id, data.Version)
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrAnnouncementChanged
+		}
+
+		data.Version++
+		return m.insertHistory(ctx, session, data)
+	})
+	if err != nil {
+		return err
+	}
+	return m.delCache(ctx, data, nil)
+}
+
+// SetPinned 设置或取消置顶，每个群最多一条置顶公告
+func (m *customImGroupAnnouncementModel) SetPinned(ctx context.Context, data *ImGroupAnnouncement, pinned bool) error {
+	var unpinned []uint64
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		value := 0
+		if pinned {
+			ids, err := m.unpinOthers(ctx, session, data.GroupId, data.Id)
+			if err != nil {
+				return err
+			}
+			unpinned = ids
+			value = 1
+		}
+		query := fmt.Sprintf("update %s set `pinned` = ? where `id` = ?", m.table)
+		_, err := session.ExecCtx(ctx, query, value, data.Id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	data.Pinned = 0
+	if pinned {
+		data.Pinned = 1
+	}
+	return m.delCache(ctx, data, unpinned)
+}
+
+// FindByGroupId 按群组查询未删除的公告(分页)，置顶公告在前，其余按发布时间倒序
+func (m *customImGroupAnnouncementModel) FindByGroupId(ctx context.Context, groupId string, page, pageSize int64) ([]*ImGroupAnnouncement, error) {
+	var resp []*ImGroupAnnouncement
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf("select %s from %s where `group_id` = ? and `status` = 1 order by `pinned` desc, `id` desc limit ? offset ?", imGroupAnnouncementRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CountByGroupId 统计群组未删除的公告数量
+func (m *customImGroupAnnouncementModel) CountByGroupId(ctx context.Context, groupId string) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `group_id` = ? and `status` = 1", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, groupId)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// unpinOthers 取消群内除 exceptId 外其他公告的置顶，返回被取消置顶的公告ID（用于清理缓存）
+func (m *customImGroupAnnouncementModel) unpinOthers(ctx context.Context, session sqlx.Session, groupId string, exceptId uint64) ([]uint64, error) {
+	var ids []uint64
+	query := fmt.Sprintf("select `id` from %s where `group_id` = ? and `pinned` = 1 and `id` <> ? for update", m.table)
+	if err := session.QueryRowsCtx(ctx, &ids, query, groupId, exceptId); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query = fmt.Sprintf("update %s set `pinned` = 0 where `group_id` = ? and `pinned` = 1 and `id` <> ?", m.table)
+	if _, err := session.ExecCtx(ctx, query, groupId, exceptId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// insertHistory 写入公告当前版本的编辑历史
+func (m *customImGroupAnnouncementModel) insertHistory(ctx context.Context, session sqlx.Session, data *ImGroupAnnouncement) error {
+	query := fmt.Sprintf("insert into %s (`announcement_id`, `version`, `editor_id`, `content`) values (?, ?, ?, ?)", imGroupAnnouncementHistoryTable)
+	_, err := session.ExecCtx(ctx, query, data.Id, data.Version, data.EditorId, data.Content)
+	return err
+}
+
+// delCache 事务提交后清理公告、被取消置顶的公告和新版本历史的缓存
+func (m *customImGroupAnnouncementModel) delCache(ctx context.Context, data *ImGroupAnnouncement, unpinned []uint64) error {
+	keys := []string{
+		fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, data.Id),
+		fmt.Sprintf("%s%v:%v", cacheImAuthImGroupAnnouncementHistoryAnnouncementIdVersionPrefix, data.Id, data.Version),
+	}
+	for _, id := range unpinned {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, id))
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
@@ -0,0 +1,120 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imGroupAnnouncementFieldNames          = builder.RawFieldNames(&ImGroupAnnouncement{})
+	imGroupAnnouncementRows                = strings.Join(imGroupAnnouncementFieldNames, ",")
+	imGroupAnnouncementRowsExpectAutoSet   = strings.Join(stringx.Remove(imGroupAnnouncementFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imGroupAnnouncementRowsWithPlaceHolder = strings.Join(stringx.Remove(imGroupAnnouncementFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImGroupAnnouncementIdPrefix = "cache:imAuth:imGroupAnnouncement:id:"
+)
+
+type (
+	imGroupAnnouncementModel interface {
+		Insert(ctx context.Context, data *ImGroupAnnouncement) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImGroupAnnouncement, error)
+		Update(ctx context.Context, data *ImGroupAnnouncement) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImGroupAnnouncementModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImGroupAnnouncement struct {
+		Id             uint64    `db:"id"`
+		GroupId        string    `db:"group_id"`        // 群组ID
+		AuthorId       uint64    `db:"author_id"`       // 发布人ID
+		EditorId       uint64    `db:"editor_id"`       // 最后编辑人ID
+		Content        string    `db:"content"`         // 公告内容（富文本，Markdown）
+		RequireConfirm int64     `db:"require_confirm"` // 是否需要成员确认: 0-否 1-是
+		Pinned         int64     `db:"pinned"`          // 是否为当前置顶公告（每个群最多一条）
+		Version        int64     `db:"version"`         // 版本号，每次编辑加1
+		Status         int64     `db:"status"`          // 状态: 1-正常 2-已删除
+		CreatedAt      time.Time `db:"created_at"`
+		UpdatedAt      time.Time `db:"updated_at"`
+	}
+)
+
+func newImGroupAnnouncementModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImGroupAnnouncementModel {
+	return &defaultImGroupAnnouncementModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_group_announcement`",
+	}
+}
+
+func (m *defaultImGroupAnnouncementModel) Delete(ctx context.Context, id uint64) error {
+	imAuthImGroupAnnouncementIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImGroupAnnouncementIdKey)
+	return err
+}
+
+func (m *defaultImGroupAnnouncementModel) FindOne(ctx context.Context, id uint64) (*ImGroupAnnouncement, error) {
+	imAuthImGroupAnnouncementIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, id)
+	var resp ImGroupAnnouncement
+	err := m.QueryRowCtx(ctx, &resp, imAuthImGroupAnnouncementIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupAnnouncementRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupAnnouncementModel) Insert(ctx context.Context, data *ImGroupAnnouncement) (sql.Result, error) {
+	imAuthImGroupAnnouncementIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, imGroupAnnouncementRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.AuthorId, data.EditorId, data.Content, data.RequireConfirm, data.Pinned, data.Version, data.Status)
+	}, imAuthImGroupAnnouncementIdKey)
+	return ret, err
+}
+
+func (m *defaultImGroupAnnouncementModel) Update(ctx context.Context, data *ImGroupAnnouncement) error {
+	imAuthImGroupAnnouncementIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, data.Id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupAnnouncementRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.AuthorId, data.EditorId, data.Content, data.RequireConfirm, data.Pinned, data.Version, data.Status, data.Id)
+	}, imAuthImGroupAnnouncementIdKey)
+	return err
+}
+
+func (m *defaultImGroupAnnouncementModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImGroupAnnouncementIdPrefix, primary)
+}
+
+func (m *defaultImGroupAnnouncementModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupAnnouncementRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImGroupAnnouncementModel) tableName() string {
+	return m.table
+}
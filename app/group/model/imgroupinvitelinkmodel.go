@@ -0,0 +1,103 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImGroupInviteLinkModel = (*customImGroupInviteLinkModel)(nil)
+
+type (
+	// ImGroupInviteLinkModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImGroupInviteLinkModel.
+	ImGroupInviteLinkModel interface {
+		imGroupInviteLinkModel
+		// 占用一次使用次数（链接有效、未过期且未用完时成功）
+		Consume(ctx context.Context, data *ImGroupInviteLink) (bool, error)
+		// 归还一次已占用的使用次数（兑换失败时）
+		Release(ctx context.Context, data *ImGroupInviteLink) error
+		// 按群组查询邀请链接(分页)，creatorId 为 0 时不按创建人过滤
+		FindByGroupId(ctx context.Context, groupId string, creatorId uint64, page, pageSize int64) ([]*ImGroupInviteLink, error)
+		// 统计群组的邀请链接数量，creatorId 为 0 时不按创建人过滤
+		CountByGroupId(ctx context.Context, groupId string, creatorId uint64) (int64, error)
+	}
+
+	customImGroupInviteLinkModel struct {
+		*defaultImGroupInviteLinkModel
+	}
+)
+
+// NewImGroupInviteLinkModel returns a model for the database table.
+func NewImGroupInviteLinkModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupInviteLinkModel {
+	return &customImGroupInviteLinkModel{
+		defaultImGroupInviteLinkModel: newImGroupInviteLinkModel(conn, c, opts...),
+	}
+}
+
+// Consume 以有效、未过期、未用完为条件增加使用次数，并发兑换时不会超过最大使用次数
+// 返回 false 表示链接已失效；成功后 data.UseCount 同步加1
+func (m *customImGroupInviteLinkModel) Consume(ctx context.Context, data *ImGroupInviteLink) (bool, error) {
+	idKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, data.Id)
+	codeKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkCodePrefix, data.Code)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `use_count` = `use_count` + 1 where `id` = ? and `status` = 1 and `expire_at` > ? and (`max_uses` = 0 or `use_count` < `max_uses`)", m.table)
+		return conn.ExecCtx(ctx, query, data.Id, time.Now())
+	}, idKey, codeKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+	data.UseCount++
+	return true, nil
+}
+
+// Release 归还 Consume 占用的使用次数，兑换在占用次数后失败时调用
+func (m *customImGroupInviteLinkModel) Release(ctx context.Context, data *ImGroupInviteLink) error {
+	idKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, data.Id)
+	codeKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkCodePrefix, data.Code)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `use_count` = `use_count` - 1 where `id` = ? and `use_count` > 0", m.table)
+		return conn.ExecCtx(ctx, query, data.Id)
+	}, idKey, codeKey)
+	if err != nil {
+		return err
+	}
+	if data.UseCount > 0 {
+		data.UseCount--
+	}
+	return nil
+}
+
+// FindByGroupId 按群组查询邀请链接(分页)，按创建时间倒序
+func (m *customImGroupInviteLinkModel) FindByGroupId(ctx context.Context, groupId string, creatorId uint64, page, pageSize int64) ([]*ImGroupInviteLink, error) {
+	var resp []*ImGroupInviteLink
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf("select %s from %s where `group_id` = ? and (? = 0 or `creator_id` = ?) order by `id` desc limit ? offset ?", imGroupInviteLinkRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId, creatorId, creatorId, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CountByGroupId 统计群组的邀请链接数量
+func (m *customImGroupInviteLinkModel) CountByGroupId(ctx context.Context, groupId string, creatorId uint64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `group_id` = ? and (? = 0 or `creator_id` = ?)", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, groupId, creatorId, creatorId)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,156 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imGroupInviteLinkFieldNames          = builder.RawFieldNames(&ImGroupInviteLink{})
+	imGroupInviteLinkRows                = strings.Join(imGroupInviteLinkFieldNames, ",")
+	imGroupInviteLinkRowsExpectAutoSet   = strings.Join(stringx.Remove(imGroupInviteLinkFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imGroupInviteLinkRowsWithPlaceHolder = strings.Join(stringx.Remove(imGroupInviteLinkFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImGroupInviteLinkIdPrefix   = "cache:imAuth:imGroupInviteLink:id:"
+	cacheImAuthImGroupInviteLinkCodePrefix = "cache:imAuth:imGroupInviteLink:code:"
+)
+
+type (
+	imGroupInviteLinkModel interface {
+		Insert(ctx context.Context, data *ImGroupInviteLink) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImGroupInviteLink, error)
+		FindOneByCode(ctx context.Context, code string) (*ImGroupInviteLink, error)
+		Update(ctx context.Context, data *ImGroupInviteLink) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImGroupInviteLinkModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImGroupInviteLink struct {
+		Id          uint64    `db:"id"`
+		Code        string    `db:"code"`         // 邀请码（随机生成，用于分享链接和二维码）
+		GroupId     string    `db:"group_id"`     // 群组ID
+		CreatorId   uint64    `db:"creator_id"`   // 创建人ID（群主/管理员）
+		ExpireAt    time.Time `db:"expire_at"`    // 过期时间
+		MaxUses     int64     `db:"max_uses"`     // 最大使用次数，0 表示不限
+		UseCount    int64     `db:"use_count"`    // 已使用次数
+		AutoApprove int64     `db:"auto_approve"` // 通过链接直接入群: 0-否（提交入群申请） 1-是（免审核）
+		Status      int64     `db:"status"`       // 状态: 1-有效 2-已撤销
+		RevokedBy   uint64    `db:"revoked_by"`   // 撤销人ID
+		CreatedAt   time.Time `db:"created_at"`
+		UpdatedAt   time.Time `db:"updated_at"`
+	}
+)
+
+func newImGroupInviteLinkModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImGroupInviteLinkModel {
+	return &defaultImGroupInviteLinkModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_group_invite_link`",
+	}
+}
+
+func (m *defaultImGroupInviteLinkModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupInviteLinkCodeKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkCodePrefix, data.Code)
+	imAuthImGroupInviteLinkIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImGroupInviteLinkCodeKey, imAuthImGroupInviteLinkIdKey)
+	return err
+}
+
+func (m *defaultImGroupInviteLinkModel) FindOne(ctx context.Context, id uint64) (*ImGroupInviteLink, error) {
+	imAuthImGroupInviteLinkIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, id)
+	var resp ImGroupInviteLink
+	err := m.QueryRowCtx(ctx, &resp, imAuthImGroupInviteLinkIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupInviteLinkRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupInviteLinkModel) FindOneByCode(ctx context.Context, code string) (*ImGroupInviteLink, error) {
+	imAuthImGroupInviteLinkCodeKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkCodePrefix, code)
+	var resp ImGroupInviteLink
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImGroupInviteLinkCodeKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `code` = ? limit 1", imGroupInviteLinkRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, code); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupInviteLinkModel) Insert(ctx context.Context, data *ImGroupInviteLink) (sql.Result, error) {
+	imAuthImGroupInviteLinkCodeKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkCodePrefix, data.Code)
+	imAuthImGroupInviteLinkIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imGroupInviteLinkRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Code, data.GroupId, data.CreatorId, data.ExpireAt, data.MaxUses, data.UseCount, data.AutoApprove, data.Status, data.RevokedBy)
+	}, imAuthImGroupInviteLinkCodeKey, imAuthImGroupInviteLinkIdKey)
+	return ret, err
+}
+
+func (m *defaultImGroupInviteLinkModel) Update(ctx context.Context, newData *ImGroupInviteLink) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupInviteLinkCodeKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkCodePrefix, data.Code)
+	imAuthImGroupInviteLinkIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupInviteLinkRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.Code, newData.GroupId, newData.CreatorId, newData.ExpireAt, newData.MaxUses, newData.UseCount, newData.AutoApprove, newData.Status, newData.RevokedBy, newData.Id)
+	}, imAuthImGroupInviteLinkCodeKey, imAuthImGroupInviteLinkIdKey)
+	return err
+}
+
+func (m *defaultImGroupInviteLinkModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImGroupInviteLinkIdPrefix, primary)
+}
+
+func (m *defaultImGroupInviteLinkModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupInviteLinkRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImGroupInviteLinkModel) tableName() string {
+	return m.table
+}
@@ -47,6 +47,7 @@ type (
 		GroupId   string        `db:"group_id"`   // 群组ID
 		UserId    uint64        `db:"user_id"`    // 申请人ID
 		Message   string        `db:"message"`    // 申请理由
+		Answer    string        `db:"answer"`     // 入群问题回答（回答问题入群且需人工审核时）
 		Status    int64         `db:"status"`     // 0-待处理 1-已同意 2-已拒绝
 		HandlerId sql.NullInt64 `db:"handler_id"` // 处理人ID（群主/管理员）
 		CreatedAt time.Time     `db:"created_at"`
@@ -117,8 +118,8 @@ func (m *defaultImGroupJoinRequestModel) Insert(ctx context.Context, data *ImGro
 	imAuthImGroupJoinRequestGroupIdUserIdStatusKey := fmt.Sprintf("%s%v:%v:%v", cacheImAuthImGroupJoinRequestGroupIdUserIdStatusPrefix, data.GroupId, data.UserId, data.Status)
 	imAuthImGroupJoinRequestIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupJoinRequestIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, imGroupJoinRequestRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.GroupId, data.UserId, data.Message, data.Status, data.HandlerId)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, imGroupJoinRequestRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.UserId, data.Message, data.Answer, data.Status, data.HandlerId)
 	}, imAuthImGroupJoinRequestGroupIdUserIdStatusKey, imAuthImGroupJoinRequestIdKey)
 	return ret, err
 }
@@ -133,7 +134,7 @@ func (m *defaultImGroupJoinRequestModel) Update(ctx context.Context, newData *Im
 	imAuthImGroupJoinRequestIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupJoinRequestIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupJoinRequestRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.GroupId, newData.UserId, newData.Message, newData.Status, newData.HandlerId, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.GroupId, newData.UserId, newData.Message, newData.Answer, newData.Status, newData.HandlerId, newData.Id)
 	}, imAuthImGroupJoinRequestGroupIdUserIdStatusKey, imAuthImGroupJoinRequestIdKey)
 	return err
 }
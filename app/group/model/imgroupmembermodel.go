@@ -22,7 +22,8 @@ type (
 		CountByUserId(ctx context.Context, userId int64) (int64, error)
 		DeleteByGroupIdUserId(ctx context.Context, groupId string, userId int64) error
 		UpdateReadSeq(ctx context.Context, groupId string, userId int64, readSeq uint64) error
-		FindManagedGroupsByUserId(ctx context.Context, userId int64) ([]string, error)
+		FindGroupsWithPermission(ctx context.Context, userId int64, perm int64) ([]string, error)
+		FindEarliestAdmin(ctx context.Context, groupId string) (*ImGroupMember, error)
 	}
 
 	customImGroupMemberModel struct {
@@ -30,6 +31,9 @@ type (
 	}
 )
 
+// imGroupMemberTable 群成员表名（群主转让事务中与群组表一起更新）
+const imGroupMemberTable = "`im_group_member`"
+
 // NewImGroupMemberModel returns a model for the database table.
 func NewImGroupMemberModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupMemberModel {
 	return &customImGroupMemberModel{
@@ -116,11 +120,15 @@ func (m *customImGroupMemberModel) UpdateReadSeq(ctx context.Context, groupId st
 	return err
 }
 
-// FindManagedGroupsByUserId 查询用户作为管理员/群主的所有群组ID
-func (m *customImGroupMemberModel) FindManagedGroupsByUserId(ctx context.Context, userId int64) ([]string, error) {
+// FindGroupsWithPermission 查询用户拥有 perm 权限的所有正常群组ID（群主拥有全部权限，
+// 管理员和普通成员按群组的 admin_permissions / member_permissions 位掩码判断）
+func (m *customImGroupMemberModel) FindGroupsWithPermission(ctx context.Context, userId int64, perm int64) ([]string, error) {
 	var groupIds []string
-	query := fmt.Sprintf("select `group_id` from %s where `user_id` = ? and `role` in (1, 2)", m.table)
-	err := m.QueryRowsNoCacheCtx(ctx, &groupIds, query, userId)
+	query := fmt.Sprintf("select m.`group_id` from %s m join `im_group` g on g.`group_id` = m.`group_id` "+
+		"where m.`user_id` = ? and g.`status` = 1 and (m.`role` = 1 "+
+		"or (m.`role` = 2 and g.`admin_permissions` & ? = ?) "+
+		"or (m.`role` = 3 and g.`member_permissions` & ? = ?))", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &groupIds, query, userId, perm, perm, perm, perm)
 	switch err {
 	case nil:
 		return groupIds, nil
@@ -128,3 +136,18 @@ func (m *customImGroupMemberModel) FindManagedGroupsByUserId(ctx context.Context
 		return nil, err
 	}
 }
+
+// FindEarliestAdmin 查询入群最早的管理员（群主退群时自动转让），没有管理员时返回 ErrNotFound
+func (m *customImGroupMemberModel) FindEarliestAdmin(ctx context.Context, groupId string) (*ImGroupMember, error) {
+	var resp ImGroupMember
+	query := fmt.Sprintf("select %s from %s where `group_id` = ? and `role` = 2 order by `joined_at` asc, `id` asc limit 1", imGroupMemberRows, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, groupId)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -43,14 +43,15 @@ type (
 	}
 
 	ImGroupMember struct {
-		Id       int64          `db:"id"`
-		GroupId  string         `db:"group_id"` // 群组ID
-		UserId   int64          `db:"user_id"`  // 用户ID
-		Role     int64          `db:"role"`     // 角色: 1-群主 2-管理员 3-普通成员
-		Nickname sql.NullString `db:"nickname"` // 群昵称
-		Mute     int64          `db:"mute"`     // 是否禁言: 0-否 1-是
-		ReadSeq  uint64         `db:"read_seq"` // 已读消息Seq
-		JoinedAt time.Time      `db:"joined_at"`
+		Id        int64          `db:"id"`
+		GroupId   string         `db:"group_id"`   // 群组ID
+		UserId    int64          `db:"user_id"`    // 用户ID
+		Role      int64          `db:"role"`       // 角色: 1-群主 2-管理员 3-普通成员
+		Nickname  sql.NullString `db:"nickname"`   // 群昵称
+		Mute      int64          `db:"mute"`       // 是否禁言: 0-否 1-是
+		MuteUntil int64          `db:"mute_until"` // 禁言截止时间戳(秒): 0-永久禁言（mute=1时有效），到期后自动解除
+		ReadSeq   uint64         `db:"read_seq"`   // 已读消息Seq
+		JoinedAt  time.Time      `db:"joined_at"`
 	}
 )
 
@@ -117,8 +118,8 @@ func (m *defaultImGroupMemberModel) Insert(ctx context.Context, data *ImGroupMem
 	imGroupMemberGroupIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImGroupMemberGroupIdUserIdPrefix, data.GroupId, data.UserId)
 	imGroupMemberIdKey := fmt.Sprintf("%s%v", cacheImGroupMemberIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, imGroupMemberRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.GroupId, data.UserId, data.Role, data.Nickname, data.Mute, data.ReadSeq, data.JoinedAt)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, imGroupMemberRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.UserId, data.Role, data.Nickname, data.Mute, data.MuteUntil, data.ReadSeq, data.JoinedAt)
 	}, imGroupMemberGroupIdUserIdKey, imGroupMemberIdKey)
 	return ret, err
 }
@@ -133,7 +134,7 @@ func (m *defaultImGroupMemberModel) Update(ctx context.Context, newData *ImGroup
 	imGroupMemberIdKey := fmt.Sprintf("%s%v", cacheImGroupMemberIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupMemberRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.GroupId, newData.UserId, newData.Role, newData.Nickname, newData.Mute, newData.ReadSeq, newData.JoinedAt, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.GroupId, newData.UserId, newData.Role, newData.Nickname, newData.Mute, newData.MuteUntil, newData.ReadSeq, newData.JoinedAt, newData.Id)
 	}, imGroupMemberGroupIdUserIdKey, imGroupMemberIdKey)
 	return err
 }
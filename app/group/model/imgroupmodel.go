@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
@@ -17,6 +18,7 @@ type (
 		imGroupModel
 		SearchByKeyword(ctx context.Context, keyword string) ([]*ImGroup, error)
 		FindOneByName(ctx context.Context, name string) (*ImGroup, error)
+		TransferOwner(ctx context.Context, data *ImGroup, oldOwner, newOwner *ImGroupMember) error
 	}
 
 	customImGroupModel struct {
@@ -27,8 +29,8 @@ type (
 func (m *customImGroupModel) SearchByKeyword(ctx context.Context, keyword string) ([]*ImGroup, error) {
 	var resp []*ImGroup
 	likeKeyword := "%" + keyword + "%"
-	// 同时模糊匹配群号和群名
-	query := fmt.Sprintf("select %s from %s where `group_id` like ? or `name` like ? limit 50", imGroupRows, m.table)
+	// 同时模糊匹配群号和群名，不可被搜索的群组不返回
+	query := fmt.Sprintf("select %s from %s where `discoverable` = 1 and (`group_id` like ? or `name` like ?) limit 50", imGroupRows, m.table)
 	err := m.CachedConn.QueryRowsNoCacheCtx(ctx, &resp, query, likeKeyword, likeKeyword)
 	return resp, err
 }
@@ -47,6 +49,48 @@ func (m *customImGroupModel) FindOneByName(ctx context.Context, name string) (*I
 	}
 }
 
+// ErrOwnerChanged 转让群主时群主已发生变化（并发转让或群已解散）
+var ErrOwnerChanged = errors.New("group owner changed")
+
+// TransferOwner 在同一事务中转让群主：更新群主ID，原群主降为普通成员，新群主角色设为群主
+// 以原群主ID为条件更新，并发转让时只有一次成功，其余返回 ErrOwnerChanged；成功后 data.OwnerId 同步为新群主
+func (m *customImGroupModel) TransferOwner(ctx context.Context, data *ImGroup, oldOwner, newOwner *ImGroupMember) error {
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set `owner_id` = ? where `id` = ? and `owner_id` = ? and `status` = 1", m.table)
+		result, err := session.ExecCtx(ctx, query, newOwner.UserId, data.Id, oldOwner.UserId)
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrOwnerChanged
+		}
+
+		query = fmt.Sprintf("update %s set `role` = ? where `id` = ?", imGroupMemberTable)
+		if _, err := session.ExecCtx(ctx, query, 3, oldOwner.Id); err != nil {
+			return err
+		}
+		_, err = session.ExecCtx(ctx, query, 1, newOwner.Id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	data.OwnerId = newOwner.UserId
+	oldOwner.Role, newOwner.Role = 3, 1
+
+	// 事务提交后清理群组和两位成员的缓存
+	return m.DelCacheCtx(ctx,
+		fmt.Sprintf("%s%v", cacheImAuthImGroupIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheImAuthImGroupGroupIdPrefix, data.GroupId),
+		fmt.Sprintf("%s%v", cacheImGroupMemberIdPrefix, oldOwner.Id),
+		fmt.Sprintf("%s%v:%v", cacheImGroupMemberGroupIdUserIdPrefix, oldOwner.GroupId, oldOwner.UserId),
+		fmt.Sprintf("%s%v", cacheImGroupMemberIdPrefix, newOwner.Id),
+		fmt.Sprintf("%s%v:%v", cacheImGroupMemberGroupIdUserIdPrefix, newOwner.GroupId, newOwner.UserId),
+	)
+}
+
 // NewImGroupModel returns a model for the database table.
 func NewImGroupModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupModel {
 	return &customImGroupModel{
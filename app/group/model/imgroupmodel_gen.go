@@ -53,6 +53,13 @@ type (
 		MemberCount       int64          `db:"member_count"`        // 当前成员数
 		Status            int64          `db:"status"`              // 状态: 1-正常 2-已解散
 		InviteConfirmMode int64          `db:"invite_confirm_mode"` // 邀请确认模式: 0-直接加入 1-需要确认（默认）
+		MuteAll           int64          `db:"mute_all"`            // 全员禁言: 0-否 1-是（仅群主和管理员可以发言）
+		JoinPolicy        int64          `db:"join_policy"`         // 入群方式: 1-自由加入 2-需要审核（默认） 3-回答问题 4-仅限邀请 5-禁止加入
+		JoinQuestion      string         `db:"join_question"`       // 入群问题（join_policy=3时有效）
+		JoinAnswer        string         `db:"join_answer"`         // 入群问题答案: 为空时人工审核回答，否则自动校验
+		Discoverable      int64          `db:"discoverable"`        // 是否可被搜索: 0-否 1-是
+		AdminPermissions  int64          `db:"admin_permissions"`   // 管理员权限位掩码: 1-邀请成员 2-移出成员 4-禁言 8-修改群信息 16-@全体成员 32-置顶消息 64-群公告 128-审批入群
+		MemberPermissions int64          `db:"member_permissions"`  // 普通成员权限位掩码（同管理员），群主始终拥有全部权限
 		CreatedAt         time.Time      `db:"created_at"`
 		UpdatedAt         time.Time      `db:"updated_at"`
 	}
@@ -121,8 +128,8 @@ func (m *defaultImGroupModel) Insert(ctx context.Context, data *ImGroup) (sql.Re
 	imAuthImGroupGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupGroupIdPrefix, data.GroupId)
 	imAuthImGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imGroupRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.GroupId, data.Name, data.Avatar, data.OwnerId, data.Description, data.MaxMembers, data.MemberCount, data.Status, data.InviteConfirmMode)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imGroupRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.Name, data.Avatar, data.OwnerId, data.Description, data.MaxMembers, data.MemberCount, data.Status, data.InviteConfirmMode, data.MuteAll, data.JoinPolicy, data.JoinQuestion, data.JoinAnswer, data.Discoverable, data.AdminPermissions, data.MemberPermissions)
 	}, imAuthImGroupGroupIdKey, imAuthImGroupIdKey)
 	return ret, err
 }
@@ -137,7 +144,7 @@ func (m *defaultImGroupModel) Update(ctx context.Context, newData *ImGroup) erro
 	imAuthImGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.GroupId, newData.Name, newData.Avatar, newData.OwnerId, newData.Description, newData.MaxMembers, newData.MemberCount, newData.Status, newData.InviteConfirmMode, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.GroupId, newData.Name, newData.Avatar, newData.OwnerId, newData.Description, newData.MaxMembers, newData.MemberCount, newData.Status, newData.InviteConfirmMode, newData.MuteAll, newData.JoinPolicy, newData.JoinQuestion, newData.JoinAnswer, newData.Discoverable, newData.AdminPermissions, newData.MemberPermissions, newData.Id)
 	}, imAuthImGroupGroupIdKey, imAuthImGroupIdKey)
 	return err
 }
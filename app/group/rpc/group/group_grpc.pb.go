@@ -43,8 +43,12 @@ type GroupClient interface {
 	GetUserGroupList(ctx context.Context, in *GetUserGroupListReq, opts ...grpc.CallOption) (*GetUserGroupListResp, error)
 	// 设置成员权限
 	SetMemberRole(ctx context.Context, in *SetMemberRoleReq, opts ...grpc.CallOption) (*SetMemberRoleResp, error)
+	// 转让群主
+	TransferOwnership(ctx context.Context, in *TransferOwnershipReq, opts ...grpc.CallOption) (*TransferOwnershipResp, error)
 	// 设置成员禁言
 	SetMemberMute(ctx context.Context, in *SetMemberMuteReq, opts ...grpc.CallOption) (*SetMemberMuteResp, error)
+	// 设置全员禁言
+	SetGroupMuteAll(ctx context.Context, in *SetGroupMuteAllReq, opts ...grpc.CallOption) (*SetGroupMuteAllResp, error)
 	// 检查用户是否在群组中
 	CheckMembership(ctx context.Context, in *CheckMembershipReq, opts ...grpc.CallOption) (*CheckMembershipResp, error)
 	// 更新群组已读进度
@@ -65,14 +69,51 @@ type GroupClient interface {
 	// ==================== 入群申请相关 ====================
 	// 发送入群申请
 	SendJoinRequest(ctx context.Context, in *SendJoinRequestReq, opts ...grpc.CallOption) (*SendJoinRequestResp, error)
-	// 处理入群申请（群主/管理员）
+	// 处理入群申请（需要审批入群申请权限）
 	HandleJoinRequest(ctx context.Context, in *HandleJoinRequestReq, opts ...grpc.CallOption) (*HandleJoinRequestResp, error)
-	// 获取群的入群申请列表（群主/管理员查看）
+	// 获取群的入群申请列表（需要审批入群申请权限）
 	GetGroupJoinRequests(ctx context.Context, in *GetGroupJoinRequestsReq, opts ...grpc.CallOption) (*GetGroupJoinRequestsResp, error)
 	// 获取用户发出的入群申请
 	GetSentJoinRequests(ctx context.Context, in *GetSentJoinRequestsReq, opts ...grpc.CallOption) (*GetSentJoinRequestsResp, error)
 	// 获取所有管理群组的入群申请（通知中心）
 	GetAllManagedGroupJoinRequests(ctx context.Context, in *GetAllManagedGroupJoinRequestsReq, opts ...grpc.CallOption) (*GetAllManagedGroupJoinRequestsResp, error)
+	// 设置入群方式、入群问题和是否可被搜索（需要修改群信息权限）
+	SetJoinPolicy(ctx context.Context, in *SetJoinPolicyReq, opts ...grpc.CallOption) (*SetJoinPolicyResp, error)
+	// 获取入群设置（需要修改群信息权限，包含入群问题答案）
+	GetJoinPolicy(ctx context.Context, in *GetJoinPolicyReq, opts ...grpc.CallOption) (*GetJoinPolicyResp, error)
+	// ==================== 群公告相关 ====================
+	// 发布群公告（需要管理群公告权限）
+	PublishAnnouncement(ctx context.Context, in *PublishAnnouncementReq, opts ...grpc.CallOption) (*PublishAnnouncementResp, error)
+	// 编辑群公告（需要管理群公告权限）
+	UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementReq, opts ...grpc.CallOption) (*UpdateAnnouncementResp, error)
+	// 删除群公告（需要管理群公告权限）
+	DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementReq, opts ...grpc.CallOption) (*DeleteAnnouncementResp, error)
+	// 置顶/取消置顶群公告（需要管理群公告权限）
+	PinAnnouncement(ctx context.Context, in *PinAnnouncementReq, opts ...grpc.CallOption) (*PinAnnouncementResp, error)
+	// 获取群公告列表（置顶公告在前）
+	GetAnnouncementList(ctx context.Context, in *GetAnnouncementListReq, opts ...grpc.CallOption) (*GetAnnouncementListResp, error)
+	// 获取群公告编辑历史
+	GetAnnouncementHistory(ctx context.Context, in *GetAnnouncementHistoryReq, opts ...grpc.CallOption) (*GetAnnouncementHistoryResp, error)
+	// 确认已读群公告
+	ConfirmAnnouncement(ctx context.Context, in *ConfirmAnnouncementReq, opts ...grpc.CallOption) (*ConfirmAnnouncementResp, error)
+	// 获取群公告确认情况（需要管理群公告权限）
+	GetAnnouncementConfirmations(ctx context.Context, in *GetAnnouncementConfirmationsReq, opts ...grpc.CallOption) (*GetAnnouncementConfirmationsResp, error)
+	// ==================== 群邀请链接相关 ====================
+	// 创建群邀请链接（需要邀请成员权限，免审核链接还需要审批入群申请权限）
+	CreateInviteLink(ctx context.Context, in *CreateInviteLinkReq, opts ...grpc.CallOption) (*CreateInviteLinkResp, error)
+	// 撤销群邀请链接（创建人或有审批入群申请权限的成员）
+	RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkReq, opts ...grpc.CallOption) (*RevokeInviteLinkResp, error)
+	// 获取群邀请链接列表（需要邀请成员权限，没有审批入群申请权限时只返回自己创建的链接）
+	GetInviteLinks(ctx context.Context, in *GetInviteLinksReq, opts ...grpc.CallOption) (*GetInviteLinksResp, error)
+	// 按邀请码查询邀请链接和群组信息（入群落地页、二维码）
+	GetInviteLink(ctx context.Context, in *GetInviteLinkReq, opts ...grpc.CallOption) (*GetInviteLinkResp, error)
+	// 通过邀请链接入群（免审核时直接入群，否则提交入群申请）
+	RedeemInviteLink(ctx context.Context, in *RedeemInviteLinkReq, opts ...grpc.CallOption) (*RedeemInviteLinkResp, error)
+	// ==================== 群权限相关 ====================
+	// 设置管理员和普通成员的权限（仅群主）
+	SetGroupPermissions(ctx context.Context, in *SetGroupPermissionsReq, opts ...grpc.CallOption) (*SetGroupPermissionsResp, error)
+	// 获取群权限配置和当前用户的有效权限（群成员）
+	GetGroupPermissions(ctx context.Context, in *GetGroupPermissionsReq, opts ...grpc.CallOption) (*GetGroupPermissionsResp, error)
 }
 
 type groupClient struct {
@@ -173,6 +214,15 @@ func (c *groupClient) SetMemberRole(ctx context.Context, in *SetMemberRoleReq, o
 	return out, nil
 }
 
+func (c *groupClient) TransferOwnership(ctx context.Context, in *TransferOwnershipReq, opts ...grpc.CallOption) (*TransferOwnershipResp, error) {
+	out := new(TransferOwnershipResp)
+	err := c.cc.Invoke(ctx, "/group.Group/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupClient) SetMemberMute(ctx context.Context, in *SetMemberMuteReq, opts ...grpc.CallOption) (*SetMemberMuteResp, error) {
 	out := new(SetMemberMuteResp)
 	err := c.cc.Invoke(ctx, "/group.Group/SetMemberMute", in, out, opts...)
@@ -182,6 +232,15 @@ func (c *groupClient) SetMemberMute(ctx context.Context, in *SetMemberMuteReq, o
 	return out, nil
 }
 
+func (c *groupClient) SetGroupMuteAll(ctx context.Context, in *SetGroupMuteAllReq, opts ...grpc.CallOption) (*SetGroupMuteAllResp, error) {
+	out := new(SetGroupMuteAllResp)
+	err := c.cc.Invoke(ctx, "/group.Group/SetGroupMuteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupClient) CheckMembership(ctx context.Context, in *CheckMembershipReq, opts ...grpc.CallOption) (*CheckMembershipResp, error) {
 	out := new(CheckMembershipResp)
 	err := c.cc.Invoke(ctx, "/group.Group/CheckMembership", in, out, opts...)
@@ -299,6 +358,159 @@ func (c *groupClient) GetAllManagedGroupJoinRequests(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *groupClient) SetJoinPolicy(ctx context.Context, in *SetJoinPolicyReq, opts ...grpc.CallOption) (*SetJoinPolicyResp, error) {
+	out := new(SetJoinPolicyResp)
+	err := c.cc.Invoke(ctx, "/group.Group/SetJoinPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetJoinPolicy(ctx context.Context, in *GetJoinPolicyReq, opts ...grpc.CallOption) (*GetJoinPolicyResp, error) {
+	out := new(GetJoinPolicyResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetJoinPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) PublishAnnouncement(ctx context.Context, in *PublishAnnouncementReq, opts ...grpc.CallOption) (*PublishAnnouncementResp, error) {
+	out := new(PublishAnnouncementResp)
+	err := c.cc.Invoke(ctx, "/group.Group/PublishAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementReq, opts ...grpc.CallOption) (*UpdateAnnouncementResp, error) {
+	out := new(UpdateAnnouncementResp)
+	err := c.cc.Invoke(ctx, "/group.Group/UpdateAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementReq, opts ...grpc.CallOption) (*DeleteAnnouncementResp, error) {
+	out := new(DeleteAnnouncementResp)
+	err := c.cc.Invoke(ctx, "/group.Group/DeleteAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) PinAnnouncement(ctx context.Context, in *PinAnnouncementReq, opts ...grpc.CallOption) (*PinAnnouncementResp, error) {
+	out := new(PinAnnouncementResp)
+	err := c.cc.Invoke(ctx, "/group.Group/PinAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetAnnouncementList(ctx context.Context, in *GetAnnouncementListReq, opts ...grpc.CallOption) (*GetAnnouncementListResp, error) {
+	out := new(GetAnnouncementListResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetAnnouncementList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetAnnouncementHistory(ctx context.Context, in *GetAnnouncementHistoryReq, opts ...grpc.CallOption) (*GetAnnouncementHistoryResp, error) {
+	out := new(GetAnnouncementHistoryResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetAnnouncementHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) ConfirmAnnouncement(ctx context.Context, in *ConfirmAnnouncementReq, opts ...grpc.CallOption) (*ConfirmAnnouncementResp, error) {
+	out := new(ConfirmAnnouncementResp)
+	err := c.cc.Invoke(ctx, "/group.Group/ConfirmAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetAnnouncementConfirmations(ctx context.Context, in *GetAnnouncementConfirmationsReq, opts ...grpc.CallOption) (*GetAnnouncementConfirmationsResp, error) {
+	out := new(GetAnnouncementConfirmationsResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetAnnouncementConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) CreateInviteLink(ctx context.Context, in *CreateInviteLinkReq, opts ...grpc.CallOption) (*CreateInviteLinkResp, error) {
+	out := new(CreateInviteLinkResp)
+	err := c.cc.Invoke(ctx, "/group.Group/CreateInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkReq, opts ...grpc.CallOption) (*RevokeInviteLinkResp, error) {
+	out := new(RevokeInviteLinkResp)
+	err := c.cc.Invoke(ctx, "/group.Group/RevokeInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetInviteLinks(ctx context.Context, in *GetInviteLinksReq, opts ...grpc.CallOption) (*GetInviteLinksResp, error) {
+	out := new(GetInviteLinksResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetInviteLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetInviteLink(ctx context.Context, in *GetInviteLinkReq, opts ...grpc.CallOption) (*GetInviteLinkResp, error) {
+	out := new(GetInviteLinkResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) RedeemInviteLink(ctx context.Context, in *RedeemInviteLinkReq, opts ...grpc.CallOption) (*RedeemInviteLinkResp, error) {
+	out := new(RedeemInviteLinkResp)
+	err := c.cc.Invoke(ctx, "/group.Group/RedeemInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupPermissions(ctx context.Context, in *SetGroupPermissionsReq, opts ...grpc.CallOption) (*SetGroupPermissionsResp, error) {
+	out := new(SetGroupPermissionsResp)
+	err := c.cc.Invoke(ctx, "/group.Group/SetGroupPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupPermissions(ctx context.Context, in *GetGroupPermissionsReq, opts ...grpc.CallOption) (*GetGroupPermissionsResp, error) {
+	out := new(GetGroupPermissionsResp)
+	err := c.cc.Invoke(ctx, "/group.Group/GetGroupPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServer is the server API for Group service.
 // All implementations must embed UnimplementedGroupServer
 // for forward compatibility
@@ -323,8 +535,12 @@ type GroupServer interface {
 	GetUserGroupList(context.Context, *GetUserGroupListReq) (*GetUserGroupListResp, error)
 	// 设置成员权限
 	SetMemberRole(context.Context, *SetMemberRoleReq) (*SetMemberRoleResp, error)
+	// 转让群主
+	TransferOwnership(context.Context, *TransferOwnershipReq) (*TransferOwnershipResp, error)
 	// 设置成员禁言
 	SetMemberMute(context.Context, *SetMemberMuteReq) (*SetMemberMuteResp, error)
+	// 设置全员禁言
+	SetGroupMuteAll(context.Context, *SetGroupMuteAllReq) (*SetGroupMuteAllResp, error)
 	// 检查用户是否在群组中
 	CheckMembership(context.Context, *CheckMembershipReq) (*CheckMembershipResp, error)
 	// 更新群组已读进度
@@ -345,14 +561,51 @@ type GroupServer interface {
 	// ==================== 入群申请相关 ====================
 	// 发送入群申请
 	SendJoinRequest(context.Context, *SendJoinRequestReq) (*SendJoinRequestResp, error)
-	// 处理入群申请（群主/管理员）
+	// 处理入群申请（需要审批入群申请权限）
 	HandleJoinRequest(context.Context, *HandleJoinRequestReq) (*HandleJoinRequestResp, error)
-	// 获取群的入群申请列表（群主/管理员查看）
+	// 获取群的入群申请列表（需要审批入群申请权限）
 	GetGroupJoinRequests(context.Context, *GetGroupJoinRequestsReq) (*GetGroupJoinRequestsResp, error)
 	// 获取用户发出的入群申请
 	GetSentJoinRequests(context.Context, *GetSentJoinRequestsReq) (*GetSentJoinRequestsResp, error)
 	// 获取所有管理群组的入群申请（通知中心）
 	GetAllManagedGroupJoinRequests(context.Context, *GetAllManagedGroupJoinRequestsReq) (*GetAllManagedGroupJoinRequestsResp, error)
+	// 设置入群方式、入群问题和是否可被搜索（需要修改群信息权限）
+	SetJoinPolicy(context.Context, *SetJoinPolicyReq) (*SetJoinPolicyResp, error)
+	// 获取入群设置（需要修改群信息权限，包含入群问题答案）
+	GetJoinPolicy(context.Context, *GetJoinPolicyReq) (*GetJoinPolicyResp, error)
+	// ==================== 群公告相关 ====================
+	// 发布群公告（需要管理群公告权限）
+	PublishAnnouncement(context.Context, *PublishAnnouncementReq) (*PublishAnnouncementResp, error)
+	// 编辑群公告（需要管理群公告权限）
+	UpdateAnnouncement(context.Context, *UpdateAnnouncementReq) (*UpdateAnnouncementResp, error)
+	// 删除群公告（需要管理群公告权限）
+	DeleteAnnouncement(context.Context, *DeleteAnnouncementReq) (*DeleteAnnouncementResp, error)
+	// 置顶/取消置顶群公告（需要管理群公告权限）
+	PinAnnouncement(context.Context, *PinAnnouncementReq) (*PinAnnouncementResp, error)
+	// 获取群公告列表（置顶公告在前）
+	GetAnnouncementList(context.Context, *GetAnnouncementListReq) (*GetAnnouncementListResp, error)
+	// 获取群公告编辑历史
+	GetAnnouncementHistory(context.Context, *GetAnnouncementHistoryReq) (*GetAnnouncementHistoryResp, error)
+	// 确认已读群公告
+	ConfirmAnnouncement(context.Context, *ConfirmAnnouncementReq) (*ConfirmAnnouncementResp, error)
+	// 获取群公告确认情况（需要管理群公告权限）
+	GetAnnouncementConfirmations(context.Context, *GetAnnouncementConfirmationsReq) (*GetAnnouncementConfirmationsResp, error)
+	// ==================== 群邀请链接相关 ====================
+	// 创建群邀请链接（需要邀请成员权限，免审核链接还需要审批入群申请权限）
+	CreateInviteLink(context.Context, *CreateInviteLinkReq) (*CreateInviteLinkResp, error)
+	// 撤销群邀请链接（创建人或有审批入群申请权限的成员）
+	RevokeInviteLink(context.Context, *RevokeInviteLinkReq) (*RevokeInviteLinkResp, error)
+	// 获取群邀请链接列表（需要邀请成员权限，没有审批入群申请权限时只返回自己创建的链接）
+	GetInviteLinks(context.Context, *GetInviteLinksReq) (*GetInviteLinksResp, error)
+	// 按邀请码查询邀请链接和群组信息（入群落地页、二维码）
+	GetInviteLink(context.Context, *GetInviteLinkReq) (*GetInviteLinkResp, error)
+	// 通过邀请链接入群（免审核时直接入群，否则提交入群申请）
+	RedeemInviteLink(context.Context, *RedeemInviteLinkReq) (*RedeemInviteLinkResp, error)
+	// ==================== 群权限相关 ====================
+	// 设置管理员和普通成员的权限（仅群主）
+	SetGroupPermissions(context.Context, *SetGroupPermissionsReq) (*SetGroupPermissionsResp, error)
+	// 获取群权限配置和当前用户的有效权限（群成员）
+	GetGroupPermissions(context.Context, *GetGroupPermissionsReq) (*GetGroupPermissionsResp, error)
 	mustEmbedUnimplementedGroupServer()
 }
 
@@ -390,9 +643,15 @@ func (UnimplementedGroupServer) GetUserGroupList(context.Context, *GetUserGroupL
 func (UnimplementedGroupServer) SetMemberRole(context.Context, *SetMemberRoleReq) (*SetMemberRoleResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMemberRole not implemented")
 }
+func (UnimplementedGroupServer) TransferOwnership(context.Context, *TransferOwnershipReq) (*TransferOwnershipResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
 func (UnimplementedGroupServer) SetMemberMute(context.Context, *SetMemberMuteReq) (*SetMemberMuteResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMemberMute not implemented")
 }
+func (UnimplementedGroupServer) SetGroupMuteAll(context.Context, *SetGroupMuteAllReq) (*SetGroupMuteAllResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupMuteAll not implemented")
+}
 func (UnimplementedGroupServer) CheckMembership(context.Context, *CheckMembershipReq) (*CheckMembershipResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckMembership not implemented")
 }
@@ -432,6 +691,57 @@ func (UnimplementedGroupServer) GetSentJoinRequests(context.Context, *GetSentJoi
 func (UnimplementedGroupServer) GetAllManagedGroupJoinRequests(context.Context, *GetAllManagedGroupJoinRequestsReq) (*GetAllManagedGroupJoinRequestsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllManagedGroupJoinRequests not implemented")
 }
+func (UnimplementedGroupServer) SetJoinPolicy(context.Context, *SetJoinPolicyReq) (*SetJoinPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetJoinPolicy not implemented")
+}
+func (UnimplementedGroupServer) GetJoinPolicy(context.Context, *GetJoinPolicyReq) (*GetJoinPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinPolicy not implemented")
+}
+func (UnimplementedGroupServer) PublishAnnouncement(context.Context, *PublishAnnouncementReq) (*PublishAnnouncementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) UpdateAnnouncement(context.Context, *UpdateAnnouncementReq) (*UpdateAnnouncementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) DeleteAnnouncement(context.Context, *DeleteAnnouncementReq) (*DeleteAnnouncementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) PinAnnouncement(context.Context, *PinAnnouncementReq) (*PinAnnouncementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) GetAnnouncementList(context.Context, *GetAnnouncementListReq) (*GetAnnouncementListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncementList not implemented")
+}
+func (UnimplementedGroupServer) GetAnnouncementHistory(context.Context, *GetAnnouncementHistoryReq) (*GetAnnouncementHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncementHistory not implemented")
+}
+func (UnimplementedGroupServer) ConfirmAnnouncement(context.Context, *ConfirmAnnouncementReq) (*ConfirmAnnouncementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAnnouncement not implemented")
+}
+func (UnimplementedGroupServer) GetAnnouncementConfirmations(context.Context, *GetAnnouncementConfirmationsReq) (*GetAnnouncementConfirmationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncementConfirmations not implemented")
+}
+func (UnimplementedGroupServer) CreateInviteLink(context.Context, *CreateInviteLinkReq) (*CreateInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteLink not implemented")
+}
+func (UnimplementedGroupServer) RevokeInviteLink(context.Context, *RevokeInviteLinkReq) (*RevokeInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteLink not implemented")
+}
+func (UnimplementedGroupServer) GetInviteLinks(context.Context, *GetInviteLinksReq) (*GetInviteLinksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInviteLinks not implemented")
+}
+func (UnimplementedGroupServer) GetInviteLink(context.Context, *GetInviteLinkReq) (*GetInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInviteLink not implemented")
+}
+func (UnimplementedGroupServer) RedeemInviteLink(context.Context, *RedeemInviteLinkReq) (*RedeemInviteLinkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInviteLink not implemented")
+}
+func (UnimplementedGroupServer) SetGroupPermissions(context.Context, *SetGroupPermissionsReq) (*SetGroupPermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupPermissions not implemented")
+}
+func (UnimplementedGroupServer) GetGroupPermissions(context.Context, *GetGroupPermissionsReq) (*GetGroupPermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupPermissions not implemented")
+}
 func (UnimplementedGroupServer) mustEmbedUnimplementedGroupServer() {}
 
 // UnsafeGroupServer may be embedded to opt out of forward compatibility for this service.
@@ -625,6 +935,24 @@ func _Group_SetMemberRole_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Group_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).TransferOwnership(ctx, req.(*TransferOwnershipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Group_SetMemberMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetMemberMuteReq)
 	if err := dec(in); err != nil {
@@ -643,6 +971,24 @@ func _Group_SetMemberMute_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Group_SetGroupMuteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMuteAllReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupMuteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/SetGroupMuteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupMuteAll(ctx, req.(*SetGroupMuteAllReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Group_CheckMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckMembershipReq)
 	if err := dec(in); err != nil {
@@ -877,6 +1223,312 @@ func _Group_GetAllManagedGroupJoinRequests_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Group_SetJoinPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJoinPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetJoinPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/SetJoinPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetJoinPolicy(ctx, req.(*SetJoinPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetJoinPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetJoinPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetJoinPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetJoinPolicy(ctx, req.(*GetJoinPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_PublishAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).PublishAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/PublishAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).PublishAnnouncement(ctx, req.(*PublishAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_UpdateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).UpdateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/UpdateAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).UpdateAnnouncement(ctx, req.(*UpdateAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_DeleteAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).DeleteAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/DeleteAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).DeleteAnnouncement(ctx, req.(*DeleteAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_PinAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).PinAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/PinAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).PinAnnouncement(ctx, req.(*PinAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetAnnouncementList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetAnnouncementList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetAnnouncementList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetAnnouncementList(ctx, req.(*GetAnnouncementListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetAnnouncementHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetAnnouncementHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetAnnouncementHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetAnnouncementHistory(ctx, req.(*GetAnnouncementHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ConfirmAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAnnouncementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ConfirmAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/ConfirmAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ConfirmAnnouncement(ctx, req.(*ConfirmAnnouncementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetAnnouncementConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementConfirmationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetAnnouncementConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetAnnouncementConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetAnnouncementConfirmations(ctx, req.(*GetAnnouncementConfirmationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_CreateInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).CreateInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/CreateInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).CreateInviteLink(ctx, req.(*CreateInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_RevokeInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).RevokeInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/RevokeInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).RevokeInviteLink(ctx, req.(*RevokeInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteLinksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetInviteLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetInviteLinks(ctx, req.(*GetInviteLinksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetInviteLink(ctx, req.(*GetInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_RedeemInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).RedeemInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/RedeemInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).RedeemInviteLink(ctx, req.(*RedeemInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupPermissionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/SetGroupPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupPermissions(ctx, req.(*SetGroupPermissionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupPermissionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.Group/GetGroupPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupPermissions(ctx, req.(*GetGroupPermissionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Group_ServiceDesc is the grpc.ServiceDesc for Group service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -924,10 +1576,18 @@ var Group_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMemberRole",
 			Handler:    _Group_SetMemberRole_Handler,
 		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Group_TransferOwnership_Handler,
+		},
 		{
 			MethodName: "SetMemberMute",
 			Handler:    _Group_SetMemberMute_Handler,
 		},
+		{
+			MethodName: "SetGroupMuteAll",
+			Handler:    _Group_SetGroupMuteAll_Handler,
+		},
 		{
 			MethodName: "CheckMembership",
 			Handler:    _Group_CheckMembership_Handler,
@@ -980,6 +1640,74 @@ var Group_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllManagedGroupJoinRequests",
 			Handler:    _Group_GetAllManagedGroupJoinRequests_Handler,
 		},
+		{
+			MethodName: "SetJoinPolicy",
+			Handler:    _Group_SetJoinPolicy_Handler,
+		},
+		{
+			MethodName: "GetJoinPolicy",
+			Handler:    _Group_GetJoinPolicy_Handler,
+		},
+		{
+			MethodName: "PublishAnnouncement",
+			Handler:    _Group_PublishAnnouncement_Handler,
+		},
+		{
+			MethodName: "UpdateAnnouncement",
+			Handler:    _Group_UpdateAnnouncement_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncement",
+			Handler:    _Group_DeleteAnnouncement_Handler,
+		},
+		{
+			MethodName: "PinAnnouncement",
+			Handler:    _Group_PinAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetAnnouncementList",
+			Handler:    _Group_GetAnnouncementList_Handler,
+		},
+		{
+			MethodName: "GetAnnouncementHistory",
+			Handler:    _Group_GetAnnouncementHistory_Handler,
+		},
+		{
+			MethodName: "ConfirmAnnouncement",
+			Handler:    _Group_ConfirmAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetAnnouncementConfirmations",
+			Handler:    _Group_GetAnnouncementConfirmations_Handler,
+		},
+		{
+			MethodName: "CreateInviteLink",
+			Handler:    _Group_CreateInviteLink_Handler,
+		},
+		{
+			MethodName: "RevokeInviteLink",
+			Handler:    _Group_RevokeInviteLink_Handler,
+		},
+		{
+			MethodName: "GetInviteLinks",
+			Handler:    _Group_GetInviteLinks_Handler,
+		},
+		{
+			MethodName: "GetInviteLink",
+			Handler:    _Group_GetInviteLink_Handler,
+		},
+		{
+			MethodName: "RedeemInviteLink",
+			Handler:    _Group_RedeemInviteLink_Handler,
+		},
+		{
+			MethodName: "SetGroupPermissions",
+			Handler:    _Group_SetGroupPermissions_Handler,
+		},
+		{
+			MethodName: "GetGroupPermissions",
+			Handler:    _Group_GetGroupPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group.proto",
@@ -14,18 +14,41 @@ import (
 )
 
 type (
+	AnnouncementConfirmInfo            = group.AnnouncementConfirmInfo
+	AnnouncementInfo                   = group.AnnouncementInfo
+	AnnouncementVersion                = group.AnnouncementVersion
 	CheckMembershipReq                 = group.CheckMembershipReq
 	CheckMembershipResp                = group.CheckMembershipResp
+	ConfirmAnnouncementReq             = group.ConfirmAnnouncementReq
+	ConfirmAnnouncementResp            = group.ConfirmAnnouncementResp
 	CreateGroupReq                     = group.CreateGroupReq
 	CreateGroupResp                    = group.CreateGroupResp
+	CreateInviteLinkReq                = group.CreateInviteLinkReq
+	CreateInviteLinkResp               = group.CreateInviteLinkResp
+	DeleteAnnouncementReq              = group.DeleteAnnouncementReq
+	DeleteAnnouncementResp             = group.DeleteAnnouncementResp
 	DismissGroupReq                    = group.DismissGroupReq
 	DismissGroupResp                   = group.DismissGroupResp
 	GetAllManagedGroupJoinRequestsReq  = group.GetAllManagedGroupJoinRequestsReq
 	GetAllManagedGroupJoinRequestsResp = group.GetAllManagedGroupJoinRequestsResp
+	GetAnnouncementConfirmationsReq    = group.GetAnnouncementConfirmationsReq
+	GetAnnouncementConfirmationsResp   = group.GetAnnouncementConfirmationsResp
+	GetAnnouncementHistoryReq          = group.GetAnnouncementHistoryReq
+	GetAnnouncementHistoryResp         = group.GetAnnouncementHistoryResp
+	GetAnnouncementListReq             = group.GetAnnouncementListReq
+	GetAnnouncementListResp            = group.GetAnnouncementListResp
 	GetGroupInfoReq                    = group.GetGroupInfoReq
 	GetGroupInfoResp                   = group.GetGroupInfoResp
 	GetGroupJoinRequestsReq            = group.GetGroupJoinRequestsReq
 	GetGroupJoinRequestsResp           = group.GetGroupJoinRequestsResp
+	GetGroupPermissionsReq             = group.GetGroupPermissionsReq
+	GetGroupPermissionsResp            = group.GetGroupPermissionsResp
+	GetInviteLinkReq                   = group.GetInviteLinkReq
+	GetInviteLinkResp                  = group.GetInviteLinkResp
+	GetInviteLinksReq                  = group.GetInviteLinksReq
+	GetInviteLinksResp                 = group.GetInviteLinksResp
+	GetJoinPolicyReq                   = group.GetJoinPolicyReq
+	GetJoinPolicyResp                  = group.GetJoinPolicyResp
 	GetJoinedGroupsReq                 = group.GetJoinedGroupsReq
 	GetJoinedGroupsResp                = group.GetJoinedGroupsResp
 	GetMemberListReq                   = group.GetMemberListReq
@@ -44,24 +67,44 @@ type (
 	HandleGroupInvitationResp          = group.HandleGroupInvitationResp
 	HandleJoinRequestReq               = group.HandleJoinRequestReq
 	HandleJoinRequestResp              = group.HandleJoinRequestResp
+	InviteLinkInfo                     = group.InviteLinkInfo
 	InviteMembersReq                   = group.InviteMembersReq
 	InviteMembersResp                  = group.InviteMembersResp
+	JoinPolicyInfo                     = group.JoinPolicyInfo
 	JoinRequestInfo                    = group.JoinRequestInfo
 	KickMemberReq                      = group.KickMemberReq
 	KickMemberResp                     = group.KickMemberResp
 	MemberInfo                         = group.MemberInfo
+	PinAnnouncementReq                 = group.PinAnnouncementReq
+	PinAnnouncementResp                = group.PinAnnouncementResp
+	PublishAnnouncementReq             = group.PublishAnnouncementReq
+	PublishAnnouncementResp            = group.PublishAnnouncementResp
 	QuitGroupReq                       = group.QuitGroupReq
 	QuitGroupResp                      = group.QuitGroupResp
+	RedeemInviteLinkReq                = group.RedeemInviteLinkReq
+	RedeemInviteLinkResp               = group.RedeemInviteLinkResp
+	RevokeInviteLinkReq                = group.RevokeInviteLinkReq
+	RevokeInviteLinkResp               = group.RevokeInviteLinkResp
 	SearchGroupReq                     = group.SearchGroupReq
 	SearchGroupResp                    = group.SearchGroupResp
 	SendGroupInvitationReq             = group.SendGroupInvitationReq
 	SendGroupInvitationResp            = group.SendGroupInvitationResp
 	SendJoinRequestReq                 = group.SendJoinRequestReq
 	SendJoinRequestResp                = group.SendJoinRequestResp
+	SetGroupMuteAllReq                 = group.SetGroupMuteAllReq
+	SetGroupMuteAllResp                = group.SetGroupMuteAllResp
+	SetGroupPermissionsReq             = group.SetGroupPermissionsReq
+	SetGroupPermissionsResp            = group.SetGroupPermissionsResp
+	SetJoinPolicyReq                   = group.SetJoinPolicyReq
+	SetJoinPolicyResp                  = group.SetJoinPolicyResp
 	SetMemberMuteReq                   = group.SetMemberMuteReq
 	SetMemberMuteResp                  = group.SetMemberMuteResp
 	SetMemberRoleReq                   = group.SetMemberRoleReq
 	SetMemberRoleResp                  = group.SetMemberRoleResp
+	TransferOwnershipReq               = group.TransferOwnershipReq
+	TransferOwnershipResp              = group.TransferOwnershipResp
+	UpdateAnnouncementReq              = group.UpdateAnnouncementReq
+	UpdateAnnouncementResp             = group.UpdateAnnouncementResp
 	UpdateGroupReadSeqReq              = group.UpdateGroupReadSeqReq
 	UpdateGroupReadSeqResp             = group.UpdateGroupReadSeqResp
 	UpdateGroupReq                     = group.UpdateGroupReq
@@ -88,8 +131,12 @@ type (
 		GetUserGroupList(ctx context.Context, in *GetUserGroupListReq, opts ...grpc.CallOption) (*GetUserGroupListResp, error)
 		// 设置成员权限
 		SetMemberRole(ctx context.Context, in *SetMemberRoleReq, opts ...grpc.CallOption) (*SetMemberRoleResp, error)
+		// 转让群主
+		TransferOwnership(ctx context.Context, in *TransferOwnershipReq, opts ...grpc.CallOption) (*TransferOwnershipResp, error)
 		// 设置成员禁言
 		SetMemberMute(ctx context.Context, in *SetMemberMuteReq, opts ...grpc.CallOption) (*SetMemberMuteResp, error)
+		// 设置全员禁言
+		SetGroupMuteAll(ctx context.Context, in *SetGroupMuteAllReq, opts ...grpc.CallOption) (*SetGroupMuteAllResp, error)
 		// 检查用户是否在群组中
 		CheckMembership(ctx context.Context, in *CheckMembershipReq, opts ...grpc.CallOption) (*CheckMembershipResp, error)
 		// 更新群组已读进度
@@ -108,14 +155,48 @@ type (
 		GetSentInvitations(ctx context.Context, in *GetSentInvitationsReq, opts ...grpc.CallOption) (*GetSentInvitationsResp, error)
 		// ==================== 入群申请相关 ====================
 		SendJoinRequest(ctx context.Context, in *SendJoinRequestReq, opts ...grpc.CallOption) (*SendJoinRequestResp, error)
-		// 处理入群申请（群主/管理员）
+		// 处理入群申请（需要审批入群申请权限）
 		HandleJoinRequest(ctx context.Context, in *HandleJoinRequestReq, opts ...grpc.CallOption) (*HandleJoinRequestResp, error)
-		// 获取群的入群申请列表（群主/管理员查看）
+		// 获取群的入群申请列表（需要审批入群申请权限）
 		GetGroupJoinRequests(ctx context.Context, in *GetGroupJoinRequestsReq, opts ...grpc.CallOption) (*GetGroupJoinRequestsResp, error)
 		// 获取用户发出的入群申请
 		GetSentJoinRequests(ctx context.Context, in *GetSentJoinRequestsReq, opts ...grpc.CallOption) (*GetSentJoinRequestsResp, error)
 		// 获取所有管理群组的入群申请（通知中心）
 		GetAllManagedGroupJoinRequests(ctx context.Context, in *GetAllManagedGroupJoinRequestsReq, opts ...grpc.CallOption) (*GetAllManagedGroupJoinRequestsResp, error)
+		// 设置入群方式、入群问题和是否可被搜索（需要修改群信息权限）
+		SetJoinPolicy(ctx context.Context, in *SetJoinPolicyReq, opts ...grpc.CallOption) (*SetJoinPolicyResp, error)
+		// 获取入群设置（需要修改群信息权限，包含入群问题答案）
+		GetJoinPolicy(ctx context.Context, in *GetJoinPolicyReq, opts ...grpc.CallOption) (*GetJoinPolicyResp, error)
+		// ==================== 群公告相关 ====================
+		PublishAnnouncement(ctx context.Context, in *PublishAnnouncementReq, opts ...grpc.CallOption) (*PublishAnnouncementResp, error)
+		// 编辑群公告（需要管理群公告权限）
+		UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementReq, opts ...grpc.CallOption) (*UpdateAnnouncementResp, error)
+		// 删除群公告（需要管理群公告权限）
+		DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementReq, opts ...grpc.CallOption) (*DeleteAnnouncementResp, error)
+		// 置顶/取消置顶群公告（需要管理群公告权限）
+		PinAnnouncement(ctx context.Context, in *PinAnnouncementReq, opts ...grpc.CallOption) (*PinAnnouncementResp, error)
+		// 获取群公告列表（置顶公告在前）
+		GetAnnouncementList(ctx context.Context, in *GetAnnouncementListReq, opts ...grpc.CallOption) (*GetAnnouncementListResp, error)
+		// 获取群公告编辑历史
+		GetAnnouncementHistory(ctx context.Context, in *GetAnnouncementHistoryReq, opts ...grpc.CallOption) (*GetAnnouncementHistoryResp, error)
+		// 确认已读群公告
+		ConfirmAnnouncement(ctx context.Context, in *ConfirmAnnouncementReq, opts ...grpc.CallOption) (*ConfirmAnnouncementResp, error)
+		// 获取群公告确认情况（需要管理群公告权限）
+		GetAnnouncementConfirmations(ctx context.Context, in *GetAnnouncementConfirmationsReq, opts ...grpc.CallOption) (*GetAnnouncementConfirmationsResp, error)
+		// ==================== 群邀请链接相关 ====================
+		CreateInviteLink(ctx context.Context, in *CreateInviteLinkReq, opts ...grpc.CallOption) (*CreateInviteLinkResp, error)
+		// 撤销群邀请链接（创建人或有审批入群申请权限的成员）
+		RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkReq, opts ...grpc.CallOption) (*RevokeInviteLinkResp, error)
+		// 获取群邀请链接列表（需要邀请成员权限，没有审批入群申请权限时只返回自己创建的链接）
+		GetInviteLinks(ctx context.Context, in *GetInviteLinksReq, opts ...grpc.CallOption) (*GetInviteLinksResp, error)
+		// 按邀请码查询邀请链接和群组信息（入群落地页、二维码）
+		GetInviteLink(ctx context.Context, in *GetInviteLinkReq, opts ...grpc.CallOption) (*GetInviteLinkResp, error)
+		// 通过邀请链接入群（免审核时直接入群，否则提交入群申请）
+		RedeemInviteLink(ctx context.Context, in *RedeemInviteLinkReq, opts ...grpc.CallOption) (*RedeemInviteLinkResp, error)
+		// ==================== 群权限相关 ====================
+		SetGroupPermissions(ctx context.Context, in *SetGroupPermissionsReq, opts ...grpc.CallOption) (*SetGroupPermissionsResp, error)
+		// 获取群权限配置和当前用户的有效权限（群成员）
+		GetGroupPermissions(ctx context.Context, in *GetGroupPermissionsReq, opts ...grpc.CallOption) (*GetGroupPermissionsResp, error)
 	}
 
 	defaultGroup struct {
@@ -189,12 +270,24 @@ func (m *defaultGroup) SetMemberRole(ctx context.Context, in *SetMemberRoleReq,
 	return client.SetMemberRole(ctx, in, opts...)
 }
 
+// 转让群主
+func (m *defaultGroup) TransferOwnership(ctx context.Context, in *TransferOwnershipReq, opts ...grpc.CallOption) (*TransferOwnershipResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.TransferOwnership(ctx, in, opts...)
+}
+
 // 设置成员禁言
 func (m *defaultGroup) SetMemberMute(ctx context.Context, in *SetMemberMuteReq, opts ...grpc.CallOption) (*SetMemberMuteResp, error) {
 	client := group.NewGroupClient(m.cli.Conn())
 	return client.SetMemberMute(ctx, in, opts...)
 }
 
+// 设置全员禁言
+func (m *defaultGroup) SetGroupMuteAll(ctx context.Context, in *SetGroupMuteAllReq, opts ...grpc.CallOption) (*SetGroupMuteAllResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.SetGroupMuteAll(ctx, in, opts...)
+}
+
 // 检查用户是否在群组中
 func (m *defaultGroup) CheckMembership(ctx context.Context, in *CheckMembershipReq, opts ...grpc.CallOption) (*CheckMembershipResp, error) {
 	client := group.NewGroupClient(m.cli.Conn())
@@ -249,13 +342,13 @@ func (m *defaultGroup) SendJoinRequest(ctx context.Context, in *SendJoinRequestR
 	return client.SendJoinRequest(ctx, in, opts...)
 }
 
-// 处理入群申请（群主/管理员）
+// 处理入群申请（需要审批入群申请权限）
 func (m *defaultGroup) HandleJoinRequest(ctx context.Context, in *HandleJoinRequestReq, opts ...grpc.CallOption) (*HandleJoinRequestResp, error) {
 	client := group.NewGroupClient(m.cli.Conn())
 	return client.HandleJoinRequest(ctx, in, opts...)
 }
 
-// 获取群的入群申请列表（群主/管理员查看）
+// 获取群的入群申请列表（需要审批入群申请权限）
 func (m *defaultGroup) GetGroupJoinRequests(ctx context.Context, in *GetGroupJoinRequestsReq, opts ...grpc.CallOption) (*GetGroupJoinRequestsResp, error) {
 	client := group.NewGroupClient(m.cli.Conn())
 	return client.GetGroupJoinRequests(ctx, in, opts...)
@@ -272,3 +365,105 @@ func (m *defaultGroup) GetAllManagedGroupJoinRequests(ctx context.Context, in *G
 	client := group.NewGroupClient(m.cli.Conn())
 	return client.GetAllManagedGroupJoinRequests(ctx, in, opts...)
 }
+
+// 设置入群方式、入群问题和是否可被搜索（需要修改群信息权限）
+func (m *defaultGroup) SetJoinPolicy(ctx context.Context, in *SetJoinPolicyReq, opts ...grpc.CallOption) (*SetJoinPolicyResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.SetJoinPolicy(ctx, in, opts...)
+}
+
+// 获取入群设置（需要修改群信息权限，包含入群问题答案）
+func (m *defaultGroup) GetJoinPolicy(ctx context.Context, in *GetJoinPolicyReq, opts ...grpc.CallOption) (*GetJoinPolicyResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetJoinPolicy(ctx, in, opts...)
+}
+
+// ==================== 群公告相关 ====================
+func (m *defaultGroup) PublishAnnouncement(ctx context.Context, in *PublishAnnouncementReq, opts ...grpc.CallOption) (*PublishAnnouncementResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.PublishAnnouncement(ctx, in, opts...)
+}
+
+// 编辑群公告（需要管理群公告权限）
+func (m *defaultGroup) UpdateAnnouncement(ctx context.Context, in *UpdateAnnouncementReq, opts ...grpc.CallOption) (*UpdateAnnouncementResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.UpdateAnnouncement(ctx, in, opts...)
+}
+
+// 删除群公告（需要管理群公告权限）
+func (m *defaultGroup) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementReq, opts ...grpc.CallOption) (*DeleteAnnouncementResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.DeleteAnnouncement(ctx, in, opts...)
+}
+
+// 置顶/取消置顶群公告（需要管理群公告权限）
+func (m *defaultGroup) PinAnnouncement(ctx context.Context, in *PinAnnouncementReq, opts ...grpc.CallOption) (*PinAnnouncementResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.PinAnnouncement(ctx, in, opts...)
+}
+
+// 获取群公告列表（置顶公告在前）
+func (m *defaultGroup) GetAnnouncementList(ctx context.Context, in *GetAnnouncementListReq, opts ...grpc.CallOption) (*GetAnnouncementListResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetAnnouncementList(ctx, in, opts...)
+}
+
+// 获取群公告编辑历史
+func (m *defaultGroup) GetAnnouncementHistory(ctx context.Context, in *GetAnnouncementHistoryReq, opts ...grpc.CallOption) (*GetAnnouncementHistoryResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetAnnouncementHistory(ctx, in, opts...)
+}
+
+// 确认已读群公告
+func (m *defaultGroup) ConfirmAnnouncement(ctx context.Context, in *ConfirmAnnouncementReq, opts ...grpc.CallOption) (*ConfirmAnnouncementResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.ConfirmAnnouncement(ctx, in, opts...)
+}
+
+// 获取群公告确认情况（需要管理群公告权限）
+func (m *defaultGroup) GetAnnouncementConfirmations(ctx context.Context, in *GetAnnouncementConfirmationsReq, opts ...grpc.CallOption) (*GetAnnouncementConfirmationsResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetAnnouncementConfirmations(ctx, in, opts...)
+}
+
+// ==================== 群邀请链接相关 ====================
+func (m *defaultGroup) CreateInviteLink(ctx context.Context, in *CreateInviteLinkReq, opts ...grpc.CallOption) (*CreateInviteLinkResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.CreateInviteLink(ctx, in, opts...)
+}
+
+// 撤销群邀请链接（创建人或有审批入群申请权限的成员）
+func (m *defaultGroup) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkReq, opts ...grpc.CallOption) (*RevokeInviteLinkResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.RevokeInviteLink(ctx, in, opts...)
+}
+
+// 获取群邀请链接列表（需要邀请成员权限，没有审批入群申请权限时只返回自己创建的链接）
+func (m *defaultGroup) GetInviteLinks(ctx context.Context, in *GetInviteLinksReq, opts ...grpc.CallOption) (*GetInviteLinksResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetInviteLinks(ctx, in, opts...)
+}
+
+// 按邀请码查询邀请链接和群组信息（入群落地页、二维码）
+func (m *defaultGroup) GetInviteLink(ctx context.Context, in *GetInviteLinkReq, opts ...grpc.CallOption) (*GetInviteLinkResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetInviteLink(ctx, in, opts...)
+}
+
+// 通过邀请链接入群（免审核时直接入群，否则提交入群申请）
+func (m *defaultGroup) RedeemInviteLink(ctx context.Context, in *RedeemInviteLinkReq, opts ...grpc.CallOption) (*RedeemInviteLinkResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.RedeemInviteLink(ctx, in, opts...)
+}
+
+// ==================== 群权限相关 ====================
+func (m *defaultGroup) SetGroupPermissions(ctx context.Context, in *SetGroupPermissionsReq, opts ...grpc.CallOption) (*SetGroupPermissionsResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.SetGroupPermissions(ctx, in, opts...)
+}
+
+// 获取群权限配置和当前用户的有效权限（群成员）
+func (m *defaultGroup) GetGroupPermissions(ctx context.Context, in *GetGroupPermissionsReq, opts ...grpc.CallOption) (*GetGroupPermissionsResp, error) {
+	client := group.NewGroupClient(m.cli.Conn())
+	return client.GetGroupPermissions(ctx, in, opts...)
+}
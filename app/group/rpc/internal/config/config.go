@@ -12,9 +12,19 @@ type Config struct {
 	}
 	Cache cache.CacheConf
 
+	// 消息服务（群事件写入群消息时间线）
+	MessageRpc zrpc.RpcClientConf
+
 	// WebSocket 服务地址
 	WsServiceUrl string
 
 	// WebSocket 内部推送鉴权（可选）
 	WsPushSecret string `json:",optional"`
+
+	// 群邀请链接
+	InviteLink struct {
+		BaseUrl       string `json:",default=http://localhost:5173/invite/"` // 分享链接前缀，后接邀请码
+		DefaultExpire int64  `json:",default=604800"`                        // 默认有效期（秒）
+		MaxExpire     int64  `json:",default=2592000"`                       // 最长有效期（秒）
+	}
 }
@@ -44,17 +44,24 @@ func (l *CheckMembershipLogic) CheckMembership(in *group.CheckMembershipReq) (*g
 		return nil, status.Error(codes.Internal, "查询失败")
 	}
 
+	// 查询群组（全员禁言状态、权限配置）
+	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, in.GroupId)
+	if err != nil && err != model.ErrNotFound {
+		l.Logger.Errorf("查询群组失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+
+	muted, muteUntil, byMuteAll := speakState(groupInfo, member)
+	var permissions int64
+	if groupInfo != nil {
+		permissions = memberPermissions(groupInfo, member.Role)
+	}
 	return &group.CheckMembershipResp{
-		IsMember: true,
-		Member: &group.MemberInfo{
-			Id:       member.Id,
-			GroupId:  member.GroupId,
-			UserId:   member.UserId,
-			Role:     int32(member.Role),
-			Nickname: member.Nickname.String,
-			Mute:     int32(member.Mute),
-			JoinedAt: member.JoinedAt.Unix(),
-			ReadSeq:  member.ReadSeq,
-		},
+		IsMember:    true,
+		Member:      toMemberInfo(member),
+		Muted:       muted,
+		MuteUntil:   muteUntil,
+		MuteAll:     byMuteAll,
+		Permissions: permissions,
 	}, nil
 }
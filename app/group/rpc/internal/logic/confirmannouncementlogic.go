@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ConfirmAnnouncementLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConfirmAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmAnnouncementLogic {
+	return &ConfirmAnnouncementLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 确认已读群公告
+// ConfirmAnnouncement 确认已读群公告（群成员），记录确认的公告版本
+func (l *ConfirmAnnouncementLogic) ConfirmAnnouncement(in *group.ConfirmAnnouncementReq) (*group.ConfirmAnnouncementResp, error) {
+	announcement, err := findAnnouncement(l.ctx, l.svcCtx, in.AnnouncementId)
+	if err != nil {
+		return nil, err
+	}
+	if announcement.RequireConfirm != 1 {
+		return nil, status.Error(codes.FailedPrecondition, "该公告无需确认")
+	}
+	if _, _, err := authorize(l.ctx, l.svcCtx, announcement.GroupId, in.UserId, 0); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.ImGroupAnnouncementConfirmModel.Confirm(l.ctx, announcement.Id, uint64(in.UserId), announcement.Version); err != nil {
+		l.Logger.Errorf("确认群公告失败: id=%d, userId=%d, err=%v", in.AnnouncementId, in.UserId, err)
+		return nil, status.Error(codes.Internal, "确认失败")
+	}
+
+	return &group.ConfirmAnnouncementResp{
+		Success: true,
+		Version: announcement.Version,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -51,12 +52,16 @@ func (l *CreateGroupLogic) CreateGroup(in *group.CreateGroupReq) (*group.CreateG
 
 	// 创建群组记录
 	groupData := &model.ImGroup{
-		GroupId:     groupId,
-		Name:        in.Name,
-		OwnerId:     in.OwnerId,
-		MaxMembers:  int64(maxMembers),
-		MemberCount: 1, // 创建者自动加入
-		Status:      1, // 正常状态
+		GroupId:           groupId,
+		Name:              in.Name,
+		OwnerId:           in.OwnerId,
+		MaxMembers:        int64(maxMembers),
+		MemberCount:       1, // 创建者自动加入
+		Status:            1, // 正常状态
+		JoinPolicy:        joinPolicyApproval,
+		Discoverable:      1,
+		AdminPermissions:  groupperm.DefaultAdmin,
+		MemberPermissions: groupperm.DefaultMember,
 	}
 
 	// 设置可选字段
@@ -94,6 +99,7 @@ func (l *CreateGroupLogic) CreateGroup(in *group.CreateGroupReq) (*group.CreateG
 	}
 
 	// 如果有初始成员，批量添加
+	var addedIds []int64
 	if len(in.MemberIds) > 0 {
 		successCount := 0
 		for _, memberId := range in.MemberIds {
@@ -120,6 +126,7 @@ func (l *CreateGroupLogic) CreateGroup(in *group.CreateGroupReq) (*group.CreateG
 				continue
 			}
 			successCount++
+			addedIds = append(addedIds, memberId)
 		}
 
 		// 更新群成员数
@@ -149,17 +156,19 @@ func (l *CreateGroupLogic) CreateGroup(in *group.CreateGroupReq) (*group.CreateG
 	// 构造响应
 	resp := &group.CreateGroupResp{
 		Group: &group.GroupInfo{
-			Id:          groupDbId,
-			GroupId:     groupId,
-			Name:        groupData.Name,
-			Avatar:      groupData.Avatar.String,
-			OwnerId:     groupData.OwnerId,
-			Description: groupData.Description.String,
-			MaxMembers:  int32(groupData.MaxMembers),
-			MemberCount: int32(groupData.MemberCount),
-			Status:      int32(groupData.Status),
-			CreatedAt:   groupData.CreatedAt.Unix(),
-			UpdatedAt:   groupData.UpdatedAt.Unix(),
+			Id:           groupDbId,
+			GroupId:      groupId,
+			Name:         groupData.Name,
+			Avatar:       groupData.Avatar.String,
+			OwnerId:      groupData.OwnerId,
+			Description:  groupData.Description.String,
+			MaxMembers:   int32(groupData.MaxMembers),
+			MemberCount:  int32(groupData.MemberCount),
+			Status:       int32(groupData.Status),
+			CreatedAt:    groupData.CreatedAt.Unix(),
+			UpdatedAt:    groupData.UpdatedAt.Unix(),
+			JoinPolicy:   int32(groupData.JoinPolicy),
+			Discoverable: groupData.Discoverable == 1,
 		},
 	}
 
@@ -169,5 +178,11 @@ func (l *CreateGroupLogic) CreateGroup(in *group.CreateGroupReq) (*group.CreateG
 		"group":      resp.Group,
 	})
 
+	// 写入群消息时间线
+	recordGroupEvent(l.ctx, l.svcCtx, groupId, eventGroupCreate, in.OwnerId)
+	if len(addedIds) > 0 {
+		recordGroupEvent(l.ctx, l.svcCtx, groupId, eventMemberInvite, in.OwnerId, addedIds...)
+	}
+
 	return resp, nil
 }
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteAnnouncementLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAnnouncementLogic {
+	return &DeleteAnnouncementLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除群公告（需要管理群公告权限）
+// DeleteAnnouncement 删除群公告（需要管理群公告权限），编辑历史和确认记录保留
+func (l *DeleteAnnouncementLogic) DeleteAnnouncement(in *group.DeleteAnnouncementReq) (*group.DeleteAnnouncementResp, error) {
+	announcement, err := findAnnouncement(l.ctx, l.svcCtx, in.AnnouncementId)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := authorize(l.ctx, l.svcCtx, announcement.GroupId, in.OperatorId, groupperm.Announce); err != nil {
+		return nil, err
+	}
+
+	announcement.Status = 2
+	announcement.Pinned = 0
+	if err := l.svcCtx.ImGroupAnnouncementModel.Update(l.ctx, announcement); err != nil {
+		l.Logger.Errorf("删除群公告失败: id=%d, err=%v", in.AnnouncementId, err)
+		return nil, status.Error(codes.Internal, "删除公告失败")
+	}
+
+	pushAnnouncementEvent(l.svcCtx, announcement, announcementActionDelete, in.OperatorId)
+
+	return &group.DeleteAnnouncementResp{Success: true}, nil
+}
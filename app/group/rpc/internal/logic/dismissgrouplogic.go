@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
 
@@ -28,21 +27,13 @@ func NewDismissGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Dism
 
 // DismissGroup 解散群组
 func (l *DismissGroupLogic) DismissGroup(in *group.DismissGroupReq) (*group.DismissGroupResp, error) {
-	// 1. 验证群组是否存在
-	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, in.GroupId)
+	// 1. 验证群组和操作者权限 (必须是群主)
+	groupInfo, _, err := authorizeOwner(l.ctx, l.svcCtx, in.GroupId, in.OperatorId)
 	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, errors.New("群组不存在")
-		}
 		return nil, err
 	}
 
-	// 2. 验证是否为群主
-	if groupInfo.OwnerId != in.OperatorId {
-		return nil, errors.New("只有群主可以解散群组")
-	}
-
-	// 3. 更新状态为已解散 (Status = 2)
+	// 2. 更新状态为已解散 (Status = 2)
 	groupInfo.Status = 2
 	err = l.svcCtx.ImGroupModel.Update(l.ctx, groupInfo)
 	if err != nil {
@@ -50,7 +41,10 @@ func (l *DismissGroupLogic) DismissGroup(in *group.DismissGroupReq) (*group.Dism
 		return nil, errors.New("解散群组失败")
 	}
 
-	// 3.5 删除所有群成员记录（同步执行，确保数据一致性）
+	// 写入群消息时间线（在删除成员之前，保证在线成员能收到推送）
+	recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventGroupDismiss, in.OperatorId)
+
+	// 3. 删除所有群成员记录（同步执行，确保数据一致性）
 	members, err := l.svcCtx.ImGroupMemberModel.FindByGroupId(l.ctx, in.GroupId)
 	if err == nil && len(members) > 0 {
 		l.Logger.Infof("[DismissGroup] Starting to delete %d members for group %s", len(members), in.GroupId)
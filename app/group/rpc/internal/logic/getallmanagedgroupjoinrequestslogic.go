@@ -5,6 +5,7 @@ import (
 
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -25,13 +26,13 @@ func NewGetAllManagedGroupJoinRequestsLogic(ctx context.Context, svcCtx *svc.Ser
 
 // 获取所有管理群组的入群申请（通知中心）
 func (l *GetAllManagedGroupJoinRequestsLogic) GetAllManagedGroupJoinRequests(in *group.GetAllManagedGroupJoinRequestsReq) (*group.GetAllManagedGroupJoinRequestsResp, error) {
-	// 1. 查询用户作为管理员/群主的所有群组ID
-	groupIds, err := l.svcCtx.ImGroupMemberModel.FindManagedGroupsByUserId(l.ctx, in.OperatorId)
+	// 1. 查询用户有审批入群申请权限的所有群组ID
+	groupIds, err := l.svcCtx.ImGroupMemberModel.FindGroupsWithPermission(l.ctx, in.OperatorId, groupperm.ApproveJoin)
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. 如果用户不是任何群的管理员，直接返回空列表
+	// 2. 如果用户没有任何群的审批权限，直接返回空列表
 	if len(groupIds) == 0 {
 		return &group.GetAllManagedGroupJoinRequestsResp{
 			List:  []*group.JoinRequestInfo{},
@@ -57,6 +58,7 @@ func (l *GetAllManagedGroupJoinRequestsLogic) GetAllManagedGroupJoinRequests(in
 			GroupId:   req.GroupId,
 			UserId:    int64(req.UserId),
 			Message:   req.Message,
+			Answer:    req.Answer,
 			Status:    req.Status,
 			HandlerId: handlerId,
 			CreatedAt: req.CreatedAt.Unix(),
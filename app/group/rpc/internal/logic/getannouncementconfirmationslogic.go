@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetAnnouncementConfirmationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAnnouncementConfirmationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnnouncementConfirmationsLogic {
+	return &GetAnnouncementConfirmationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群公告确认情况（需要管理群公告权限）
+// GetAnnouncementConfirmations 获取群公告确认情况（需要管理群公告权限），只统计确认了当前版本的成员
+func (l *GetAnnouncementConfirmationsLogic) GetAnnouncementConfirmations(in *group.GetAnnouncementConfirmationsReq) (*group.GetAnnouncementConfirmationsResp, error) {
+	announcement, err := findAnnouncement(l.ctx, l.svcCtx, in.AnnouncementId)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := authorize(l.ctx, l.svcCtx, announcement.GroupId, in.OperatorId, groupperm.Announce); err != nil {
+		return nil, err
+	}
+
+	confirms, err := l.svcCtx.ImGroupAnnouncementConfirmModel.FindByAnnouncementVersion(l.ctx, announcement.Id, announcement.Version)
+	if err != nil {
+		l.Logger.Errorf("查询群公告确认记录失败: id=%d, err=%v", in.AnnouncementId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+	members, err := l.svcCtx.ImGroupMemberModel.FindByGroupId(l.ctx, announcement.GroupId)
+	if err != nil {
+		l.Logger.Errorf("查询群成员失败: groupId=%s, err=%v", announcement.GroupId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+
+	// 只返回仍在群内的成员
+	inGroup := make(map[int64]bool, len(members))
+	for _, m := range members {
+		inGroup[m.UserId] = true
+	}
+	confirmed := make([]*group.AnnouncementConfirmInfo, 0, len(confirms))
+	confirmedIds := make(map[int64]bool, len(confirms))
+	for _, c := range confirms {
+		userId := int64(c.UserId)
+		if !inGroup[userId] {
+			continue
+		}
+		confirmedIds[userId] = true
+		confirmed = append(confirmed, &group.AnnouncementConfirmInfo{
+			UserId:      userId,
+			ConfirmedAt: c.UpdatedAt.Unix(),
+		})
+	}
+	unconfirmed := make([]int64, 0, len(members))
+	for _, m := range members {
+		if !confirmedIds[m.UserId] {
+			unconfirmed = append(unconfirmed, m.UserId)
+		}
+	}
+
+	return &group.GetAnnouncementConfirmationsResp{
+		Version:        announcement.Version,
+		Confirmed:      confirmed,
+		UnconfirmedIds: unconfirmed,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetAnnouncementHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAnnouncementHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnnouncementHistoryLogic {
+	return &GetAnnouncementHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群公告编辑历史
+// GetAnnouncementHistory 获取群公告编辑历史（群成员），按版本倒序
+func (l *GetAnnouncementHistoryLogic) GetAnnouncementHistory(in *group.GetAnnouncementHistoryReq) (*group.GetAnnouncementHistoryResp, error) {
+	announcement, err := findAnnouncement(l.ctx, l.svcCtx, in.AnnouncementId)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := authorize(l.ctx, l.svcCtx, announcement.GroupId, in.UserId, 0); err != nil {
+		return nil, err
+	}
+
+	history, err := l.svcCtx.ImGroupAnnouncementHistoryModel.FindByAnnouncementId(l.ctx, announcement.Id)
+	if err != nil {
+		l.Logger.Errorf("查询群公告历史失败: id=%d, err=%v", in.AnnouncementId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+
+	list := make([]*group.AnnouncementVersion, 0, len(history))
+	for _, h := range history {
+		list = append(list, &group.AnnouncementVersion{
+			Version:   h.Version,
+			EditorId:  int64(h.EditorId),
+			Content:   h.Content,
+			CreatedAt: h.CreatedAt.Unix(),
+		})
+	}
+
+	return &group.GetAnnouncementHistoryResp{List: list}, nil
+}
@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetAnnouncementListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAnnouncementListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnnouncementListLogic {
+	return &GetAnnouncementListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群公告列表（置顶公告在前）
+// GetAnnouncementList 获取群公告列表（群成员），置顶公告在前
+func (l *GetAnnouncementListLogic) GetAnnouncementList(in *group.GetAnnouncementListReq) (*group.GetAnnouncementListResp, error) {
+	if in.GroupId == "" || in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if _, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.UserId, 0); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	list, err := l.svcCtx.ImGroupAnnouncementModel.FindByGroupId(l.ctx, in.GroupId, page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("查询群公告失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+	total, err := l.svcCtx.ImGroupAnnouncementModel.CountByGroupId(l.ctx, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("统计群公告失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+
+	// 当前用户确认过的版本
+	ids := make([]uint64, 0, len(list))
+	for _, a := range list {
+		ids = append(ids, a.Id)
+	}
+	confirms, err := l.svcCtx.ImGroupAnnouncementConfirmModel.FindByUserAndAnnouncements(l.ctx, uint64(in.UserId), ids)
+	if err != nil {
+		l.Logger.Errorf("查询群公告确认记录失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+	confirmedVersion := make(map[uint64]int64, len(confirms))
+	for _, c := range confirms {
+		confirmedVersion[c.AnnouncementId] = c.Version
+	}
+
+	result := make([]*group.AnnouncementInfo, 0, len(list))
+	for _, a := range list {
+		result = append(result, toAnnouncementInfo(a, confirmedVersion[a.Id] == a.Version))
+	}
+
+	return &group.GetAnnouncementListResp{
+		List:  result,
+		Total: total,
+	}, nil
+}
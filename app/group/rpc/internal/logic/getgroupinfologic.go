@@ -37,17 +37,21 @@ func (l *GetGroupInfoLogic) GetGroupInfo(in *group.GetGroupInfoReq) (*group.GetG
 
 	return &group.GetGroupInfoResp{
 		Group: &group.GroupInfo{
-			Id:          int64(groupInfo.Id),
-			GroupId:     groupInfo.GroupId,
-			Name:        groupInfo.Name,
-			Avatar:      groupInfo.Avatar.String,
-			OwnerId:     groupInfo.OwnerId,
-			Description: groupInfo.Description.String,
-			MaxMembers:  int32(groupInfo.MaxMembers),
-			MemberCount: int32(groupInfo.MemberCount),
-			Status:      int32(groupInfo.Status),
-			CreatedAt:   groupInfo.CreatedAt.Unix(),
-			UpdatedAt:   groupInfo.UpdatedAt.Unix(),
+			Id:           int64(groupInfo.Id),
+			GroupId:      groupInfo.GroupId,
+			Name:         groupInfo.Name,
+			Avatar:       groupInfo.Avatar.String,
+			OwnerId:      groupInfo.OwnerId,
+			Description:  groupInfo.Description.String,
+			MaxMembers:   int32(groupInfo.MaxMembers),
+			MemberCount:  int32(groupInfo.MemberCount),
+			Status:       int32(groupInfo.Status),
+			CreatedAt:    groupInfo.CreatedAt.Unix(),
+			UpdatedAt:    groupInfo.UpdatedAt.Unix(),
+			MuteAll:      int32(groupInfo.MuteAll),
+			JoinPolicy:   int32(groupInfo.JoinPolicy),
+			JoinQuestion: groupInfo.JoinQuestion,
+			Discoverable: groupInfo.Discoverable == 1,
 		},
 	}, nil
 }
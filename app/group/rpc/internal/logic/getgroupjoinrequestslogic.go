@@ -2,11 +2,10 @@ package logic
 
 import (
 	"context"
-	"errors"
 
-	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -25,19 +24,12 @@ func NewGetGroupJoinRequestsLogic(ctx context.Context, svcCtx *svc.ServiceContex
 	}
 }
 
-// GetGroupJoinRequests 获取群的入群申请列表（群主/管理员查看）
+// GetGroupJoinRequests 获取群的入群申请列表（需要审批入群申请权限）
 func (l *GetGroupJoinRequestsLogic) GetGroupJoinRequests(in *group.GetGroupJoinRequestsReq) (*group.GetGroupJoinRequestsResp, error) {
-	// 1. 验证操作者权限（必须是群主或管理员）
-	member, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.OperatorId)
-	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, errors.New("您不是群成员，无权查看申请")
-		}
+	// 1. 验证操作者权限（需要审批入群申请权限）
+	if _, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.ApproveJoin); err != nil {
 		return nil, err
 	}
-	if member.Role != 1 && member.Role != 2 {
-		return nil, errors.New("只有群主或管理员可以查看申请列表")
-	}
 
 	// 2. 分页查询待处理的申请（status=0）
 	requests, err := l.svcCtx.ImGroupJoinRequestModel.FindByGroupIdAndStatus(l.ctx, in.GroupId, 0, in.Page, in.PageSize)
@@ -63,6 +55,7 @@ func (l *GetGroupJoinRequestsLogic) GetGroupJoinRequests(in *group.GetGroupJoinR
 			GroupId:   req.GroupId,
 			UserId:    int64(req.UserId),
 			Message:   req.Message,
+			Answer:    req.Answer,
 			Status:    req.Status,
 			HandlerId: handlerId,
 			CreatedAt: req.CreatedAt.Unix(),
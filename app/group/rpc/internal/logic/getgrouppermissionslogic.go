@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetGroupPermissionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetGroupPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupPermissionsLogic {
+	return &GetGroupPermissionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群权限配置和当前用户的有效权限（群成员）
+// GetGroupPermissions 返回管理员和普通成员的权限配置，以及当前用户按角色计算出的有效权限
+func (l *GetGroupPermissionsLogic) GetGroupPermissions(in *group.GetGroupPermissionsReq) (*group.GetGroupPermissionsResp, error) {
+	if in.GroupId == "" || in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	groupInfo, member, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.UserId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.GetGroupPermissionsResp{
+		AdminPermissions:  groupInfo.AdminPermissions,
+		MemberPermissions: groupInfo.MemberPermissions,
+		Permissions:       memberPermissions(groupInfo, member.Role),
+		Role:              int32(member.Role),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetInviteLinkLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetInviteLinkLogic {
+	return &GetInviteLinkLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 按邀请码查询邀请链接和群组信息（入群落地页、二维码）
+// GetInviteLink 无需群成员身份，链接已失效时仍返回链接信息，由调用方根据 status 展示
+func (l *GetInviteLinkLogic) GetInviteLink(in *group.GetInviteLinkReq) (*group.GetInviteLinkResp, error) {
+	link, err := findInviteLinkByCode(l.ctx, l.svcCtx, in.Code)
+	if err != nil {
+		return nil, err
+	}
+	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, link.GroupId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "群组不存在")
+		}
+		return nil, err
+	}
+
+	return &group.GetInviteLinkResp{
+		Link: toInviteLinkInfo(l.svcCtx, link),
+		Group: &group.GroupInfo{
+			Id:           int64(groupInfo.Id),
+			GroupId:      groupInfo.GroupId,
+			Name:         groupInfo.Name,
+			Avatar:       groupInfo.Avatar.String,
+			OwnerId:      groupInfo.OwnerId,
+			Description:  groupInfo.Description.String,
+			MaxMembers:   int32(groupInfo.MaxMembers),
+			MemberCount:  int32(groupInfo.MemberCount),
+			Status:       int32(groupInfo.Status),
+			CreatedAt:    groupInfo.CreatedAt.Unix(),
+			UpdatedAt:    groupInfo.UpdatedAt.Unix(),
+			MuteAll:      int32(groupInfo.MuteAll),
+			JoinPolicy:   int32(groupInfo.JoinPolicy),
+			JoinQuestion: groupInfo.JoinQuestion,
+			Discoverable: groupInfo.Discoverable == 1,
+		},
+	}, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetInviteLinksLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetInviteLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetInviteLinksLogic {
+	return &GetInviteLinksLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群邀请链接列表（需要邀请成员权限）
+// GetInviteLinks 获取群邀请链接列表，可按创建人过滤
+func (l *GetInviteLinksLogic) GetInviteLinks(in *group.GetInviteLinksReq) (*group.GetInviteLinksResp, error) {
+	if in.GroupId == "" || in.OperatorId == 0 || in.CreatorId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	groupInfo, member, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.Invite)
+	if err != nil {
+		return nil, err
+	}
+	// 没有审批入群申请权限的成员只能查看自己创建的链接
+	creatorId := in.CreatorId
+	if !groupperm.Has(memberPermissions(groupInfo, member.Role), groupperm.ApproveJoin) {
+		creatorId = in.OperatorId
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	list, err := l.svcCtx.ImGroupInviteLinkModel.FindByGroupId(l.ctx, in.GroupId, uint64(creatorId), page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("查询邀请链接失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+	total, err := l.svcCtx.ImGroupInviteLinkModel.CountByGroupId(l.ctx, in.GroupId, uint64(creatorId))
+	if err != nil {
+		l.Logger.Errorf("统计邀请链接失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "查询失败")
+	}
+
+	result := make([]*group.InviteLinkInfo, 0, len(list))
+	for _, link := range list {
+		result = append(result, toInviteLinkInfo(l.svcCtx, link))
+	}
+
+	return &group.GetInviteLinksResp{
+		List:  result,
+		Total: total,
+	}, nil
+}
@@ -36,16 +36,7 @@ func (l *GetJoinedGroupsLogic) GetJoinedGroups(in *group.GetJoinedGroupsReq) (*g
 	// 返回 MemberInfo 列表（包含 group_id, user_id, role, read_seq 等成员信息）
 	var memberList []*group.MemberInfo
 	for _, m := range members {
-		memberList = append(memberList, &group.MemberInfo{
-			Id:       m.Id,
-			GroupId:  m.GroupId,
-			UserId:   m.UserId,
-			Role:     int32(m.Role),
-			Nickname: m.Nickname.String,
-			Mute:     int32(m.Mute),
-			JoinedAt: m.JoinedAt.Unix(),
-			ReadSeq:  m.ReadSeq,
-		})
+		memberList = append(memberList, toMemberInfo(m))
 	}
 
 	return &group.GetJoinedGroupsResp{
@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetJoinPolicyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetJoinPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetJoinPolicyLogic {
+	return &GetJoinPolicyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取入群设置（需要修改群信息权限，包含入群问题答案）
+// GetJoinPolicy 入群问题答案只对有修改群信息权限的成员可见，其他成员从群组信息中获取入群方式和问题
+func (l *GetJoinPolicyLogic) GetJoinPolicy(in *group.GetJoinPolicyReq) (*group.GetJoinPolicyResp, error) {
+	if in.GroupId == "" || in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	groupInfo, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.EditInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.GetJoinPolicyResp{
+		Policy: toJoinPolicyInfo(groupInfo),
+	}, nil
+}
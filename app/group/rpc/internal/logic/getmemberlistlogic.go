@@ -33,16 +33,7 @@ func (l *GetMemberListLogic) GetMemberList(in *group.GetMemberListReq) (*group.G
 
 	var list []*group.MemberInfo
 	for _, m := range members {
-		list = append(list, &group.MemberInfo{
-			Id:       m.Id,
-			GroupId:  m.GroupId,
-			UserId:   m.UserId,
-			Role:     int32(m.Role),
-			Nickname: m.Nickname.String,
-			Mute:     int32(m.Mute),
-			JoinedAt: m.JoinedAt.Unix(),
-			ReadSeq:  m.ReadSeq,
-		})
+		list = append(list, toMemberInfo(m))
 	}
 
 	return &group.GetMemberListResp{
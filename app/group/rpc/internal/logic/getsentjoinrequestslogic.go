@@ -49,6 +49,7 @@ func (l *GetSentJoinRequestsLogic) GetSentJoinRequests(in *group.GetSentJoinRequ
 			GroupId:   req.GroupId,
 			UserId:    int64(req.UserId),
 			Message:   req.Message,
+			Answer:    req.Answer,
 			Status:    req.Status,
 			HandlerId: handlerId,
 			CreatedAt: req.CreatedAt.Unix(),
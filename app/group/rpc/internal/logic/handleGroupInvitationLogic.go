@@ -110,6 +110,7 @@ func (l *HandleGroupInvitationLogic) HandleGroupInvitation(in *group.HandleGroup
 			"userId":  invitation.InviteeId,
 			"groupId": invitation.GroupId,
 		})
+		recordGroupEvent(l.ctx, l.svcCtx, invitation.GroupId, eventMemberInvite, int64(invitation.InviterId), int64(invitation.InviteeId))
 
 		// 推送通知给邀请方
 		go func() {
@@ -2,13 +2,13 @@ package logic
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -29,25 +29,16 @@ func NewInviteMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Inv
 
 // InviteMembers 邀请成员加入群组 (批量直接加入)
 func (l *InviteMembersLogic) InviteMembers(in *group.InviteMembersReq) (*group.InviteMembersResp, error) {
-	// 1. 验证群组
-	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, in.GroupId)
+	// 1. 验证群组和邀请人权限
+	groupInfo, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.InviterId, groupperm.Invite)
 	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, errors.New("群组不存在")
-		}
 		return nil, err
 	}
 
-	// 2. 验证邀请人权限
-	_, err = l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.InviterId)
-	if err != nil {
-		return nil, errors.New("邀请人不是群成员")
-	}
-
 	var successIds []int64
 	var failedIds []int64
 
-	// 3. 批量处理
+	// 2. 批量处理
 	for _, inviteeId := range in.MemberIds {
 		// 检查是否已经是成员
 		isMember, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, inviteeId)
@@ -101,6 +92,7 @@ func (l *InviteMembersLogic) InviteMembers(in *group.InviteMembersReq) (*group.I
 			"inviterId": in.InviterId,
 			"memberIds": successIds,
 		})
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventMemberInvite, in.InviterId, successIds...)
 	}
 
 	return &group.InviteMembersResp{
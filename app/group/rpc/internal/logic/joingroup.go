@@ -0,0 +1,108 @@
+package logic
+
+// joingroup.go - 成员入群和提交入群申请（入群申请、邀请链接共用）
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+)
+
+// addGroupMember 将用户以普通成员身份加入群组，更新成员数并清除 Redis 成员集合，推送入群通知并写入群消息时间线
+// 调用方负责校验群组状态和人数上限；用户已是群成员时不做处理，返回 false
+func addGroupMember(ctx context.Context, svcCtx *svc.ServiceContext, groupInfo *model.ImGroup, userId, operatorId int64) (bool, error) {
+	// 检查是否已经是成员（防止并发问题）
+	existingMember, _ := svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(ctx, groupInfo.GroupId, userId)
+	if existingMember != nil {
+		return false, nil
+	}
+
+	// 添加成员到群组
+	newMember := &model.ImGroupMember{
+		GroupId:  groupInfo.GroupId,
+		UserId:   userId,
+		Role:     3, // 普通成员
+		Mute:     0, // 不禁言
+		JoinedAt: time.Now(),
+		ReadSeq:  0, // 初始已读序列号为0
+	}
+	if _, err := svcCtx.ImGroupMemberModel.Insert(ctx, newMember); err != nil {
+		return false, err
+	}
+
+	// 更新群组成员数
+	groupInfo.MemberCount++
+	if err := svcCtx.ImGroupModel.Update(ctx, groupInfo); err != nil {
+		logx.WithContext(ctx).Errorf("更新群组成员数失败: %v", err)
+		// 不中断流程，成员已加入
+	}
+
+	// 删除 Redis 成员集合，由 ws 推送群消息时按最新成员列表重建
+	// （不直接 SADD：集合不存在时会创建只含新成员且没有过期时间的集合）
+	redisKey := fmt.Sprintf("im:group:members:%s", groupInfo.GroupId)
+	if _, err := svcCtx.Redis.DelCtx(ctx, redisKey); err != nil {
+		logx.WithContext(ctx).Errorf("清除群成员缓存失败: groupId=%s, userId=%d, err=%v", groupInfo.GroupId, userId, err)
+	}
+
+	// 推送入群通知
+	_ = svcCtx.WsPushClient.PushGroupEvent(groupInfo.GroupId, "joinGroup", map[string]interface{}{
+		"userId":  userId,
+		"groupId": groupInfo.GroupId,
+	})
+	recordGroupEvent(ctx, svcCtx, groupInfo.GroupId, eventMemberJoin, operatorId, userId)
+	return true, nil
+}
+
+// createJoinRequest 提交入群申请，返回申请ID
+// 调用方负责校验群组状态、成员身份和入群方式；已有待处理的申请时返回错误
+func createJoinRequest(ctx context.Context, svcCtx *svc.ServiceContext, groupId string, userId int64, message, answer string) (int64, error) {
+	// 检查是否已有待处理的申请（防止重复申请）
+	pendingRequest, err := svcCtx.ImGroupJoinRequestModel.FindPendingByGroupAndUser(ctx, groupId, uint64(userId))
+	if err != nil && err != sqlc.ErrNotFound && err != model.ErrNotFound {
+		return 0, err
+	}
+	if pendingRequest != nil {
+		return 0, errors.New("已有待处理的入群申请，请耐心等待")
+	}
+
+	// 检查是否有历史申请记录（已同意/已拒绝）
+	// 如果用户之前申请过但已被处理（同意后被踢出，或被拒绝），复用该记录
+	existingRequest, err := svcCtx.ImGroupJoinRequestModel.FindLatestByGroupAndUser(ctx, groupId, uint64(userId))
+	if err != nil && err != sqlc.ErrNotFound && err != model.ErrNotFound {
+		return 0, err
+	}
+
+	if existingRequest != nil {
+		// 复用已有记录，更新为新的申请
+		existingRequest.Message = message
+		existingRequest.Answer = answer
+		existingRequest.Status = 0                              // 重置为待处理
+		existingRequest.HandlerId = sql.NullInt64{Valid: false} // 清空处理人
+		if err := svcCtx.ImGroupJoinRequestModel.Update(ctx, existingRequest); err != nil {
+			return 0, err
+		}
+		return int64(existingRequest.Id), nil
+	}
+
+	// 没有任何历史记录，创建新的入群申请记录
+	joinRequest := &model.ImGroupJoinRequest{
+		GroupId: groupId,
+		UserId:  uint64(userId),
+		Message: message,
+		Answer:  answer,
+		Status:  0, // 0-待处理
+	}
+	result, err := svcCtx.ImGroupJoinRequestModel.Insert(ctx, joinRequest)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
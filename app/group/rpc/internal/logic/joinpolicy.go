@@ -0,0 +1,60 @@
+package logic
+
+// joinpolicy.go - 群组入群方式（自由加入、需要审核、回答问题、仅限邀请、禁止加入）
+//
+// 入群方式只约束用户主动申请入群（SendJoinRequest）；成员邀请不受影响，
+// 邀请链接在“禁止加入”时失效，在“仅限邀请”时仍可使用。
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/group"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 入群方式
+const (
+	joinPolicyOpen       = 1 // 自由加入
+	joinPolicyApproval   = 2 // 需要审核
+	joinPolicyQuestion   = 3 // 回答问题（设置了答案时自动校验，否则人工审核）
+	joinPolicyInviteOnly = 4 // 仅限邀请
+	joinPolicyClosed     = 5 // 禁止加入
+)
+
+// maxJoinQuestionLength 入群问题和答案的最大字符数
+const maxJoinQuestionLength = 200
+
+// checkJoinPolicy 校验入群设置，返回去除首尾空白后的问题和答案
+func checkJoinPolicy(policy *group.JoinPolicyInfo) (question, answer string, err error) {
+	if policy == nil || policy.JoinPolicy < joinPolicyOpen || policy.JoinPolicy > joinPolicyClosed {
+		return "", "", status.Error(codes.InvalidArgument, "入群方式无效")
+	}
+	question = strings.TrimSpace(policy.JoinQuestion)
+	answer = strings.TrimSpace(policy.JoinAnswer)
+	if utf8.RuneCountInString(question) > maxJoinQuestionLength || utf8.RuneCountInString(answer) > maxJoinQuestionLength {
+		return "", "", status.Errorf(codes.InvalidArgument, "入群问题和答案最多%d个字符", maxJoinQuestionLength)
+	}
+	if policy.JoinPolicy == joinPolicyQuestion && question == "" {
+		return "", "", status.Error(codes.InvalidArgument, "请设置入群问题")
+	}
+	return question, answer, nil
+}
+
+// matchJoinAnswer 校验入群问题回答（忽略首尾空白和大小写）
+func matchJoinAnswer(expected, answer string) bool {
+	return strings.EqualFold(strings.TrimSpace(expected), strings.TrimSpace(answer))
+}
+
+// toJoinPolicyInfo 群组入群设置转换为 RPC 返回结构
+func toJoinPolicyInfo(groupInfo *model.ImGroup) *group.JoinPolicyInfo {
+	return &group.JoinPolicyInfo{
+		JoinPolicy:   int32(groupInfo.JoinPolicy),
+		JoinQuestion: groupInfo.JoinQuestion,
+		JoinAnswer:   groupInfo.JoinAnswer,
+		Discoverable: groupInfo.Discoverable == 1,
+	}
+}
@@ -0,0 +1,54 @@
+package logic
+
+// mute.go - 禁言状态（个人限时禁言、全员禁言）
+//
+// 个人禁言记录截止时间（mute_until，0 表示永久禁言），到期后不需要定时任务清理，
+// 读取时按当前时间判断即自动解除；全员禁言开启后只有拥有禁言成员权限的成员（群主和默认配置下的管理员）可以发言
+
+import (
+	"time"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/common/groupperm"
+)
+
+// maxMuteDuration 单次禁言的最长时长（秒）
+const maxMuteDuration = 30 * 24 * 3600
+
+// memberMuteState 成员个人禁言是否生效及截止时间，已到期的禁言视为未禁言
+func memberMuteState(m *model.ImGroupMember) (mute int32, until int64) {
+	if m.Mute != 1 {
+		return 0, 0
+	}
+	if m.MuteUntil > 0 && m.MuteUntil <= time.Now().Unix() {
+		return 0, 0
+	}
+	return 1, m.MuteUntil
+}
+
+// speakState 成员当前能否发言：个人禁言未到期，或全员禁言且没有禁言成员权限时不能发言
+// until 为禁言截止时间戳（0 表示直到解除），byMuteAll 表示原因是全员禁言
+func speakState(groupInfo *model.ImGroup, m *model.ImGroupMember) (muted bool, until int64, byMuteAll bool) {
+	if groupInfo != nil && groupInfo.MuteAll == 1 && !groupperm.Has(memberPermissions(groupInfo, m.Role), groupperm.Mute) {
+		return true, 0, true
+	}
+	mute, until := memberMuteState(m)
+	return mute == 1, until, false
+}
+
+// toMemberInfo 群成员转换为 RPC 返回结构
+func toMemberInfo(m *model.ImGroupMember) *group.MemberInfo {
+	mute, until := memberMuteState(m)
+	return &group.MemberInfo{
+		Id:        m.Id,
+		GroupId:   m.GroupId,
+		UserId:    m.UserId,
+		Role:      int32(m.Role),
+		Nickname:  m.Nickname.String,
+		Mute:      mute,
+		JoinedAt:  m.JoinedAt.Unix(),
+		ReadSeq:   m.ReadSeq,
+		MuteUntil: until,
+	}
+}
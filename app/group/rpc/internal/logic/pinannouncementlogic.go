@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PinAnnouncementLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPinAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PinAnnouncementLogic {
+	return &PinAnnouncementLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 置顶/取消置顶群公告（需要管理群公告权限）
+// PinAnnouncement 置顶/取消置顶群公告（需要管理群公告权限），每个群最多一条置顶公告
+func (l *PinAnnouncementLogic) PinAnnouncement(in *group.PinAnnouncementReq) (*group.PinAnnouncementResp, error) {
+	announcement, err := findAnnouncement(l.ctx, l.svcCtx, in.AnnouncementId)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := authorize(l.ctx, l.svcCtx, announcement.GroupId, in.OperatorId, groupperm.Announce); err != nil {
+		return nil, err
+	}
+
+	// 状态未变化时不重复通知
+	if (announcement.Pinned == 1) == in.Pinned {
+		return &group.PinAnnouncementResp{Success: true}, nil
+	}
+	if err := l.svcCtx.ImGroupAnnouncementModel.SetPinned(l.ctx, announcement, in.Pinned); err != nil {
+		l.Logger.Errorf("设置群公告置顶失败: id=%d, err=%v", in.AnnouncementId, err)
+		return nil, status.Error(codes.Internal, "操作失败")
+	}
+
+	action := announcementActionUnpin
+	if in.Pinned {
+		action = announcementActionPin
+	}
+	pushAnnouncementEvent(l.svcCtx, announcement, action, in.OperatorId)
+
+	return &group.PinAnnouncementResp{Success: true}, nil
+}
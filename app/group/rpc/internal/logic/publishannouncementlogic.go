@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PublishAnnouncementLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPublishAnnouncementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishAnnouncementLogic {
+	return &PublishAnnouncementLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PublishAnnouncement 发布群公告（需要管理群公告权限），推送群事件并写入群消息时间线
+func (l *PublishAnnouncementLogic) PublishAnnouncement(in *group.PublishAnnouncementReq) (*group.PublishAnnouncementResp, error) {
+	if in.GroupId == "" || in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	content, err := checkAnnouncementContent(in.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 验证群组和操作者权限
+	if _, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.Announce); err != nil {
+		return nil, err
+	}
+
+	// 2. 写入公告和第一版历史（置顶时取消其他公告的置顶）
+	now := time.Now()
+	announcement := &model.ImGroupAnnouncement{
+		GroupId:   in.GroupId,
+		AuthorId:  uint64(in.OperatorId),
+		EditorId:  uint64(in.OperatorId),
+		Content:   content,
+		Version:   1,
+		Status:    1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if in.RequireConfirm {
+		announcement.RequireConfirm = 1
+	}
+	if in.Pin {
+		announcement.Pinned = 1
+	}
+	if err := l.svcCtx.ImGroupAnnouncementModel.Publish(l.ctx, announcement); err != nil {
+		l.Logger.Errorf("发布群公告失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "发布公告失败")
+	}
+
+	// 3. 推送通知并写入群消息时间线
+	pushAnnouncementEvent(l.svcCtx, announcement, announcementActionPublish, in.OperatorId)
+	recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventAnnouncementPublish, in.OperatorId)
+
+	return &group.PublishAnnouncementResp{
+		Announcement: toAnnouncementInfo(announcement, false),
+	}, nil
+}
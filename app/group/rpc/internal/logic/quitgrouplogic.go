@@ -37,8 +37,9 @@ func (l *QuitGroupLogic) QuitGroup(in *group.QuitGroupReq) (*group.QuitGroupResp
 		return nil, err
 	}
 
-	// 2. 验证是否为群主 (群主不能直接退群，需先转让或解散)
-	if groupInfo.OwnerId == in.UserId {
+	// 2. 验证是否为群主 (群主不能直接退群，需先转让或解散；开启自动转让时转让给入群最早的管理员)
+	isOwner := groupInfo.OwnerId == in.UserId
+	if isOwner && !in.AutoTransfer {
 		return nil, errors.New("群主不能直接退群，请先转让群主或解散群组")
 	}
 
@@ -48,6 +49,23 @@ func (l *QuitGroupLogic) QuitGroup(in *group.QuitGroupReq) (*group.QuitGroupResp
 		return nil, errors.New("你不是群成员")
 	}
 
+	// 3.1 群主自动转让（转让成功后群主已降为普通成员，再按普通成员退群）
+	var newOwnerId int64
+	if isOwner {
+		newOwner, err := l.svcCtx.ImGroupMemberModel.FindEarliestAdmin(l.ctx, in.GroupId)
+		if err == model.ErrNotFound {
+			return nil, errors.New("群内没有管理员，无法自动转让群主，请先转让群主或解散群组")
+		}
+		if err != nil {
+			l.Logger.Errorf("查询群管理员失败: %v", err)
+			return nil, errors.New("退出群组失败")
+		}
+		if err := transferOwnership(l.ctx, l.svcCtx, groupInfo, member, newOwner); err != nil {
+			return nil, err
+		}
+		newOwnerId = newOwner.UserId
+	}
+
 	// 4. 删除成员记录
 	err = l.svcCtx.ImGroupMemberModel.Delete(l.ctx, member.Id)
 	if err != nil {
@@ -83,6 +101,7 @@ func (l *QuitGroupLogic) QuitGroup(in *group.QuitGroupReq) (*group.QuitGroupResp
 		"userId":  in.UserId,
 		"groupId": in.GroupId,
 	})
+	recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventMemberQuit, in.UserId)
 
-	return &group.QuitGroupResp{}, nil
+	return &group.QuitGroupResp{NewOwnerId: newOwnerId}, nil
 }
@@ -0,0 +1,147 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RedeemInviteLinkLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedeemInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeemInviteLinkLogic {
+	return &RedeemInviteLinkLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 通过邀请链接入群（免审核时直接入群，否则提交入群申请）
+// RedeemInviteLink 免审核链接与同意入群申请走同一入群流程，操作人记为链接创建人；
+// 邀请链接视为邀请，不受“仅限邀请”和入群问题限制，群组禁止加入时不可使用
+func (l *RedeemInviteLinkLogic) RedeemInviteLink(in *group.RedeemInviteLinkReq) (*group.RedeemInviteLinkResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	// 1. 验证链接状态
+	link, err := findInviteLinkByCode(l.ctx, l.svcCtx, in.Code)
+	if err != nil {
+		return nil, err
+	}
+	if linkStatus := inviteLinkStatus(link); linkStatus != inviteLinkStatusValid {
+		return nil, inviteLinkInvalidError(linkStatus)
+	}
+
+	// 2. 验证群组状态，创建人已不再拥有创建该链接所需的权限时链接失效
+	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, link.GroupId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "群组不存在")
+		}
+		return nil, err
+	}
+	if groupInfo.Status != 1 {
+		return nil, status.Error(codes.FailedPrecondition, "群组已解散")
+	}
+	if groupInfo.JoinPolicy == joinPolicyClosed {
+		return nil, status.Error(codes.FailedPrecondition, "该群已禁止加入")
+	}
+	creator, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, link.GroupId, int64(link.CreatorId))
+	if err != nil && err != model.ErrNotFound {
+		return nil, err
+	}
+	creatorPerm := groupperm.Invite
+	if link.AutoApprove == 1 {
+		creatorPerm |= groupperm.ApproveJoin
+	}
+	if creator == nil || !groupperm.Has(memberPermissions(groupInfo, creator.Role), creatorPerm) {
+		return nil, status.Error(codes.FailedPrecondition, "邀请链接已失效")
+	}
+
+	// 3. 检查用户是否已是群成员、是否已有待处理的申请
+	member, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, link.GroupId, in.UserId)
+	if err != nil && err != model.ErrNotFound {
+		return nil, err
+	}
+	if member != nil {
+		return nil, status.Error(codes.AlreadyExists, "您已经是群成员")
+	}
+	autoApprove := link.AutoApprove == 1
+	if autoApprove {
+		if groupInfo.MemberCount >= groupInfo.MaxMembers {
+			return nil, status.Error(codes.ResourceExhausted, "群成员已满")
+		}
+	} else {
+		pendingRequest, err := l.svcCtx.ImGroupJoinRequestModel.FindPendingByGroupAndUser(l.ctx, link.GroupId, uint64(in.UserId))
+		if err != nil && err != sqlc.ErrNotFound && err != model.ErrNotFound {
+			return nil, err
+		}
+		if pendingRequest != nil {
+			return nil, status.Error(codes.AlreadyExists, "已有待处理的入群申请，请耐心等待")
+		}
+	}
+
+	// 4. 占用一次使用次数（并发兑换时以数据库条件更新为准）
+	ok, err := l.svcCtx.ImGroupInviteLinkModel.Consume(l.ctx, link)
+	if err != nil {
+		l.Logger.Errorf("占用邀请链接次数失败: code=%s, err=%v", link.Code, err)
+		return nil, status.Error(codes.Internal, "入群失败")
+	}
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "邀请链接已失效")
+	}
+
+	// 5. 免审核直接入群，否则提交入群申请；未能入群或提交申请时归还使用次数
+	if autoApprove {
+		added, err := addGroupMember(l.ctx, l.svcCtx, groupInfo, in.UserId, int64(link.CreatorId))
+		if err != nil {
+			l.releaseLink(link)
+			return nil, err
+		}
+		if !added {
+			// 并发兑换时另一个请求已将用户加入群组
+			l.releaseLink(link)
+			return nil, status.Error(codes.AlreadyExists, "您已经是群成员")
+		}
+		return &group.RedeemInviteLinkResp{
+			GroupId: link.GroupId,
+			Joined:  true,
+		}, nil
+	}
+
+	message := strings.TrimSpace(in.Message)
+	if message == "" {
+		message = defaultInviteLinkJoinMessage
+	}
+	requestId, err := createJoinRequest(l.ctx, l.svcCtx, link.GroupId, in.UserId, message, "")
+	if err != nil {
+		l.releaseLink(link)
+		return nil, err
+	}
+
+	return &group.RedeemInviteLinkResp{
+		GroupId:   link.GroupId,
+		RequestId: requestId,
+	}, nil
+}
+
+// releaseLink 归还已占用的使用次数，失败时只记录日志
+func (l *RedeemInviteLinkLogic) releaseLink(link *model.ImGroupInviteLink) {
+	if err := l.svcCtx.ImGroupInviteLinkModel.Release(l.ctx, link); err != nil {
+		l.Logger.Errorf("归还邀请链接次数失败: code=%s, err=%v", link.Code, err)
+	}
+}
@@ -35,17 +35,21 @@ func (l *SearchGroupLogic) SearchGroup(in *group.SearchGroupReq) (*group.SearchG
 	var list []*group.GroupInfo
 	for _, g := range groups {
 		list = append(list, &group.GroupInfo{
-			Id:          int64(g.Id),
-			GroupId:     g.GroupId,
-			Name:        g.Name,
-			Avatar:      g.Avatar.String,
-			OwnerId:     g.OwnerId,
-			Description: g.Description.String,
-			MaxMembers:  int32(g.MaxMembers),
-			MemberCount: int32(g.MemberCount),
-			Status:      int32(g.Status),
-			CreatedAt:   g.CreatedAt.Unix(),
-			UpdatedAt:   g.UpdatedAt.Unix(),
+			Id:           int64(g.Id),
+			GroupId:      g.GroupId,
+			Name:         g.Name,
+			Avatar:       g.Avatar.String,
+			OwnerId:      g.OwnerId,
+			Description:  g.Description.String,
+			MaxMembers:   int32(g.MaxMembers),
+			MemberCount:  int32(g.MemberCount),
+			Status:       int32(g.Status),
+			CreatedAt:    g.CreatedAt.Unix(),
+			UpdatedAt:    g.UpdatedAt.Unix(),
+			MuteAll:      int32(g.MuteAll),
+			JoinPolicy:   int32(g.JoinPolicy),
+			JoinQuestion: g.JoinQuestion,
+			Discoverable: g.Discoverable == 1,
 		})
 	}
 
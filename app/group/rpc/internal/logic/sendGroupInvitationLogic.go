@@ -8,6 +8,7 @@ import (
 	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -29,39 +30,25 @@ func NewSendGroupInvitationLogic(ctx context.Context, svcCtx *svc.ServiceContext
 // ==================== 群聊邀请相关 ====================
 // SendGroupInvitation 发送群聊邀请
 func (l *SendGroupInvitationLogic) SendGroupInvitation(in *group.SendGroupInvitationReq) (*group.SendGroupInvitationResp, error) {
-	// 1. 验证群组是否存在且状态正常
-	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, in.GroupId)
+	// 1. 验证群组状态正常且邀请人有邀请成员权限
+	groupInfo, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.InviterId, groupperm.Invite)
 	if err != nil {
-		logx.Errorf("群组不存在或已解散: %v", err)
-		return nil, errors.New("群组不存在")
-	}
-	if groupInfo.Status != 1 {
-		return nil, errors.New("群组已解散")
-	}
-
-	// 2. 验证邀请人是否为群成员
-	inviterMember, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.InviterId)
-	if err != nil {
-		logx.Errorf("邀请人不是群成员: %v", err)
-		return nil, errors.New("您不是群成员，无法邀请")
-	}
-	if inviterMember == nil {
-		return nil, errors.New("您不是群成员，无法邀请")
+		return nil, err
 	}
 
-	// 3. 验证被邀请人是否已在群中
+	// 2. 验证被邀请人是否已在群中
 	existingMember, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.InviteeId)
 	if err == nil && existingMember != nil {
 		return nil, errors.New("该用户已在群中")
 	}
 
-	// 4. 检查是否存在待处理的邀请（避免重复邀请）
+	// 3. 检查是否存在待处理的邀请（避免重复邀请）
 	pendingInvitation, err := l.svcCtx.ImGroupInvitationModel.FindPendingByGroupAndInvitee(l.ctx, in.GroupId, in.InviteeId)
 	if err == nil && pendingInvitation != nil {
 		return nil, errors.New("已存在待处理的邀请")
 	}
 
-	// 5. 创建邀请记录
+	// 4. 创建邀请记录
 	invitation := &model.ImGroupInvitation{
 		GroupId:   in.GroupId,
 		InviterId: uint64(in.InviterId),
@@ -84,7 +71,7 @@ func (l *SendGroupInvitationLogic) SendGroupInvitation(in *group.SendGroupInvita
 		return nil, errors.New("发送邀请失败")
 	}
 
-	// 6. 通过WebSocket推送通知给被邀请人
+	// 5. 通过WebSocket推送通知给被邀请人
 	go func() {
 		_ = l.svcCtx.WsPushClient.PushToUser(in.InviteeId, "group_invitation", map[string]interface{}{
 			"invitationId": invitationId,
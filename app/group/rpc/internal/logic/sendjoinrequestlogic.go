@@ -2,15 +2,14 @@ package logic
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"strings"
 
 	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stores/sqlc"
 )
 
 type SendJoinRequestLogic struct {
@@ -50,55 +49,50 @@ func (l *SendJoinRequestLogic) SendJoinRequest(in *group.SendJoinRequestReq) (*g
 		return nil, errors.New("您已经是群成员")
 	}
 
-	// 3. 检查是否已有待处理的申请（防止重复申请）
-	pendingRequest, err := l.svcCtx.ImGroupJoinRequestModel.FindPendingByGroupAndUser(l.ctx, in.GroupId, uint64(in.UserId))
-	if err != nil && err != sqlc.ErrNotFound && err != model.ErrNotFound {
-		return nil, err
-	}
-	if pendingRequest != nil {
-		return nil, errors.New("已有待处理的入群申请，请耐心等待")
-	}
-
-	// 3.5 检查是否有历史申请记录（已同意/已拒绝）
-	// 如果用户之前申请过但已被处理（同意后被踢出，或被拒绝），复用该记录
-	existingRequest, err := l.svcCtx.ImGroupJoinRequestModel.FindLatestByGroupAndUser(l.ctx, in.GroupId, uint64(in.UserId))
-	if err != nil && err != sqlc.ErrNotFound && err != model.ErrNotFound {
-		return nil, err
-	}
-
-	if existingRequest != nil {
-		// 复用已有记录，更新为新的申请
-		existingRequest.Message = in.Message
-		existingRequest.Status = 0                              // 重置为待处理
-		existingRequest.HandlerId = sql.NullInt64{Valid: false} // 清空处理人
-		err = l.svcCtx.ImGroupJoinRequestModel.Update(l.ctx, existingRequest)
-		if err != nil {
-			return nil, err
+	// 3. 按入群方式处理
+	answer := strings.TrimSpace(in.Answer)
+	switch groupInfo.JoinPolicy {
+	case joinPolicyInviteOnly:
+		return nil, errors.New("该群仅支持邀请入群")
+	case joinPolicyClosed:
+		return nil, errors.New("该群已禁止加入")
+	case joinPolicyOpen:
+		return l.joinDirectly(groupInfo, in.UserId)
+	case joinPolicyQuestion:
+		if answer == "" {
+			return nil, errors.New("请回答入群问题")
 		}
-		return &group.SendJoinRequestResp{
-			RequestId: int64(existingRequest.Id),
-		}, nil
-	}
-
-	// 4. 没有任何历史记录，创建新的入群申请记录
-	joinRequest := &model.ImGroupJoinRequest{
-		GroupId: in.GroupId,
-		UserId:  uint64(in.UserId),
-		Message: in.Message,
-		Status:  0, // 0-待处理
+		// 设置了答案时自动校验，回答正确直接入群；未设置答案时提交申请由管理员人工审核
+		if groupInfo.JoinAnswer != "" {
+			if !matchJoinAnswer(groupInfo.JoinAnswer, answer) {
+				return nil, errors.New("入群问题回答错误")
+			}
+			return l.joinDirectly(groupInfo, in.UserId)
+		}
+	default:
+		answer = ""
 	}
 
-	result, err := l.svcCtx.ImGroupJoinRequestModel.Insert(l.ctx, joinRequest)
+	// 4. 提交入群申请
+	requestId, err := createJoinRequest(l.ctx, l.svcCtx, in.GroupId, in.UserId, in.Message, answer)
 	if err != nil {
 		return nil, err
 	}
 
-	requestId, err := result.LastInsertId()
-	if err != nil {
+	return &group.SendJoinRequestResp{
+		RequestId: requestId,
+	}, nil
+}
+
+// joinDirectly 无需审核直接入群（自由加入、入群问题回答正确）
+func (l *SendJoinRequestLogic) joinDirectly(groupInfo *model.ImGroup, userId int64) (*group.SendJoinRequestResp, error) {
+	if groupInfo.MemberCount >= groupInfo.MaxMembers {
+		return nil, errors.New("群成员已满")
+	}
+	if _, err := addGroupMember(l.ctx, l.svcCtx, groupInfo, userId, userId); err != nil {
 		return nil, err
 	}
-
 	return &group.SendJoinRequestResp{
-		RequestId: requestId,
+		Joined: true,
 	}, nil
 }
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetGroupMuteAllLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetGroupMuteAllLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetGroupMuteAllLogic {
+	return &SetGroupMuteAllLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 设置全员禁言
+func (l *SetGroupMuteAllLogic) SetGroupMuteAll(in *group.SetGroupMuteAllReq) (*group.SetGroupMuteAllResp, error) {
+	if in.MuteAll != 0 && in.MuteAll != 1 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	// 1. 验证群组和操作者权限
+	groupInfo, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.Mute)
+	if err != nil {
+		return nil, err
+	}
+
+	// 状态未变化时不重复通知
+	if groupInfo.MuteAll == int64(in.MuteAll) {
+		return &group.SetGroupMuteAllResp{Success: true}, nil
+	}
+
+	// 2. 更新全员禁言状态
+	groupInfo.MuteAll = int64(in.MuteAll)
+	if err := l.svcCtx.ImGroupModel.Update(l.ctx, groupInfo); err != nil {
+		l.Logger.Errorf("设置全员禁言失败: %v", err)
+		return nil, status.Error(codes.Internal, "操作失败")
+	}
+
+	// 3. 推送通知
+	_ = l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "updateGroup", map[string]interface{}{
+		"groupId": in.GroupId,
+		"muteAll": in.MuteAll,
+	})
+	if in.MuteAll == 1 {
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventMuteAllOn, in.OperatorId)
+	} else {
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventMuteAllOff, in.OperatorId)
+	}
+
+	return &group.SetGroupMuteAllResp{Success: true}, nil
+}
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetGroupPermissionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetGroupPermissionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetGroupPermissionsLogic {
+	return &SetGroupPermissionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 设置管理员和普通成员的权限（仅群主）
+// SetGroupPermissions 修改群权限矩阵，群主始终拥有全部权限，修改后推送群事件
+func (l *SetGroupPermissionsLogic) SetGroupPermissions(in *group.SetGroupPermissionsReq) (*group.SetGroupPermissionsResp, error) {
+	if in.GroupId == "" || in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if in.AdminPermissions&^groupperm.All != 0 || in.MemberPermissions&^groupperm.All != 0 {
+		return nil, status.Error(codes.InvalidArgument, "权限配置无效")
+	}
+
+	// 1. 验证群组和操作者权限 (必须是群主)
+	groupInfo, _, err := authorizeOwner(l.ctx, l.svcCtx, in.GroupId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 配置未变化时不重复通知
+	if groupInfo.AdminPermissions == in.AdminPermissions && groupInfo.MemberPermissions == in.MemberPermissions {
+		return &group.SetGroupPermissionsResp{Success: true}, nil
+	}
+
+	// 2. 更新权限配置
+	groupInfo.AdminPermissions = in.AdminPermissions
+	groupInfo.MemberPermissions = in.MemberPermissions
+	if err := l.svcCtx.ImGroupModel.Update(l.ctx, groupInfo); err != nil {
+		l.Logger.Errorf("设置群权限失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "操作失败")
+	}
+
+	// 3. 推送通知，客户端据此刷新操作入口
+	_ = l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "updatePermissions", map[string]interface{}{
+		"groupId":           in.GroupId,
+		"operatorId":        in.OperatorId,
+		"adminPermissions":  in.AdminPermissions,
+		"memberPermissions": in.MemberPermissions,
+	})
+
+	return &group.SetGroupPermissionsResp{Success: true}, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetJoinPolicyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetJoinPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetJoinPolicyLogic {
+	return &SetJoinPolicyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 设置入群方式、入群问题和是否可被搜索（需要修改群信息权限）
+// SetJoinPolicy 更新群组入群设置，已提交的入群申请不受影响
+func (l *SetJoinPolicyLogic) SetJoinPolicy(in *group.SetJoinPolicyReq) (*group.SetJoinPolicyResp, error) {
+	if in.GroupId == "" || in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	question, answer, err := checkJoinPolicy(in.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	groupInfo, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.EditInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	groupInfo.JoinPolicy = int64(in.Policy.JoinPolicy)
+	groupInfo.JoinQuestion = question
+	groupInfo.JoinAnswer = answer
+	groupInfo.Discoverable = 0
+	if in.Policy.Discoverable {
+		groupInfo.Discoverable = 1
+	}
+	if err := l.svcCtx.ImGroupModel.Update(l.ctx, groupInfo); err != nil {
+		l.Logger.Errorf("更新入群设置失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "更新入群设置失败")
+	}
+
+	return &group.SetJoinPolicyResp{
+		Policy: toJoinPolicyInfo(groupInfo),
+	}, nil
+}
@@ -3,9 +3,12 @@ package logic
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -26,13 +29,17 @@ func NewSetMemberMuteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Set
 
 // SetMemberMute 设置成员禁言
 func (l *SetMemberMuteLogic) SetMemberMute(in *group.SetMemberMuteReq) (*group.SetMemberMuteResp, error) {
-	// 1. 验证权限 (群主或管理员)
-	operator, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.OperatorId)
-	if err != nil {
-		return nil, errors.New("操作者不是群成员")
+	if in.Mute != 0 && in.Mute != 1 {
+		return nil, errors.New("参数错误")
+	}
+	if in.Duration < 0 || in.Duration > maxMuteDuration {
+		return nil, fmt.Errorf("禁言时长最长%d天", maxMuteDuration/86400)
 	}
-	if operator.Role == 3 {
-		return nil, errors.New("没有权限")
+
+	// 1. 验证权限
+	_, operator, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.Mute)
+	if err != nil {
+		return nil, err
 	}
 
 	// 2. 验证目标
@@ -41,19 +48,20 @@ func (l *SetMemberMuteLogic) SetMemberMute(in *group.SetMemberMuteReq) (*group.S
 		return nil, errors.New("目标成员不存在")
 	}
 
-	// 权限检查：管理员不能禁言管理员或群主
-	if operator.Role == 2 && (target.Role == 1 || target.Role == 2) {
+	// 权限检查：群主不能被禁言，只有群主可以禁言管理员
+	if target.Role == groupperm.RoleOwner {
+		return nil, errors.New("不能禁言群主")
+	}
+	if !canManageMember(operator.Role, target.Role) {
 		return nil, errors.New("权限不足")
 	}
 
-	// 3. 更新禁言状态
-	// Mute: 0=Unmute, >0 = Mute untill timestamp? Or duration? usually timestamp or 1=mute forever.
-	// Assuming Mute field is Int64 (timestamp or duration). If user passes standard "seconds" or "until".
-	// Let's assume in.Mute is int32 (seconds? or status).
-	// Usually 0 = unmute, >0 = mute duration seconds.
-	// If logic stores "MuteUntil" timestamp in DB:
-	// target.Mute = time.Now().Unix() + int64(in.Mute)
+	// 3. 更新禁言状态：记录截止时间戳（0 表示永久禁言），到期后读取时自动视为解除
 	target.Mute = int64(in.Mute)
+	target.MuteUntil = 0
+	if in.Mute == 1 && in.Duration > 0 {
+		target.MuteUntil = time.Now().Unix() + in.Duration
+	}
 	err = l.svcCtx.ImGroupMemberModel.Update(l.ctx, target)
 	if err != nil {
 		l.Logger.Errorf("设置禁言失败: %v", err)
@@ -62,9 +70,15 @@ func (l *SetMemberMuteLogic) SetMemberMute(in *group.SetMemberMuteReq) (*group.S
 
 	// 4. 推送通知
 	_ = l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "memberUpdate", map[string]interface{}{
-		"userId": in.MemberId,
-		"mute":   in.Mute,
+		"userId":    in.MemberId,
+		"mute":      in.Mute,
+		"muteUntil": target.MuteUntil,
 	})
+	if in.Mute > 0 {
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventMemberMute, in.OperatorId, in.MemberId)
+	} else {
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventMemberUnmute, in.OperatorId, in.MemberId)
+	}
 
-	return &group.SetMemberMuteResp{}, nil
+	return &group.SetMemberMuteResp{Success: true, MuteUntil: target.MuteUntil}, nil
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
 
@@ -27,28 +26,28 @@ func NewSetMemberRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Set
 
 // SetMemberRole 设置成员角色 (管理员/普通成员)
 func (l *SetMemberRoleLogic) SetMemberRole(in *group.SetMemberRoleReq) (*group.SetMemberRoleResp, error) {
-	// 1. 验证群组
-	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, in.GroupId)
-	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, errors.New("群组不存在")
-		}
-		return nil, err
+	// 群主只能通过转让群主变更，否则 im_group.owner_id 与成员角色不一致
+	if in.Role != 2 && in.Role != 3 {
+		return nil, errors.New("角色无效，设置群主请使用转让群主")
 	}
 
-	// 2. 验证操作者权限 (必须是群主)
-	if groupInfo.OwnerId != in.OperatorId {
-		return nil, errors.New("只有群主可以设置管理员")
+	// 1. 验证群组和操作者权限 (必须是群主)
+	if _, _, err := authorizeOwner(l.ctx, l.svcCtx, in.GroupId, in.OperatorId); err != nil {
+		return nil, err
 	}
 
-	// 3. 验证目标成员
+	// 2. 验证目标成员
 	member, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.MemberId)
 	if err != nil {
 		return nil, errors.New("成员不存在")
 	}
+	if member.Role == 1 {
+		return nil, errors.New("不能修改群主的角色")
+	}
 
-	// 4. 更新角色
+	// 3. 更新角色
 	// Role: 1=Owner, 2=Admin, 3=Member
+	prevRole := member.Role
 	member.Role = int64(in.Role)
 	err = l.svcCtx.ImGroupMemberModel.Update(l.ctx, member)
 	if err != nil {
@@ -56,11 +55,17 @@ func (l *SetMemberRoleLogic) SetMemberRole(in *group.SetMemberRoleReq) (*group.S
 		return nil, errors.New("设置角色失败")
 	}
 
-	// 5. 推送通知
+	// 4. 推送通知
 	_ = l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "memberUpdate", map[string]interface{}{
 		"userId": in.MemberId,
 		"role":   in.Role,
 	})
+	switch {
+	case in.Role == 2 && prevRole != 2:
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventAdminAdd, in.OperatorId, in.MemberId)
+	case in.Role == 3 && prevRole == 2:
+		recordGroupEvent(l.ctx, l.svcCtx, in.GroupId, eventAdminRemove, in.OperatorId, in.MemberId)
+	}
 
 	return &group.SetMemberRoleResp{}, nil
 }
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/messageclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 群系统消息事件（与 message-rpc 系统消息模板一致）
+const (
+	eventGroupCreate         = "groupCreate"
+	eventMemberInvite        = "memberInvite"
+	eventMemberJoin          = "memberJoin"
+	eventMemberQuit          = "memberQuit"
+	eventMemberKick          = "memberKick"
+	eventAdminAdd            = "adminAdd"
+	eventAdminRemove         = "adminRemove"
+	eventMemberMute          = "memberMute"
+	eventMemberUnmute        = "memberUnmute"
+	eventMuteAllOn           = "muteAllOn"
+	eventMuteAllOff          = "muteAllOff"
+	eventAnnouncementPublish = "announcementPublish"
+	eventOwnerTransfer       = "ownerTransfer"
+	eventGroupDismiss        = "groupDismiss"
+)
+
+// recordGroupEvent 将群事件写入群消息时间线（系统消息），离线成员同步历史消息时也能看到
+// 写入失败只记录日志，不影响群操作本身
+func recordGroupEvent(ctx context.Context, svcCtx *svc.ServiceContext, groupId, event string, operatorId int64, targetIds ...int64) {
+	_, err := svcCtx.MessageRpc.SendGroupSystemMessage(ctx, &messageclient.SendGroupSystemMessageReq{
+		GroupId:    groupId,
+		Event:      event,
+		OperatorId: operatorId,
+		TargetIds:  targetIds,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("写入群系统消息失败: groupId=%s, event=%s, err=%v", groupId, event, err)
+	}
+}
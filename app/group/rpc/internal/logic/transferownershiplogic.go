@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SkyeIM/app/group/model"
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/group/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TransferOwnershipLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTransferOwnershipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransferOwnershipLogic {
+	return &TransferOwnershipLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// TransferOwnership 转让群主（原群主降为普通成员）
+func (l *TransferOwnershipLogic) TransferOwnership(in *group.TransferOwnershipReq) (*group.TransferOwnershipResp, error) {
+	if in.GroupId == "" || in.NewOwnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if in.NewOwnerId == in.OperatorId {
+		return nil, status.Error(codes.InvalidArgument, "不能转让给自己")
+	}
+
+	// 1. 验证群组和操作者权限 (必须是群主)
+	groupInfo, oldOwner, err := authorizeOwner(l.ctx, l.svcCtx, in.GroupId, in.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 验证新群主
+	newOwner, err := l.svcCtx.ImGroupMemberModel.FindOneByGroupIdUserId(l.ctx, in.GroupId, in.NewOwnerId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "新群主不是群成员")
+		}
+		return nil, err
+	}
+
+	// 3. 转让并通知
+	if err := transferOwnership(l.ctx, l.svcCtx, groupInfo, oldOwner, newOwner); err != nil {
+		return nil, err
+	}
+
+	return &group.TransferOwnershipResp{Success: true}, nil
+}
+
+// transferOwnership 在事务中转让群主，成功后推送群事件并写入群消息时间线
+// 主动转让和群主退群时的自动转让共用
+func transferOwnership(ctx context.Context, svcCtx *svc.ServiceContext, groupInfo *model.ImGroup, oldOwner, newOwner *model.ImGroupMember) error {
+	if err := svcCtx.ImGroupModel.TransferOwner(ctx, groupInfo, oldOwner, newOwner); err != nil {
+		if errors.Is(err, model.ErrOwnerChanged) {
+			return status.Error(codes.Aborted, "群主已变更，请刷新后重试")
+		}
+		logx.WithContext(ctx).Errorf("转让群主失败: groupId=%s, from=%d, to=%d, err=%v", groupInfo.GroupId, oldOwner.UserId, newOwner.UserId, err)
+		return status.Error(codes.Internal, "转让群主失败")
+	}
+
+	_ = svcCtx.WsPushClient.PushGroupEvent(groupInfo.GroupId, "ownerTransfer", map[string]interface{}{
+		"groupId":    groupInfo.GroupId,
+		"oldOwnerId": oldOwner.UserId,
+		"newOwnerId": newOwner.UserId,
+	})
+	recordGroupEvent(ctx, svcCtx, groupInfo.GroupId, eventOwnerTransfer, oldOwner.UserId, newOwner.UserId)
+	return nil
+}
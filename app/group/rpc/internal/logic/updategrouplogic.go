@@ -5,9 +5,9 @@ import (
 	"database/sql"
 	"errors"
 
-	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/group/rpc/internal/svc"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -28,22 +28,13 @@ func NewUpdateGroupLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Updat
 
 // UpdateGroup 更新群组信息
 func (l *UpdateGroupLogic) UpdateGroup(in *group.UpdateGroupReq) (*group.UpdateGroupResp, error) {
-	// 1. 验证群组
-	groupInfo, err := l.svcCtx.ImGroupModel.FindOneByGroupId(l.ctx, in.GroupId)
+	// 1. 验证群组和操作者权限
+	groupInfo, _, err := authorize(l.ctx, l.svcCtx, in.GroupId, in.OperatorId, groupperm.EditInfo)
 	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, errors.New("群组不存在")
-		}
 		return nil, err
 	}
 
-	// 2. 验证权限 (群主或管理员?) 通常只有群主或管理员可以修改
-	if groupInfo.OwnerId != in.OperatorId {
-		// Administrator check logic todo...
-		return nil, errors.New("无权修改群信息")
-	}
-
-	// 3. 更新字段
+	// 2. 更新字段
 	if in.Name != "" {
 		groupInfo.Name = in.Name
 	}
@@ -60,7 +51,7 @@ func (l *UpdateGroupLogic) UpdateGroup(in *group.UpdateGroupReq) (*group.UpdateG
 		return nil, errors.New("更新失败")
 	}
 
-	// 4. 推送更新事件
+	// 3. 推送更新事件
 	// ... (push logic)
 	_ = l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "updateGroup", map[string]interface{}{
 		"groupId": in.GroupId,
@@ -37,5 +37,10 @@ func (l *UpdateGroupReadSeqLogic) UpdateGroupReadSeq(in *group.UpdateGroupReadSe
 		return nil, errors.New("更新失败")
 	}
 
+	// 已读进度变化后清除群未读计数，由 message-rpc 查询时按新的已读 Seq 重建
+	if err := l.svcCtx.UnreadCounter.ResetGroup(l.ctx, in.UserId, in.GroupId); err != nil {
+		l.Logger.Errorf("清除群未读计数失败: %v", err)
+	}
+
 	return &group.UpdateGroupReadSeqResp{}, nil
 }
@@ -83,12 +83,24 @@ func (s *GroupServer) SetMemberRole(ctx context.Context, in *group.SetMemberRole
 	return l.SetMemberRole(in)
 }
 
+// 转让群主
+func (s *GroupServer) TransferOwnership(ctx context.Context, in *group.TransferOwnershipReq) (*group.TransferOwnershipResp, error) {
+	l := logic.NewTransferOwnershipLogic(ctx, s.svcCtx)
+	return l.TransferOwnership(in)
+}
+
 // 设置成员禁言
 func (s *GroupServer) SetMemberMute(ctx context.Context, in *group.SetMemberMuteReq) (*group.SetMemberMuteResp, error) {
 	l := logic.NewSetMemberMuteLogic(ctx, s.svcCtx)
 	return l.SetMemberMute(in)
 }
 
+// 设置全员禁言
+func (s *GroupServer) SetGroupMuteAll(ctx context.Context, in *group.SetGroupMuteAllReq) (*group.SetGroupMuteAllResp, error) {
+	l := logic.NewSetGroupMuteAllLogic(ctx, s.svcCtx)
+	return l.SetGroupMuteAll(in)
+}
+
 // 检查用户是否在群组中
 func (s *GroupServer) CheckMembership(ctx context.Context, in *group.CheckMembershipReq) (*group.CheckMembershipResp, error) {
 	l := logic.NewCheckMembershipLogic(ctx, s.svcCtx)
@@ -143,13 +155,13 @@ func (s *GroupServer) SendJoinRequest(ctx context.Context, in *group.SendJoinReq
 	return l.SendJoinRequest(in)
 }
 
-// 处理入群申请（群主/管理员）
+// 处理入群申请（需要审批入群申请权限）
 func (s *GroupServer) HandleJoinRequest(ctx context.Context, in *group.HandleJoinRequestReq) (*group.HandleJoinRequestResp, error) {
 	l := logic.NewHandleJoinRequestLogic(ctx, s.svcCtx)
 	return l.HandleJoinRequest(in)
 }
 
-// 获取群的入群申请列表（群主/管理员查看）
+// 获取群的入群申请列表（需要审批入群申请权限）
 func (s *GroupServer) GetGroupJoinRequests(ctx context.Context, in *group.GetGroupJoinRequestsReq) (*group.GetGroupJoinRequestsResp, error) {
 	l := logic.NewGetGroupJoinRequestsLogic(ctx, s.svcCtx)
 	return l.GetGroupJoinRequests(in)
@@ -166,3 +178,105 @@ func (s *GroupServer) GetAllManagedGroupJoinRequests(ctx context.Context, in *gr
 	l := logic.NewGetAllManagedGroupJoinRequestsLogic(ctx, s.svcCtx)
 	return l.GetAllManagedGroupJoinRequests(in)
 }
+
+// 设置入群方式、入群问题和是否可被搜索（需要修改群信息权限）
+func (s *GroupServer) SetJoinPolicy(ctx context.Context, in *group.SetJoinPolicyReq) (*group.SetJoinPolicyResp, error) {
+	l := logic.NewSetJoinPolicyLogic(ctx, s.svcCtx)
+	return l.SetJoinPolicy(in)
+}
+
+// 获取入群设置（需要修改群信息权限，包含入群问题答案）
+func (s *GroupServer) GetJoinPolicy(ctx context.Context, in *group.GetJoinPolicyReq) (*group.GetJoinPolicyResp, error) {
+	l := logic.NewGetJoinPolicyLogic(ctx, s.svcCtx)
+	return l.GetJoinPolicy(in)
+}
+
+// ==================== 群公告相关 ====================
+func (s *GroupServer) PublishAnnouncement(ctx context.Context, in *group.PublishAnnouncementReq) (*group.PublishAnnouncementResp, error) {
+	l := logic.NewPublishAnnouncementLogic(ctx, s.svcCtx)
+	return l.PublishAnnouncement(in)
+}
+
+// 编辑群公告（需要管理群公告权限）
+func (s *GroupServer) UpdateAnnouncement(ctx context.Context, in *group.UpdateAnnouncementReq) (*group.UpdateAnnouncementResp, error) {
+	l := logic.NewUpdateAnnouncementLogic(ctx, s.svcCtx)
+	return l.UpdateAnnouncement(in)
+}
+
+// 删除群公告（需要管理群公告权限）
+func (s *GroupServer) DeleteAnnouncement(ctx context.Context, in *group.DeleteAnnouncementReq) (*group.DeleteAnnouncementResp, error) {
+	l := logic.NewDeleteAnnouncementLogic(ctx, s.svcCtx)
+	return l.DeleteAnnouncement(in)
+}
+
+// 置顶/取消置顶群公告（需要管理群公告权限）
+func (s *GroupServer) PinAnnouncement(ctx context.Context, in *group.PinAnnouncementReq) (*group.PinAnnouncementResp, error) {
+	l := logic.NewPinAnnouncementLogic(ctx, s.svcCtx)
+	return l.PinAnnouncement(in)
+}
+
+// 获取群公告列表（置顶公告在前）
+func (s *GroupServer) GetAnnouncementList(ctx context.Context, in *group.GetAnnouncementListReq) (*group.GetAnnouncementListResp, error) {
+	l := logic.NewGetAnnouncementListLogic(ctx, s.svcCtx)
+	return l.GetAnnouncementList(in)
+}
+
+// 获取群公告编辑历史
+func (s *GroupServer) GetAnnouncementHistory(ctx context.Context, in *group.GetAnnouncementHistoryReq) (*group.GetAnnouncementHistoryResp, error) {
+	l := logic.NewGetAnnouncementHistoryLogic(ctx, s.svcCtx)
+	return l.GetAnnouncementHistory(in)
+}
+
+// 确认已读群公告
+func (s *GroupServer) ConfirmAnnouncement(ctx context.Context, in *group.ConfirmAnnouncementReq) (*group.ConfirmAnnouncementResp, error) {
+	l := logic.NewConfirmAnnouncementLogic(ctx, s.svcCtx)
+	return l.ConfirmAnnouncement(in)
+}
+
+// 获取群公告确认情况（需要管理群公告权限）
+func (s *GroupServer) GetAnnouncementConfirmations(ctx context.Context, in *group.GetAnnouncementConfirmationsReq) (*group.GetAnnouncementConfirmationsResp, error) {
+	l := logic.NewGetAnnouncementConfirmationsLogic(ctx, s.svcCtx)
+	return l.GetAnnouncementConfirmations(in)
+}
+
+// ==================== 群邀请链接相关 ====================
+func (s *GroupServer) CreateInviteLink(ctx context.Context, in *group.CreateInviteLinkReq) (*group.CreateInviteLinkResp, error) {
+	l := logic.NewCreateInviteLinkLogic(ctx, s.svcCtx)
+	return l.CreateInviteLink(in)
+}
+
+// 撤销群邀请链接（创建人或有审批入群申请权限的成员）
+func (s *GroupServer) RevokeInviteLink(ctx context.Context, in *group.RevokeInviteLinkReq) (*group.RevokeInviteLinkResp, error) {
+	l := logic.NewRevokeInviteLinkLogic(ctx, s.svcCtx)
+	return l.RevokeInviteLink(in)
+}
+
+// 获取群邀请链接列表（需要邀请成员权限，没有审批入群申请权限时只返回自己创建的链接）
+func (s *GroupServer) GetInviteLinks(ctx context.Context, in *group.GetInviteLinksReq) (*group.GetInviteLinksResp, error) {
+	l := logic.NewGetInviteLinksLogic(ctx, s.svcCtx)
+	return l.GetInviteLinks(in)
+}
+
+// 按邀请码查询邀请链接和群组信息（入群落地页、二维码）
+func (s *GroupServer) GetInviteLink(ctx context.Context, in *group.GetInviteLinkReq) (*group.GetInviteLinkResp, error) {
+	l := logic.NewGetInviteLinkLogic(ctx, s.svcCtx)
+	return l.GetInviteLink(in)
+}
+
+// 通过邀请链接入群（免审核时直接入群，否则提交入群申请）
+func (s *GroupServer) RedeemInviteLink(ctx context.Context, in *group.RedeemInviteLinkReq) (*group.RedeemInviteLinkResp, error) {
+	l := logic.NewRedeemInviteLinkLogic(ctx, s.svcCtx)
+	return l.RedeemInviteLink(in)
+}
+
+// ==================== 群权限相关 ====================
+func (s *GroupServer) SetGroupPermissions(ctx context.Context, in *group.SetGroupPermissionsReq) (*group.SetGroupPermissionsResp, error) {
+	l := logic.NewSetGroupPermissionsLogic(ctx, s.svcCtx)
+	return l.SetGroupPermissions(in)
+}
+
+// 获取群权限配置和当前用户的有效权限（群成员）
+func (s *GroupServer) GetGroupPermissions(ctx context.Context, in *group.GetGroupPermissionsReq) (*group.GetGroupPermissionsResp, error) {
+	l := logic.NewGetGroupPermissionsLogic(ctx, s.svcCtx)
+	return l.GetGroupPermissions(in)
+}
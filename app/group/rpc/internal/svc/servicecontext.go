@@ -3,35 +3,51 @@ package svc
 import (
 	"SkyeIM/app/group/model"
 	"SkyeIM/app/group/rpc/internal/config"
+	"SkyeIM/app/message/rpc/messageclient"
+	"SkyeIM/common/unread"
 	"SkyeIM/common/wspush"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config                  config.Config
-	Redis                   *redis.Redis
-	ImGroupModel            model.ImGroupModel
-	ImGroupMemberModel      model.ImGroupMemberModel
-	ImGroupInvitationModel  model.ImGroupInvitationModel
-	ImGroupJoinRequestModel model.ImGroupJoinRequestModel
-	WsPushClient            *wspush.WsPushClient
+	Config                          config.Config
+	Redis                           *redis.Redis
+	UnreadCounter                   *unread.Counter
+	ImGroupModel                    model.ImGroupModel
+	ImGroupMemberModel              model.ImGroupMemberModel
+	ImGroupInvitationModel          model.ImGroupInvitationModel
+	ImGroupJoinRequestModel         model.ImGroupJoinRequestModel
+	ImGroupAnnouncementModel        model.ImGroupAnnouncementModel
+	ImGroupAnnouncementHistoryModel model.ImGroupAnnouncementHistoryModel
+	ImGroupAnnouncementConfirmModel model.ImGroupAnnouncementConfirmModel
+	ImGroupInviteLinkModel          model.ImGroupInviteLinkModel
+	MessageRpc                      messageclient.Message
+	WsPushClient                    *wspush.WsPushClient
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.MySQL.DataSource)
+	rds := redis.MustNewRedis(redis.RedisConf{
+		Host: c.Cache[0].Host,
+		Type: c.Cache[0].Type,
+		Pass: c.Cache[0].Pass,
+	})
 	return &ServiceContext{
-		Config: c,
-		Redis: redis.MustNewRedis(redis.RedisConf{
-			Host: c.Cache[0].Host,
-			Type: c.Cache[0].Type,
-			Pass: c.Cache[0].Pass,
-		}),
-		ImGroupModel:            model.NewImGroupModel(conn, c.Cache),
-		ImGroupMemberModel:      model.NewImGroupMemberModel(conn, c.Cache),
-		ImGroupInvitationModel:  model.NewImGroupInvitationModel(conn, c.Cache),
-		ImGroupJoinRequestModel: model.NewImGroupJoinRequestModel(conn, c.Cache),
-		WsPushClient:            wspush.NewWsPushClient(c.WsServiceUrl, c.WsPushSecret),
+		Config:                          c,
+		Redis:                           rds,
+		UnreadCounter:                   unread.NewCounter(rds),
+		ImGroupModel:                    model.NewImGroupModel(conn, c.Cache),
+		ImGroupMemberModel:              model.NewImGroupMemberModel(conn, c.Cache),
+		ImGroupInvitationModel:          model.NewImGroupInvitationModel(conn, c.Cache),
+		ImGroupJoinRequestModel:         model.NewImGroupJoinRequestModel(conn, c.Cache),
+		ImGroupAnnouncementModel:        model.NewImGroupAnnouncementModel(conn, c.Cache),
+		ImGroupAnnouncementHistoryModel: model.NewImGroupAnnouncementHistoryModel(conn, c.Cache),
+		ImGroupAnnouncementConfirmModel: model.NewImGroupAnnouncementConfirmModel(conn, c.Cache),
+		ImGroupInviteLinkModel:          model.NewImGroupInviteLinkModel(conn, c.Cache),
+		MessageRpc:                      messageclient.NewMessage(zrpc.MustNewClient(c.MessageRpc)),
+		WsPushClient:                    wspush.NewWsPushClient(c.WsServiceUrl, c.WsPushSecret),
 	}
 }
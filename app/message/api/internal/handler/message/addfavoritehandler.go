@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"net/http"
+
+	"SkyeIM/app/message/api/internal/logic/message"
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 收藏消息
+func AddFavoriteHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AddFavoriteReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := message.NewAddFavoriteLogic(r.Context(), svcCtx)
+		resp, err := l.AddFavorite(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
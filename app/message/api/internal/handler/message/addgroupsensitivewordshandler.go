@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"net/http"
+
+	"SkyeIM/app/message/api/internal/logic/message"
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 添加群自定义敏感词（需要内容审核权限）
+func AddGroupSensitiveWordsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AddGroupSensitiveWordsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := message.NewAddGroupSensitiveWordsLogic(r.Context(), svcCtx)
+		resp, err := l.AddGroupSensitiveWords(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"net/http"
+
+	"SkyeIM/app/message/api/internal/logic/message"
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 发起群投票
+func CreatePollHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CreatePollReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := message.NewCreatePollLogic(r.Context(), svcCtx)
+		resp, err := l.CreatePoll(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
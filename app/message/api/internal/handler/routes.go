@@ -27,6 +27,54 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/conversations",
 				Handler: message.GetConversationsHandler(serverCtx),
 			},
+			{
+				// 创建聊天记录导出任务
+				Method:  http.MethodPost,
+				Path:    "/export/create",
+				Handler: message.CreateExportJobHandler(serverCtx),
+			},
+			{
+				// 获取导出任务详情
+				Method:  http.MethodGet,
+				Path:    "/export/detail",
+				Handler: message.GetExportJobHandler(serverCtx),
+			},
+			{
+				// 获取导出任务列表
+				Method:  http.MethodGet,
+				Path:    "/export/list",
+				Handler: message.ListExportJobsHandler(serverCtx),
+			},
+			{
+				// 收藏消息
+				Method:  http.MethodPost,
+				Path:    "/favorite/add",
+				Handler: message.AddFavoriteHandler(serverCtx),
+			},
+			{
+				// 删除收藏
+				Method:  http.MethodPost,
+				Path:    "/favorite/delete",
+				Handler: message.DeleteFavoriteHandler(serverCtx),
+			},
+			{
+				// 获取收藏列表（支持关键词、标签、消息类型筛选）
+				Method:  http.MethodGet,
+				Path:    "/favorite/list",
+				Handler: message.ListFavoritesHandler(serverCtx),
+			},
+			{
+				// 获取收藏使用过的标签
+				Method:  http.MethodGet,
+				Path:    "/favorite/tags",
+				Handler: message.ListFavoriteTagsHandler(serverCtx),
+			},
+			{
+				// 修改收藏的标签和备注
+				Method:  http.MethodPost,
+				Path:    "/favorite/update",
+				Handler: message.UpdateFavoriteHandler(serverCtx),
+			},
 			{
 				// 获取群聊历史消息
 				Method:  http.MethodGet,
@@ -51,12 +99,90 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/history",
 				Handler: message.GetMessageHistoryHandler(serverCtx),
 			},
+			{
+				// 处理送审消息：通过或删除消息
+				Method:  http.MethodPost,
+				Path:    "/moderation/review/handle",
+				Handler: message.ReviewModerationHandler(serverCtx),
+			},
+			{
+				// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+				Method:  http.MethodGet,
+				Path:    "/moderation/review/list",
+				Handler: message.ListModerationReviewsHandler(serverCtx),
+			},
+			{
+				// 添加群自定义敏感词（需要内容审核权限）
+				Method:  http.MethodPost,
+				Path:    "/moderation/word/add",
+				Handler: message.AddGroupSensitiveWordsHandler(serverCtx),
+			},
+			{
+				// 获取群自定义敏感词（需要内容审核权限）
+				Method:  http.MethodGet,
+				Path:    "/moderation/word/list",
+				Handler: message.ListGroupSensitiveWordsHandler(serverCtx),
+			},
+			{
+				// 删除群自定义敏感词（需要内容审核权限）
+				Method:  http.MethodPost,
+				Path:    "/moderation/word/remove",
+				Handler: message.RemoveGroupSensitiveWordsHandler(serverCtx),
+			},
 			{
 				// 私聊离线同步（拉取剩余离线消息）
 				Method:  http.MethodGet,
 				Path:    "/offline",
 				Handler: message.GetPrivateOfflineSyncHandler(serverCtx),
 			},
+			{
+				// 置顶消息（群聊需要置顶消息权限）
+				Method:  http.MethodPost,
+				Path:    "/pin/add",
+				Handler: message.PinMessageHandler(serverCtx),
+			},
+			{
+				// 获取会话的置顶消息列表
+				Method:  http.MethodGet,
+				Path:    "/pin/list",
+				Handler: message.ListPinnedMessagesHandler(serverCtx),
+			},
+			{
+				// 取消置顶消息（群聊需要置顶消息权限）
+				Method:  http.MethodPost,
+				Path:    "/pin/remove",
+				Handler: message.UnpinMessageHandler(serverCtx),
+			},
+			{
+				// 发起群投票
+				Method:  http.MethodPost,
+				Path:    "/poll/create",
+				Handler: message.CreatePollHandler(serverCtx),
+			},
+			{
+				// 获取投票详情和计票结果
+				Method:  http.MethodGet,
+				Path:    "/poll/get",
+				Handler: message.GetPollHandler(serverCtx),
+			},
+			{
+				// 撤回自己的投票
+				Method:  http.MethodPost,
+				Path:    "/poll/retract",
+				Handler: message.RetractPollVoteHandler(serverCtx),
+			},
+			{
+				// 投票（已投过票时覆盖原选择）
+				Method:  http.MethodPost,
+				Path:    "/poll/vote",
+				Handler: message.VotePollHandler(serverCtx),
+			},
+			{
+				// 私聊增量同步（按会话seq拉取）
+				Method:  http.MethodGet,
+				Path:    "/private/sync",
+				Handler: message.GetPrivateSyncHandler(serverCtx),
+			},
 			{
 				// 标记私聊消息为已读
 				Method:  http.MethodPost,
@@ -64,17 +190,83 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Handler: message.MarkAsReadHandler(serverCtx),
 			},
 			{
-				// 模糊搜索聊天记录
+				// 获取群消息保留策略
+				Method:  http.MethodGet,
+				Path:    "/retention/group",
+				Handler: message.GetGroupRetentionHandler(serverCtx),
+			},
+			{
+				// 设置群消息保留策略（需要设置消息保留期限权限，默认仅群主）
+				Method:  http.MethodPost,
+				Path:    "/retention/group/set",
+				Handler: message.SetGroupRetentionHandler(serverCtx),
+			},
+			{
+				// 取消待发送的定时消息
+				Method:  http.MethodPost,
+				Path:    "/scheduled/cancel",
+				Handler: message.CancelScheduledMessageHandler(serverCtx),
+			},
+			{
+				// 创建定时消息
+				Method:  http.MethodPost,
+				Path:    "/scheduled/create",
+				Handler: message.CreateScheduledMessageHandler(serverCtx),
+			},
+			{
+				// 获取定时消息列表
+				Method:  http.MethodGet,
+				Path:    "/scheduled/list",
+				Handler: message.ListScheduledMessagesHandler(serverCtx),
+			},
+			{
+				// 修改待发送的定时消息
+				Method:  http.MethodPost,
+				Path:    "/scheduled/update",
+				Handler: message.UpdateScheduledMessageHandler(serverCtx),
+			},
+			{
+				// 搜索聊天记录
 				Method:  http.MethodGet,
 				Path:    "/search",
 				Handler: message.SearchMessageHandler(serverCtx),
 			},
+			{
+				// 关注话题（有新回复时收到通知）
+				Method:  http.MethodPost,
+				Path:    "/thread/follow",
+				Handler: message.FollowThreadHandler(serverCtx),
+			},
+			{
+				// 获取话题回复列表
+				Method:  http.MethodGet,
+				Path:    "/thread/replies",
+				Handler: message.GetThreadRepliesHandler(serverCtx),
+			},
+			{
+				// 发送群话题回复（不进入群聊主时间线）
+				Method:  http.MethodPost,
+				Path:    "/thread/reply",
+				Handler: message.SendThreadReplyHandler(serverCtx),
+			},
+			{
+				// 取消关注话题
+				Method:  http.MethodPost,
+				Path:    "/thread/unfollow",
+				Handler: message.UnfollowThreadHandler(serverCtx),
+			},
 			{
 				// 获取未读消息数（私聊）
 				Method:  http.MethodGet,
 				Path:    "/unread/count",
 				Handler: message.GetUnreadCountHandler(serverCtx),
 			},
+			{
+				// 获取所有会话的未读数汇总（私聊、群聊及@我）
+				Method:  http.MethodGet,
+				Path:    "/unread/summary",
+				Handler: message.GetUnreadSummaryHandler(serverCtx),
+			},
 		},
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/message"),
@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddFavoriteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 收藏消息
+func NewAddFavoriteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddFavoriteLogic {
+	return &AddFavoriteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AddFavoriteLogic) AddFavorite(req *types.AddFavoriteReq) (resp *types.FavoriteInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.AddFavorite(l.ctx, &message.AddFavoriteReq{
+		UserId: userId,
+		MsgId:  req.MsgId,
+		Tags:   req.Tags,
+		Note:   req.Note,
+	})
+	if err != nil {
+		l.Logger.Errorf("AddFavorite RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toFavoriteInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddGroupSensitiveWordsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 添加群自定义敏感词（需要内容审核权限）
+func NewAddGroupSensitiveWordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddGroupSensitiveWordsLogic {
+	return &AddGroupSensitiveWordsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AddGroupSensitiveWordsLogic) AddGroupSensitiveWords(req *types.AddGroupSensitiveWordsReq) (resp *types.GroupSensitiveWordsResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.AddGroupSensitiveWords(l.ctx, &message.GroupSensitiveWordsReq{
+		UserId:  userId,
+		GroupId: req.GroupId,
+		Words:   req.Words,
+		Action:  req.Action,
+	})
+	if err != nil {
+		l.Logger.Errorf("AddGroupSensitiveWords RPC failed: %v", err)
+		return nil, err
+	}
+
+	return toGroupSensitiveWordsResp(rpcResp), nil
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelScheduledMessageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消待发送的定时消息
+func NewCancelScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelScheduledMessageLogic {
+	return &CancelScheduledMessageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelScheduledMessageLogic) CancelScheduledMessage(req *types.CancelScheduledMessageReq) (resp *types.Empty, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = l.svcCtx.MessageRpc.CancelScheduledMessage(l.ctx, &message.CancelScheduledMessageReq{
+		Id:     req.Id,
+		UserId: userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("CancelScheduledMessage RPC failed: %v", err)
+		return nil, err
+	}
+
+	return &types.Empty{}, nil
+}
@@ -0,0 +1,341 @@
+package message
+
+import (
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+)
+
+// toMessageInfo RPC 消息转换为 API 返回结构
+func toMessageInfo(msg *message.MessageInfo) types.MessageInfo {
+	return types.MessageInfo{
+		Id:          msg.Id,
+		MsgId:       msg.MsgId,
+		FromUserId:  msg.FromUserId,
+		ToUserId:    msg.ToUserId,
+		ChatType:    msg.ChatType,
+		GroupId:     msg.GroupId,
+		Content:     msg.Content,
+		ContentType: msg.ContentType,
+		Status:      msg.Status,
+		CreatedAt:   msg.CreatedAt,
+		Seq:         msg.Seq,
+		AtUserIds:   msg.AtUserIds,
+		Payload:     toMessagePayload(msg.Payload),
+		ExpireTtl:   msg.ExpireTtl,
+		ExpireMode:  msg.ExpireMode,
+		ExpireAt:    msg.ExpireAt,
+		Thread:      toThreadSummaryPtr(msg.Thread),
+		LinkPreview: toLinkPreview(msg.LinkPreview),
+	}
+}
+
+// toMessagePayload 结构化消息内容转换，文本消息返回 nil
+func toMessagePayload(p *message.MessagePayload) *types.MessagePayload {
+	if p == nil {
+		return nil
+	}
+
+	out := &types.MessagePayload{}
+	if p.Image != nil {
+		out.Image = &types.ImagePayload{
+			Url:          p.Image.Url,
+			Width:        p.Image.Width,
+			Height:       p.Image.Height,
+			ThumbnailUrl: p.Image.ThumbnailUrl,
+		}
+	}
+	if p.File != nil {
+		out.File = &types.FilePayload{
+			Url:  p.File.Url,
+			Name: p.File.Name,
+			Size: p.File.Size,
+			Mime: p.File.Mime,
+		}
+	}
+	if p.Voice != nil {
+		out.Voice = &types.VoicePayload{
+			Url:      p.Voice.Url,
+			Duration: p.Voice.Duration,
+		}
+	}
+	if p.Video != nil {
+		out.Video = &types.VideoPayload{
+			Url:          p.Video.Url,
+			Duration:     p.Video.Duration,
+			Width:        p.Video.Width,
+			Height:       p.Video.Height,
+			ThumbnailUrl: p.Video.ThumbnailUrl,
+		}
+	}
+	if p.Location != nil {
+		out.Location = &types.LocationPayload{
+			Latitude:  p.Location.Latitude,
+			Longitude: p.Location.Longitude,
+			Name:      p.Location.Name,
+			Address:   p.Location.Address,
+		}
+	}
+	if p.Contact != nil {
+		out.Contact = &types.ContactPayload{
+			UserId:   p.Contact.UserId,
+			Nickname: p.Contact.Nickname,
+			Avatar:   p.Contact.Avatar,
+		}
+	}
+	if p.System != nil {
+		out.System = &types.SystemPayload{
+			Event:      p.System.Event,
+			OperatorId: p.System.OperatorId,
+			TargetIds:  p.System.TargetIds,
+			Params:     p.System.Params,
+			Text:       p.System.Text,
+		}
+	}
+	if p.Poll != nil {
+		out.Poll = &types.PollPayload{
+			Question:  p.Poll.Question,
+			Options:   p.Poll.Options,
+			Multiple:  p.Poll.Multiple,
+			Anonymous: p.Poll.Anonymous,
+			Deadline:  p.Poll.Deadline,
+		}
+	}
+	return out
+}
+
+// toScheduledMessageInfo RPC 定时消息转换为 API 返回结构
+func toScheduledMessageInfo(info *message.ScheduledMessageInfo) types.ScheduledMessageInfo {
+	return types.ScheduledMessageInfo{
+		Id:          info.Id,
+		MsgId:       info.MsgId,
+		FromUserId:  info.FromUserId,
+		ToUserId:    info.ToUserId,
+		ChatType:    info.ChatType,
+		GroupId:     info.GroupId,
+		Content:     info.Content,
+		ContentType: info.ContentType,
+		AtUserIds:   info.AtUserIds,
+		SendAt:      info.SendAt,
+		Status:      info.Status,
+		MessageId:   info.MessageId,
+		FailReason:  info.FailReason,
+		CreatedAt:   info.CreatedAt,
+	}
+}
+
+// toExportJobInfo RPC 导出任务转换为 API 返回结构
+func toExportJobInfo(info *message.ExportJobInfo) types.ExportJobInfo {
+	return types.ExportJobInfo{
+		Id:           info.Id,
+		OwnerId:      info.OwnerId,
+		ChatType:     info.ChatType,
+		PeerId:       info.PeerId,
+		GroupId:      info.GroupId,
+		Format:       info.Format,
+		StartTime:    info.StartTime,
+		EndTime:      info.EndTime,
+		Status:       info.Status,
+		FileSize:     info.FileSize,
+		MessageCount: info.MessageCount,
+		FailReason:   info.FailReason,
+		DownloadUrl:  info.DownloadUrl,
+		UrlExpireAt:  info.UrlExpireAt,
+		CreatedAt:    info.CreatedAt,
+		UpdatedAt:    info.UpdatedAt,
+	}
+}
+
+// toFavoriteInfo RPC 收藏转换为 API 返回结构
+func toFavoriteInfo(info *message.FavoriteInfo) types.FavoriteInfo {
+	tags := info.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return types.FavoriteInfo{
+		Id:           info.Id,
+		MsgId:        info.MsgId,
+		MessageId:    info.MessageId,
+		ChatType:     info.ChatType,
+		PeerId:       info.PeerId,
+		GroupId:      info.GroupId,
+		Seq:          info.Seq,
+		FromUserId:   info.FromUserId,
+		ContentType:  info.ContentType,
+		Content:      info.Content,
+		Payload:      toMessagePayload(info.Payload),
+		Tags:         tags,
+		Note:         info.Note,
+		MsgCreatedAt: info.MsgCreatedAt,
+		CreatedAt:    info.CreatedAt,
+		UpdatedAt:    info.UpdatedAt,
+	}
+}
+
+// toPinnedMessageInfo RPC 置顶消息转换为 API 返回结构
+func toPinnedMessageInfo(info *message.PinnedMessageInfo) types.PinnedMessageInfo {
+	return types.PinnedMessageInfo{
+		Id:           info.Id,
+		ChatType:     info.ChatType,
+		GroupId:      info.GroupId,
+		MsgId:        info.MsgId,
+		MessageId:    info.MessageId,
+		Seq:          info.Seq,
+		FromUserId:   info.FromUserId,
+		ContentType:  info.ContentType,
+		Content:      info.Content,
+		Payload:      toMessagePayload(info.Payload),
+		MsgCreatedAt: info.MsgCreatedAt,
+		PinnedBy:     info.PinnedBy,
+		PinnedAt:     info.PinnedAt,
+	}
+}
+
+// toGroupRetentionInfo RPC 群消息保留策略转换为 API 返回结构
+func toGroupRetentionInfo(info *message.GroupRetentionInfo) types.GroupRetentionInfo {
+	return types.GroupRetentionInfo{
+		GroupId:          info.GroupId,
+		RetentionDays:    info.RetentionDays,
+		IsDefault:        info.IsDefault,
+		DefaultDays:      info.DefaultDays,
+		ArchiveAfterDays: info.ArchiveAfterDays,
+		UpdatedBy:        info.UpdatedBy,
+		UpdatedAt:        info.UpdatedAt,
+	}
+}
+
+// toConversationUnread RPC 会话未读数转换为 API 返回结构
+func toConversationUnread(c *message.ConversationUnread) types.ConversationUnread {
+	return types.ConversationUnread{
+		ChatType: c.ChatType,
+		PeerId:   c.PeerId,
+		GroupId:  c.GroupId,
+		Unread:   c.Unread,
+		AtMe:     c.AtMe,
+	}
+}
+
+// toPollInfo RPC 投票详情转换为 API 返回结构
+func toPollInfo(info *message.PollInfo) types.PollInfo {
+	options := make([]types.PollOption, 0, len(info.Options))
+	for _, opt := range info.Options {
+		voterIds := opt.VoterIds
+		if voterIds == nil {
+			voterIds = []int64{}
+		}
+		options = append(options, types.PollOption{
+			Index:    opt.Index,
+			Text:     opt.Text,
+			Count:    opt.Count,
+			VoterIds: voterIds,
+		})
+	}
+	myVotes := info.MyVotes
+	if myVotes == nil {
+		myVotes = []int32{}
+	}
+	return types.PollInfo{
+		MsgId:      info.MsgId,
+		GroupId:    info.GroupId,
+		CreatorId:  info.CreatorId,
+		Question:   info.Question,
+		Options:    options,
+		Multiple:   info.Multiple,
+		Anonymous:  info.Anonymous,
+		Deadline:   info.Deadline,
+		Closed:     info.Closed,
+		ClosedAt:   info.ClosedAt,
+		VoterCount: info.VoterCount,
+		MyVotes:    myVotes,
+		CreatedAt:  info.CreatedAt,
+	}
+}
+
+// toThreadSummary RPC 话题汇总转换为 API 返回结构
+func toThreadSummary(sum *message.ThreadSummary) types.ThreadSummary {
+	if sum == nil {
+		return types.ThreadSummary{}
+	}
+	return types.ThreadSummary{
+		ReplyCount:  sum.ReplyCount,
+		LastSeq:     sum.LastSeq,
+		LastReplyAt: sum.LastReplyAt,
+	}
+}
+
+// toThreadSummaryPtr 消息上的话题汇总，没有回复时返回 nil
+func toThreadSummaryPtr(sum *message.ThreadSummary) *types.ThreadSummary {
+	if sum == nil {
+		return nil
+	}
+	out := toThreadSummary(sum)
+	return &out
+}
+
+// toLinkPreview 链接预览转换，未抓取或抓取失败时返回 nil
+func toLinkPreview(p *message.LinkPreview) *types.LinkPreview {
+	if p == nil {
+		return nil
+	}
+	return &types.LinkPreview{
+		Url:         p.Url,
+		Title:       p.Title,
+		Description: p.Description,
+		Image:       p.Image,
+		SiteName:    p.SiteName,
+	}
+}
+
+// toThreadReplyInfo RPC 话题回复转换为 API 返回结构
+func toThreadReplyInfo(reply *message.ThreadReplyInfo) types.ThreadReplyInfo {
+	return types.ThreadReplyInfo{
+		Id:          reply.Id,
+		MsgId:       reply.MsgId,
+		RootMsgId:   reply.RootMsgId,
+		GroupId:     reply.GroupId,
+		Seq:         reply.Seq,
+		FromUserId:  reply.FromUserId,
+		Content:     reply.Content,
+		ContentType: reply.ContentType,
+		Payload:     toMessagePayload(reply.Payload),
+		CreatedAt:   reply.CreatedAt,
+	}
+}
+
+// toGroupSensitiveWordsResp RPC 群敏感词列表转换为 API 返回结构
+func toGroupSensitiveWordsResp(rpcResp *message.GroupSensitiveWordsResp) *types.GroupSensitiveWordsResp {
+	list := make([]types.SensitiveWordInfo, 0, len(rpcResp.List))
+	for _, word := range rpcResp.List {
+		list = append(list, types.SensitiveWordInfo{
+			Word:      word.Word,
+			Action:    word.Action,
+			CreatedBy: word.CreatedBy,
+			CreatedAt: word.CreatedAt,
+		})
+	}
+	return &types.GroupSensitiveWordsResp{List: list}
+}
+
+// toModerationReviewInfo RPC 送审消息转换为 API 返回结构
+func toModerationReviewInfo(review *message.ModerationReviewInfo) types.ModerationReviewInfo {
+	hitWords := review.HitWords
+	if hitWords == nil {
+		hitWords = []string{}
+	}
+	return types.ModerationReviewInfo{
+		Id:          review.Id,
+		MsgId:       review.MsgId,
+		RootMsgId:   review.RootMsgId,
+		ChatType:    review.ChatType,
+		FromUserId:  review.FromUserId,
+		ToUserId:    review.ToUserId,
+		GroupId:     review.GroupId,
+		Content:     review.Content,
+		ContentType: review.ContentType,
+		Payload:     toMessagePayload(review.Payload),
+		HitWords:    hitWords,
+		Status:      review.Status,
+		ReviewerId:  review.ReviewerId,
+		ReviewedAt:  review.ReviewedAt,
+		CreatedAt:   review.CreatedAt,
+	}
+}
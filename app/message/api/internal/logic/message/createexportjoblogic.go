@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateExportJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建聊天记录导出任务
+func NewCreateExportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateExportJobLogic {
+	return &CreateExportJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateExportJobLogic) CreateExportJob(req *types.CreateExportJobReq) (resp *types.ExportJobInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.CreateExportJob(l.ctx, &message.CreateExportJobReq{
+		UserId:    userId,
+		ChatType:  req.ChatType,
+		PeerId:    req.PeerId,
+		GroupId:   req.GroupId,
+		OwnerId:   req.OwnerId,
+		Format:    req.Format,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		l.Logger.Errorf("CreateExportJob RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toExportJobInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 发起群投票
+func NewCreatePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePollLogic {
+	return &CreatePollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreatePollLogic) CreatePoll(req *types.CreatePollReq) (resp *types.CreatePollResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.CreatePoll(l.ctx, &message.CreatePollReq{
+		UserId:    userId,
+		GroupId:   req.GroupId,
+		MsgId:     req.MsgId,
+		Question:  req.Question,
+		Options:   req.Options,
+		Multiple:  req.Multiple,
+		Anonymous: req.Anonymous,
+		Deadline:  req.Deadline,
+	})
+	if err != nil {
+		l.Logger.Errorf("CreatePoll RPC failed: %v", err)
+		return nil, err
+	}
+
+	return &types.CreatePollResp{
+		Id:        rpcResp.Id,
+		MsgId:     rpcResp.MsgId,
+		CreatedAt: rpcResp.CreatedAt,
+		Seq:       rpcResp.Seq,
+		Duplicate: rpcResp.Duplicate,
+		Poll:      toPollInfo(rpcResp.Poll),
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateScheduledMessageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建定时消息
+func NewCreateScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateScheduledMessageLogic {
+	return &CreateScheduledMessageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateScheduledMessageLogic) CreateScheduledMessage(req *types.CreateScheduledMessageReq) (resp *types.ScheduledMessageInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.CreateScheduledMessage(l.ctx, &message.CreateScheduledMessageReq{
+		FromUserId:  userId,
+		ChatType:    req.ChatType,
+		ToUserId:    req.ToUserId,
+		GroupId:     req.GroupId,
+		Content:     req.Content,
+		ContentType: req.ContentType,
+		AtUserIds:   req.AtUserIds,
+		SendAt:      req.SendAt,
+	})
+	if err != nil {
+		l.Logger.Errorf("CreateScheduledMessage RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toScheduledMessageInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteFavoriteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除收藏
+func NewDeleteFavoriteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteFavoriteLogic {
+	return &DeleteFavoriteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteFavoriteLogic) DeleteFavorite(req *types.DeleteFavoriteReq) (resp *types.DeleteFavoriteResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.DeleteFavorite(l.ctx, &message.DeleteFavoriteReq{
+		UserId: userId,
+		Ids:    req.Ids,
+	})
+	if err != nil {
+		l.Logger.Errorf("DeleteFavorite RPC failed: %v", err)
+		return nil, err
+	}
+
+	return &types.DeleteFavoriteResp{
+		Deleted: rpcResp.Deleted,
+	}, nil
+}
@@ -51,20 +51,7 @@ func (l *GetAtMeMessagesLogic) GetAtMeMessages(req *types.GetAtMeMessagesReq) (r
 	// 转换为API格式
 	list := make([]types.MessageInfo, 0, len(rpcResp.List))
 	for _, msg := range rpcResp.List {
-		list = append(list, types.MessageInfo{
-			Id:          msg.Id,
-			MsgId:       msg.MsgId,
-			FromUserId:  msg.FromUserId,
-			ToUserId:    msg.ToUserId,
-			ChatType:    msg.ChatType,
-			GroupId:     msg.GroupId,
-			Content:     msg.Content,
-			ContentType: msg.ContentType,
-			Status:      msg.Status,
-			CreatedAt:   msg.CreatedAt,
-			Seq:         msg.Seq,
-			AtUserIds:   msg.AtUserIds,
-		})
+		list = append(list, toMessageInfo(msg))
 	}
 
 	return &types.GetAtMeMessagesResp{
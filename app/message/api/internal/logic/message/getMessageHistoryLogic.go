@@ -39,10 +39,13 @@ func (l *GetMessageHistoryLogic) GetMessageHistory(req *types.GetMessageHistoryR
 
 	// 调用 RPC 获取消息列表
 	rpcResp, err := l.svcCtx.MessageRpc.GetMessageList(l.ctx, &message.GetMessageListReq{
-		UserId:    userId,
-		PeerId:    req.PeerId,
-		LastMsgId: req.LastMsgId,
-		Limit:     req.Limit,
+		UserId:      userId,
+		PeerId:      req.PeerId,
+		LastMsgId:   req.LastMsgId,
+		Limit:       req.Limit,
+		Direction:   req.Direction,
+		AnchorMsgId: req.AnchorMsgId,
+		AnchorTime:  req.AnchorTime,
 	})
 	if err != nil {
 		l.Logger.Errorf("GetMessageHistory RPC failed: %v", err)
@@ -52,24 +55,13 @@ func (l *GetMessageHistoryLogic) GetMessageHistory(req *types.GetMessageHistoryR
 	// 转换为 API 响应格式
 	list := make([]types.MessageInfo, 0, len(rpcResp.List))
 	for _, msg := range rpcResp.List {
-		list = append(list, types.MessageInfo{
-			Id:          msg.Id,
-			MsgId:       msg.MsgId,
-			FromUserId:  msg.FromUserId,
-			ToUserId:    msg.ToUserId,
-			ChatType:    msg.ChatType,
-			GroupId:     msg.GroupId,
-			Content:     msg.Content,
-			ContentType: msg.ContentType,
-			Status:      msg.Status,
-			CreatedAt:   msg.CreatedAt,
-			Seq:         msg.Seq,
-		})
+		list = append(list, toMessageInfo(msg))
 	}
 
 	return &types.GetMessageHistoryResp{
-		List:    list,
-		HasMore: rpcResp.HasMore,
+		List:         list,
+		HasMore:      rpcResp.HasMore,
+		HasMoreAfter: rpcResp.HasMoreAfter,
 	}, nil
 }
 
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetExportJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取导出任务详情
+func NewGetExportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetExportJobLogic {
+	return &GetExportJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetExportJobLogic) GetExportJob(req *types.GetExportJobReq) (resp *types.ExportJobInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.GetExportJob(l.ctx, &message.GetExportJobReq{
+		Id:     req.Id,
+		UserId: userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("GetExportJob RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toExportJobInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -41,10 +41,14 @@ func (l *GetGroupMessageHistoryLogic) GetGroupMessageHistory(req *types.GetGroup
 	}
 
 	rpcResp, err := l.svcCtx.MessageRpc.GetGroupMessageList(l.ctx, &message.GetGroupMessageListReq{
-		UserId:    userId,
-		GroupId:   req.GroupId,
-		LastMsgId: req.LastMsgId,
-		Limit:     rpcLimit,
+		UserId:      userId,
+		GroupId:     req.GroupId,
+		LastMsgId:   req.LastMsgId,
+		Limit:       rpcLimit,
+		Direction:   req.Direction,
+		AnchorMsgId: req.AnchorMsgId,
+		AnchorSeq:   req.AnchorSeq,
+		AnchorTime:  req.AnchorTime,
 	})
 
 	if err != nil {
@@ -54,23 +58,12 @@ func (l *GetGroupMessageHistoryLogic) GetGroupMessageHistory(req *types.GetGroup
 
 	list := make([]types.MessageInfo, 0, len(rpcResp.List))
 	for _, msg := range rpcResp.List {
-		list = append(list, types.MessageInfo{
-			Id:          msg.Id,
-			MsgId:       msg.MsgId,
-			FromUserId:  msg.FromUserId,
-			ToUserId:    msg.ToUserId, // 群聊通常为0
-			ChatType:    msg.ChatType,
-			GroupId:     msg.GroupId,
-			Content:     msg.Content,
-			ContentType: msg.ContentType,
-			Status:      msg.Status,
-			CreatedAt:   msg.CreatedAt,
-			Seq:         msg.Seq,
-		})
+		list = append(list, toMessageInfo(msg))
 	}
 
 	return &types.GetGroupMessageHistoryResp{
-		List:    list,
-		HasMore: rpcResp.HasMore,
+		List:         list,
+		HasMore:      rpcResp.HasMore,
+		HasMoreAfter: rpcResp.HasMoreAfter,
 	}, nil
 }
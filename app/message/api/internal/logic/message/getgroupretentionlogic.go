@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetGroupRetentionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群消息保留策略
+func NewGetGroupRetentionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupRetentionLogic {
+	return &GetGroupRetentionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetGroupRetentionLogic) GetGroupRetention(req *types.GetGroupRetentionReq) (resp *types.GroupRetentionInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.GetGroupRetention(l.ctx, &message.GetGroupRetentionReq{
+		GroupId: req.GroupId,
+		UserId:  userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("GetGroupRetention RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toGroupRetentionInfo(rpcResp.Info)
+	return &info, nil
+}
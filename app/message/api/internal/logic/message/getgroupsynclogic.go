@@ -41,37 +41,21 @@ func (l *GetGroupSyncLogic) GetGroupSync(req *types.GetGroupSyncReq) (resp *type
 		UserId:  userId,
 		GroupId: req.GroupId,
 		Seq:     req.Seq,
+		Limit:   req.Limit,
 	})
 	if err != nil {
 		l.Logger.Errorf("GetGroupSync RPC failed: %v", err)
 		return nil, err
 	}
 
-	limit := req.Limit
-	if limit <= 0 || limit > 200 {
-		limit = 200
-	}
-
 	list := make([]types.MessageInfo, 0, len(rpcResp.List))
 	for _, msg := range rpcResp.List {
-		list = append(list, types.MessageInfo{
-			Id:          msg.Id,
-			MsgId:       msg.MsgId,
-			FromUserId:  msg.FromUserId,
-			ToUserId:    msg.ToUserId,
-			ChatType:    msg.ChatType,
-			GroupId:     msg.GroupId,
-			Content:     msg.Content,
-			ContentType: msg.ContentType,
-			Status:      msg.Status,
-			CreatedAt:   msg.CreatedAt,
-			Seq:         msg.Seq,
-			AtUserIds:   msg.AtUserIds,
-		})
-		if int32(len(list)) >= limit {
-			break
-		}
+		list = append(list, toMessageInfo(msg))
 	}
 
-	return &types.GetGroupSyncResp{List: list}, nil
+	return &types.GetGroupSyncResp{
+		List:        list,
+		SkippedSeqs: append([]uint64{}, rpcResp.SkippedSeqs...),
+		HasMore:     rpcResp.HasMore,
+	}, nil
 }
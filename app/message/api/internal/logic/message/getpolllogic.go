@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取投票详情和计票结果
+func NewGetPollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPollLogic {
+	return &GetPollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPollLogic) GetPoll(req *types.GetPollReq) (resp *types.PollInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.GetPoll(l.ctx, &message.GetPollReq{
+		UserId: userId,
+		MsgId:  req.MsgId,
+	})
+	if err != nil {
+		l.Logger.Errorf("GetPoll RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toPollInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -92,20 +92,7 @@ func (l *GetPrivateOfflineSyncLogic) GetPrivateOfflineSync(req *types.GetPrivate
 	// 5. 转换为API类型
 	list := make([]types.MessageInfo, 0, len(pageMessages))
 	for _, msg := range pageMessages {
-		list = append(list, types.MessageInfo{
-			Id:          msg.Id,
-			MsgId:       msg.MsgId,
-			FromUserId:  msg.FromUserId,
-			ToUserId:    msg.ToUserId,
-			ChatType:    msg.ChatType,
-			GroupId:     msg.GroupId,
-			Content:     msg.Content,
-			ContentType: msg.ContentType,
-			Status:      msg.Status,
-			CreatedAt:   msg.CreatedAt,
-			Seq:         msg.Seq,
-			AtUserIds:   msg.AtUserIds,
-		})
+		list = append(list, toMessageInfo(msg))
 	}
 
 	return &types.GetPrivateOfflineSyncResp{
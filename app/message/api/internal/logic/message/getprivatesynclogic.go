@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPrivateSyncLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 私聊增量同步（按会话seq拉取）
+func NewGetPrivateSyncLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivateSyncLogic {
+	return &GetPrivateSyncLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPrivateSyncLogic) GetPrivateSync(req *types.GetPrivateSyncReq) (resp *types.GetPrivateSyncResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.PeerId == 0 {
+		return &types.GetPrivateSyncResp{List: []types.MessageInfo{}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.GetPrivateMessagesBySeq(l.ctx, &message.GetPrivateMessagesBySeqReq{
+		UserId: userId,
+		PeerId: req.PeerId,
+		Seq:    req.Seq,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		l.Logger.Errorf("GetPrivateSync RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.MessageInfo, 0, len(rpcResp.List))
+	for _, msg := range rpcResp.List {
+		list = append(list, toMessageInfo(msg))
+	}
+
+	return &types.GetPrivateSyncResp{
+		List:        list,
+		SkippedSeqs: append([]uint64{}, rpcResp.SkippedSeqs...),
+		HasMore:     rpcResp.HasMore,
+	}, nil
+}
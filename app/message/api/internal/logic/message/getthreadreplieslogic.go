@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetThreadRepliesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取话题回复列表
+func NewGetThreadRepliesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetThreadRepliesLogic {
+	return &GetThreadRepliesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetThreadRepliesLogic) GetThreadReplies(req *types.GetThreadRepliesReq) (resp *types.GetThreadRepliesResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.GetThreadReplies(l.ctx, &message.GetThreadRepliesReq{
+		UserId:    userId,
+		RootMsgId: req.RootMsgId,
+		AfterSeq:  req.AfterSeq,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		l.Logger.Errorf("GetThreadReplies RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.ThreadReplyInfo, 0, len(rpcResp.List))
+	for _, reply := range rpcResp.List {
+		list = append(list, toThreadReplyInfo(reply))
+	}
+
+	return &types.GetThreadRepliesResp{
+		Root:        toMessageInfo(rpcResp.Root),
+		List:        list,
+		HasMore:     rpcResp.HasMore,
+		Thread:      toThreadSummary(rpcResp.Thread),
+		Following:   rpcResp.Following,
+		SkippedSeqs: rpcResp.SkippedSeqs,
+	}, nil
+}
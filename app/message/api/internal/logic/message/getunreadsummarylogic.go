@@ -0,0 +1,57 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUnreadSummaryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取所有会话的未读数汇总（私聊、群聊及@我）
+func NewGetUnreadSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUnreadSummaryLogic {
+	return &GetUnreadSummaryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUnreadSummaryLogic) GetUnreadSummary(req *types.Empty) (resp *types.GetUnreadSummaryResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.GetUnreadSummary(l.ctx, &message.GetUnreadSummaryReq{
+		UserId: userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("GetUnreadSummary RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.ConversationUnread, 0, len(rpcResp.List))
+	for _, c := range rpcResp.List {
+		list = append(list, toConversationUnread(c))
+	}
+
+	return &types.GetUnreadSummaryResp{
+		List:         list,
+		Total:        rpcResp.Total,
+		PrivateTotal: rpcResp.PrivateTotal,
+		GroupTotal:   rpcResp.GroupTotal,
+		AtMeTotal:    rpcResp.AtMeTotal,
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListExportJobsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取导出任务列表
+func NewListExportJobsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListExportJobsLogic {
+	return &ListExportJobsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListExportJobsLogic) ListExportJobs(req *types.ListExportJobsReq) (resp *types.ListExportJobsResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListExportJobs(l.ctx, &message.ListExportJobsReq{
+		UserId:   userId,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListExportJobs RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.ExportJobInfo, 0, len(rpcResp.List))
+	for _, info := range rpcResp.List {
+		list = append(list, toExportJobInfo(info))
+	}
+
+	return &types.ListExportJobsResp{List: list, Total: rpcResp.Total}, nil
+}
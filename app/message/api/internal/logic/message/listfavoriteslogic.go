@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFavoritesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取收藏列表（支持关键词、标签、消息类型筛选）
+func NewListFavoritesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFavoritesLogic {
+	return &ListFavoritesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFavoritesLogic) ListFavorites(req *types.ListFavoritesReq) (resp *types.ListFavoritesResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListFavorites(l.ctx, &message.ListFavoritesReq{
+		UserId:      userId,
+		Keyword:     req.Keyword,
+		Tag:         req.Tag,
+		ContentType: req.ContentType,
+		Cursor:      req.Cursor,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListFavorites RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.FavoriteInfo, 0, len(rpcResp.List))
+	for _, info := range rpcResp.List {
+		list = append(list, toFavoriteInfo(info))
+	}
+
+	return &types.ListFavoritesResp{
+		List:       list,
+		NextCursor: rpcResp.NextCursor,
+	}, nil
+}
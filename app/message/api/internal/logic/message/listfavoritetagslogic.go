@@ -0,0 +1,56 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFavoriteTagsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取收藏使用过的标签
+func NewListFavoriteTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFavoriteTagsLogic {
+	return &ListFavoriteTagsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFavoriteTagsLogic) ListFavoriteTags(req *types.Empty) (resp *types.ListFavoriteTagsResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListFavoriteTags(l.ctx, &message.ListFavoriteTagsReq{
+		UserId: userId,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListFavoriteTags RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.FavoriteTag, 0, len(rpcResp.List))
+	for _, tag := range rpcResp.List {
+		list = append(list, types.FavoriteTag{
+			Name:  tag.Name,
+			Count: tag.Count,
+		})
+	}
+
+	return &types.ListFavoriteTagsResp{
+		List: list,
+	}, nil
+}
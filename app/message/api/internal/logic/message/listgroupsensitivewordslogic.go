@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListGroupSensitiveWordsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取群自定义敏感词（需要内容审核权限）
+func NewListGroupSensitiveWordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListGroupSensitiveWordsLogic {
+	return &ListGroupSensitiveWordsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListGroupSensitiveWordsLogic) ListGroupSensitiveWords(req *types.ListGroupSensitiveWordsReq) (resp *types.GroupSensitiveWordsResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListGroupSensitiveWords(l.ctx, &message.ListGroupSensitiveWordsReq{
+		UserId:  userId,
+		GroupId: req.GroupId,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListGroupSensitiveWords RPC failed: %v", err)
+		return nil, err
+	}
+
+	return toGroupSensitiveWordsResp(rpcResp), nil
+}
@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListModerationReviewsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+func NewListModerationReviewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListModerationReviewsLogic {
+	return &ListModerationReviewsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListModerationReviewsLogic) ListModerationReviews(req *types.ListModerationReviewsReq) (resp *types.ListModerationReviewsResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListModerationReviews(l.ctx, &message.ListModerationReviewsReq{
+		UserId:  userId,
+		GroupId: req.GroupId,
+		Status:  req.Status,
+		LastId:  req.LastId,
+		Limit:   req.Limit,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListModerationReviews RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.ModerationReviewInfo, 0, len(rpcResp.List))
+	for _, review := range rpcResp.List {
+		list = append(list, toModerationReviewInfo(review))
+	}
+
+	return &types.ListModerationReviewsResp{
+		List:    list,
+		HasMore: rpcResp.HasMore,
+	}, nil
+}
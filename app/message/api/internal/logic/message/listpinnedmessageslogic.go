@@ -0,0 +1,56 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPinnedMessagesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取会话的置顶消息列表
+func NewListPinnedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPinnedMessagesLogic {
+	return &ListPinnedMessagesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPinnedMessagesLogic) ListPinnedMessages(req *types.ListPinnedMessagesReq) (resp *types.ListPinnedMessagesResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListPinnedMessages(l.ctx, &message.ListPinnedMessagesReq{
+		UserId:   userId,
+		ChatType: req.ChatType,
+		PeerId:   req.PeerId,
+		GroupId:  req.GroupId,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListPinnedMessages RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.PinnedMessageInfo, 0, len(rpcResp.List))
+	for _, info := range rpcResp.List {
+		list = append(list, toPinnedMessageInfo(info))
+	}
+
+	return &types.ListPinnedMessagesResp{
+		List: list,
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListScheduledMessagesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取定时消息列表
+func NewListScheduledMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListScheduledMessagesLogic {
+	return &ListScheduledMessagesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListScheduledMessagesLogic) ListScheduledMessages(req *types.ListScheduledMessagesReq) (resp *types.ListScheduledMessagesResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListScheduledMessages(l.ctx, &message.ListScheduledMessagesReq{
+		UserId:   userId,
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		l.Logger.Errorf("ListScheduledMessages RPC failed: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.ScheduledMessageInfo, 0, len(rpcResp.List))
+	for _, info := range rpcResp.List {
+		list = append(list, toScheduledMessageInfo(info))
+	}
+
+	return &types.ListScheduledMessagesResp{List: list, Total: rpcResp.Total}, nil
+}
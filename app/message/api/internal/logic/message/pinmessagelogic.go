@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PinMessageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 置顶消息（群聊需要置顶消息权限）
+func NewPinMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PinMessageLogic {
+	return &PinMessageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PinMessageLogic) PinMessage(req *types.PinMessageReq) (resp *types.PinnedMessageInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.PinMessage(l.ctx, &message.PinMessageReq{
+		UserId: userId,
+		MsgId:  req.MsgId,
+	})
+	if err != nil {
+		l.Logger.Errorf("PinMessage RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toPinnedMessageInfo(rpcResp.Info)
+	return &info, nil
+}
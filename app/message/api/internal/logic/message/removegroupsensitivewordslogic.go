@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveGroupSensitiveWordsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除群自定义敏感词（需要内容审核权限）
+func NewRemoveGroupSensitiveWordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveGroupSensitiveWordsLogic {
+	return &RemoveGroupSensitiveWordsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RemoveGroupSensitiveWordsLogic) RemoveGroupSensitiveWords(req *types.RemoveGroupSensitiveWordsReq) (resp *types.GroupSensitiveWordsResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.RemoveGroupSensitiveWords(l.ctx, &message.GroupSensitiveWordsReq{
+		UserId:  userId,
+		GroupId: req.GroupId,
+		Words:   req.Words,
+	})
+	if err != nil {
+		l.Logger.Errorf("RemoveGroupSensitiveWords RPC failed: %v", err)
+		return nil, err
+	}
+
+	return toGroupSensitiveWordsResp(rpcResp), nil
+}
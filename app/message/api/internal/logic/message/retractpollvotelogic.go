@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RetractPollVoteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 撤回自己的投票
+func NewRetractPollVoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RetractPollVoteLogic {
+	return &RetractPollVoteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RetractPollVoteLogic) RetractPollVote(req *types.RetractPollVoteReq) (resp *types.PollInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.RetractPollVote(l.ctx, &message.RetractPollVoteReq{
+		UserId: userId,
+		MsgId:  req.MsgId,
+	})
+	if err != nil {
+		l.Logger.Errorf("RetractPollVote RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toPollInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewModerationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 处理送审消息：通过或删除消息
+func NewReviewModerationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewModerationLogic {
+	return &ReviewModerationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReviewModerationLogic) ReviewModeration(req *types.ReviewModerationReq) (resp *types.ModerationReviewInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.ReviewModeration(l.ctx, &message.ReviewModerationReq{
+		UserId: userId,
+		Id:     req.Id,
+		Remove: req.Remove,
+	})
+	if err != nil {
+		l.Logger.Errorf("ReviewModeration RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toModerationReviewInfo(rpcResp.Review)
+	return &info, nil
+}
@@ -5,7 +5,6 @@ package message
 
 import (
 	"context"
-	"encoding/json"
 
 	"SkyeIM/app/message/api/internal/svc"
 	"SkyeIM/app/message/api/internal/types"
@@ -20,7 +19,7 @@ type SearchMessageLogic struct {
 	svcCtx *svc.ServiceContext
 }
 
-// 模糊搜索聊天记录
+// 搜索聊天记录
 func NewSearchMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchMessageLogic {
 	return &SearchMessageLogic{
 		Logger: logx.WithContext(ctx),
@@ -30,35 +29,39 @@ func NewSearchMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Sea
 }
 
 func (l *SearchMessageLogic) SearchMessage(req *types.SearchMessageReq) (resp *types.SearchMessageResp, err error) {
-	userId, _ := l.ctx.Value("userId").(json.Number).Int64()
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	rpcRes, err := l.svcCtx.MessageRpc.SearchMessage(l.ctx, &messageclient.SearchMessageReq{
-		UserId:  userId,
-		Keyword: req.Keyword,
+		UserId:      userId,
+		Keyword:     req.Keyword,
+		PeerId:      req.PeerId,
+		GroupId:     req.GroupId,
+		SenderId:    req.SenderId,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		ContentType: req.ContentType,
+		Cursor:      req.Cursor,
+		Limit:       req.Limit,
 	})
 	if err != nil {
 		l.Logger.Errorf("API 搜索消息失败: %v", err)
 		return nil, err
 	}
 
-	var list []types.MessageInfo
-	for _, v := range rpcRes.List {
-		list = append(list, types.MessageInfo{
-			Id:          v.Id,
-			MsgId:       v.MsgId,
-			FromUserId:  v.FromUserId,
-			ToUserId:    v.ToUserId,
-			ChatType:    v.ChatType,
-			GroupId:     v.GroupId,
-			Content:     v.Content,
-			ContentType: v.ContentType,
-			Status:      v.Status,
-			CreatedAt:   v.CreatedAt,
-			Seq:         v.Seq,
+	list := make([]types.SearchMessageItem, 0, len(rpcRes.Hits))
+	for _, hit := range rpcRes.Hits {
+		list = append(list, types.SearchMessageItem{
+			MessageInfo: toMessageInfo(hit.Message),
+			Snippet:     hit.Snippet,
 		})
 	}
 
 	return &types.SearchMessageResp{
-		List: list,
+		List:       list,
+		NextCursor: rpcRes.NextCursor,
+		HasMore:    rpcRes.HasMore,
 	}, nil
 }
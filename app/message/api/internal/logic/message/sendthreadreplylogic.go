@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendThreadReplyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 发送群话题回复（不进入群聊主时间线）
+func NewSendThreadReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendThreadReplyLogic {
+	return &SendThreadReplyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SendThreadReplyLogic) SendThreadReply(req *types.SendThreadReplyReq) (resp *types.SendThreadReplyResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.SendThreadReply(l.ctx, &message.SendThreadReplyReq{
+		MsgId:       req.MsgId,
+		FromUserId:  userId,
+		RootMsgId:   req.RootMsgId,
+		Content:     req.Content,
+		ContentType: req.ContentType,
+	})
+	if err != nil {
+		l.Logger.Errorf("SendThreadReply RPC failed: %v", err)
+		return nil, err
+	}
+
+	return &types.SendThreadReplyResp{
+		Id:        rpcResp.Id,
+		MsgId:     rpcResp.MsgId,
+		Seq:       rpcResp.Seq,
+		CreatedAt: rpcResp.CreatedAt,
+		Duplicate: rpcResp.Duplicate,
+		Thread:    toThreadSummary(rpcResp.Thread),
+		Content:   rpcResp.Content,
+	}, nil
+}
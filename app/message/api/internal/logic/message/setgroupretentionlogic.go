@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetGroupRetentionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 设置群消息保留策略（需要设置消息保留期限权限，默认仅群主）
+func NewSetGroupRetentionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetGroupRetentionLogic {
+	return &SetGroupRetentionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetGroupRetentionLogic) SetGroupRetention(req *types.SetGroupRetentionReq) (resp *types.GroupRetentionInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.SetGroupRetention(l.ctx, &message.SetGroupRetentionReq{
+		GroupId:       req.GroupId,
+		OperatorId:    userId,
+		RetentionDays: req.RetentionDays,
+		UseDefault:    req.UseDefault,
+	})
+	if err != nil {
+		l.Logger.Errorf("SetGroupRetention RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toGroupRetentionInfo(rpcResp.Info)
+	return &info, nil
+}
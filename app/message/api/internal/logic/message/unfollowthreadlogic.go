@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnfollowThreadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消关注话题
+func NewUnfollowThreadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnfollowThreadLogic {
+	return &UnfollowThreadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnfollowThreadLogic) UnfollowThread(req *types.FollowThreadReq) (resp *types.FollowThreadResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.UnfollowThread(l.ctx, &message.FollowThreadReq{
+		UserId:    userId,
+		RootMsgId: req.RootMsgId,
+	})
+	if err != nil {
+		l.Logger.Errorf("UnfollowThread RPC failed: %v", err)
+		return nil, err
+	}
+
+	return &types.FollowThreadResp{
+		Following: rpcResp.Following,
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnpinMessageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消置顶消息（群聊需要置顶消息权限）
+func NewUnpinMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnpinMessageLogic {
+	return &UnpinMessageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnpinMessageLogic) UnpinMessage(req *types.UnpinMessageReq) (resp *types.UnpinMessageResp, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.UnpinMessage(l.ctx, &message.UnpinMessageReq{
+		UserId:   userId,
+		ChatType: req.ChatType,
+		PeerId:   req.PeerId,
+		GroupId:  req.GroupId,
+		MsgId:    req.MsgId,
+	})
+	if err != nil {
+		l.Logger.Errorf("UnpinMessage RPC failed: %v", err)
+		return nil, err
+	}
+
+	return &types.UnpinMessageResp{
+		Success: rpcResp.Success,
+	}, nil
+}
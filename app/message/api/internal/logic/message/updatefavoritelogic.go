@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateFavoriteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改收藏的标签和备注
+func NewUpdateFavoriteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateFavoriteLogic {
+	return &UpdateFavoriteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateFavoriteLogic) UpdateFavorite(req *types.UpdateFavoriteReq) (resp *types.FavoriteInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.UpdateFavorite(l.ctx, &message.UpdateFavoriteReq{
+		UserId: userId,
+		Id:     req.Id,
+		Tags:   req.Tags,
+		Note:   req.Note,
+	})
+	if err != nil {
+		l.Logger.Errorf("UpdateFavorite RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toFavoriteInfo(rpcResp.Info)
+	return &info, nil
+}
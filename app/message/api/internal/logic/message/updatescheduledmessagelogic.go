@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateScheduledMessageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改待发送的定时消息
+func NewUpdateScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateScheduledMessageLogic {
+	return &UpdateScheduledMessageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateScheduledMessageLogic) UpdateScheduledMessage(req *types.UpdateScheduledMessageReq) (resp *types.ScheduledMessageInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.UpdateScheduledMessage(l.ctx, &message.UpdateScheduledMessageReq{
+		Id:          req.Id,
+		UserId:      userId,
+		Content:     req.Content,
+		ContentType: req.ContentType,
+		AtUserIds:   req.AtUserIds,
+		SendAt:      req.SendAt,
+	})
+	if err != nil {
+		l.Logger.Errorf("UpdateScheduledMessage RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toScheduledMessageInfo(rpcResp.Info)
+	return &info, nil
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+
+	"SkyeIM/app/message/api/internal/svc"
+	"SkyeIM/app/message/api/internal/types"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VotePollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 投票（已投过票时覆盖原选择）
+func NewVotePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VotePollLogic {
+	return &VotePollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VotePollLogic) VotePoll(req *types.VotePollReq) (resp *types.PollInfo, err error) {
+	userId, err := getUserIdFromCtx(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := l.svcCtx.MessageRpc.VotePoll(l.ctx, &message.VotePollReq{
+		UserId:        userId,
+		MsgId:         req.MsgId,
+		OptionIndexes: req.OptionIndexes,
+	})
+	if err != nil {
+		l.Logger.Errorf("VotePoll RPC failed: %v", err)
+		return nil, err
+	}
+
+	info := toPollInfo(rpcResp.Info)
+	return &info, nil
+}
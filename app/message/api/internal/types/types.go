@@ -3,15 +3,150 @@
 
 package types
 
+type AddFavoriteReq struct {
+	MsgId string   `json:"msgId"`         // 要收藏的消息唯一标识
+	Tags  []string `json:"tags,optional"` // 标签（最多10个，每个不超过20字符）
+	Note  string   `json:"note,optional"` // 备注（不超过200字符）
+}
+
+type AddGroupSensitiveWordsReq struct {
+	GroupId string   `json:"groupId"`
+	Words   []string `json:"words"`           // 单次最多100个，每个最多32个字符
+	Action  int32    `json:"action,optional"` // 默认 1-替换为*
+}
+
+type CancelScheduledMessageReq struct {
+	Id int64 `json:"id"`
+}
+
+type ContactPayload struct {
+	UserId   int64  `json:"userId"`
+	Nickname string `json:"nickname,optional"`
+	Avatar   string `json:"avatar,optional"`
+}
+
 type ConversationInfo struct {
 	PeerId      int64       `json:"peerId"`      // 对方用户ID
 	LastMessage MessageInfo `json:"lastMessage"` // 最后一条消息
 	UnreadCount int64       `json:"unreadCount"` // 未读消息数
 }
 
+type ConversationUnread struct {
+	ChatType int32  `json:"chatType"`          // 1-私聊 2-群聊
+	PeerId   int64  `json:"peerId,omitempty"`  // 私聊对方ID
+	GroupId  string `json:"groupId,omitempty"` // 群组ID
+	Unread   int64  `json:"unread"`            // 未读消息数
+	AtMe     int64  `json:"atMe"`              // @我（含@全体）的未读消息数，仅群聊
+}
+
+type CreateExportJobReq struct {
+	ChatType  int32  `json:"chatType"`         // 1-私聊 2-群聊
+	PeerId    int64  `json:"peerId,optional"`  // 私聊对方ID
+	GroupId   string `json:"groupId,optional"` // 群组ID
+	OwnerId   int64  `json:"ownerId,optional"` // 会话所属用户ID（仅审计人员导出他人私聊时使用）
+	Format    string `json:"format,options=json|html|text"`
+	StartTime int64  `json:"startTime,optional"` // 起始时间戳（秒，包含）
+	EndTime   int64  `json:"endTime,optional"`   // 结束时间戳（秒，不包含）
+}
+
+type CreatePollReq struct {
+	GroupId   string   `json:"groupId"`
+	MsgId     string   `json:"msgId"`              // 投票消息唯一标识（客户端生成，重试时复用）
+	Question  string   `json:"question"`           // 投票主题（不超过100字符）
+	Options   []string `json:"options"`            // 选项（2-10个，每个不超过50字符）
+	Multiple  bool     `json:"multiple,optional"`  // 是否多选
+	Anonymous bool     `json:"anonymous,optional"` // 是否匿名
+	Deadline  int64    `json:"deadline,optional"`  // 截止时间戳，不传表示不限（最长30天）
+}
+
+type CreatePollResp struct {
+	Id        int64    `json:"id"` // 投票消息ID
+	MsgId     string   `json:"msgId"`
+	CreatedAt int64    `json:"createdAt"`
+	Seq       uint64   `json:"seq"`
+	Duplicate bool     `json:"duplicate"` // 是否为重复请求
+	Poll      PollInfo `json:"poll"`
+}
+
+type CreateScheduledMessageReq struct {
+	ChatType    int32   `json:"chatType"`          // 1-私聊 2-群聊
+	ToUserId    int64   `json:"toUserId,optional"` // 私聊接收者ID
+	GroupId     string  `json:"groupId,optional"`  // 群聊群组ID
+	Content     string  `json:"content"`
+	ContentType int32   `json:"contentType,default=1"`
+	AtUserIds   []int64 `json:"atUserIds,optional"` // 被@的用户ID列表（群聊）
+	SendAt      int64   `json:"sendAt"`             // 计划发送时间戳（秒）
+}
+
+type DeleteFavoriteReq struct {
+	Ids []int64 `json:"ids"` // 收藏ID列表（最多100个）
+}
+
+type DeleteFavoriteResp struct {
+	Deleted int64 `json:"deleted"` // 实际删除条数
+}
+
 type Empty struct {
 }
 
+type ExportJobInfo struct {
+	Id           int64  `json:"id"`
+	OwnerId      int64  `json:"ownerId"`  // 会话所属用户ID（私聊）
+	ChatType     int32  `json:"chatType"` // 1-私聊 2-群聊
+	PeerId       int64  `json:"peerId"`
+	GroupId      string `json:"groupId"`
+	Format       string `json:"format"`    // json/html/text
+	StartTime    int64  `json:"startTime"` // 0表示不限
+	EndTime      int64  `json:"endTime"`   // 0表示不限
+	Status       int32  `json:"status"`    // 0-排队中 1-导出中 2-已完成 3-失败
+	FileSize     int64  `json:"fileSize"`
+	MessageCount int64  `json:"messageCount"`
+	FailReason   string `json:"failReason"`
+	DownloadUrl  string `json:"downloadUrl"` // 已完成时返回，有时效
+	UrlExpireAt  int64  `json:"urlExpireAt"`
+	CreatedAt    int64  `json:"createdAt"`
+	UpdatedAt    int64  `json:"updatedAt"`
+}
+
+type FavoriteInfo struct {
+	Id           int64           `json:"id"`                // 收藏ID
+	MsgId        string          `json:"msgId"`             // 来源消息唯一标识
+	MessageId    int64           `json:"messageId"`         // 来源消息ID（跳转回原会话时作为 anchorMsgId）
+	ChatType     int32           `json:"chatType"`          // 来源会话类型: 1-私聊 2-群聊
+	PeerId       int64           `json:"peerId,omitempty"`  // 私聊对方ID
+	GroupId      string          `json:"groupId,omitempty"` // 群组ID
+	Seq          uint64          `json:"seq"`               // 来源消息Seq
+	FromUserId   int64           `json:"fromUserId"`        // 原消息发送者ID
+	ContentType  int32           `json:"contentType"`       // 消息类型
+	Content      string          `json:"content"`           // 消息内容快照
+	Payload      *MessagePayload `json:"payload,optional"`  // 解析后的结构化内容（文本消息为空）
+	Tags         []string        `json:"tags"`              // 标签
+	Note         string          `json:"note"`              // 备注
+	MsgCreatedAt int64           `json:"msgCreatedAt"`      // 原消息发送时间
+	CreatedAt    int64           `json:"createdAt"`         // 收藏时间
+	UpdatedAt    int64           `json:"updatedAt"`
+}
+
+type FavoriteTag struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"` // 使用该标签的收藏数
+}
+
+type FilePayload struct {
+	Url  string `json:"url"`
+	Name string `json:"name"`
+	Size int64  `json:"size"` // 字节
+	Mime string `json:"mime,optional"`
+}
+
+type FollowThreadReq struct {
+	RootMsgId string `json:"rootMsgId"`
+}
+
+type FollowThreadResp struct {
+	Following bool `json:"following"` // 操作后是否关注
+}
+
 type GetAtMeMessagesReq struct {
 	GroupId   string `form:"groupId,optional"`   // 群组ID（可选，为空则查询所有群）
 	LastMsgId int64  `form:"lastMsgId,optional"` // 最后一条消息ID（用于分页）
@@ -27,15 +162,28 @@ type GetConversationsResp struct {
 	List []ConversationInfo `json:"list"`
 }
 
+type GetExportJobReq struct {
+	Id int64 `form:"id"`
+}
+
 type GetGroupMessageHistoryReq struct {
-	GroupId   string `form:"groupId"`            // 群组ID
-	LastMsgId int64  `form:"lastMsgId,optional"` // 最后一条消息ID（用于分页）
-	Limit     int32  `form:"limit,default=20"`   // 获取条数
+	GroupId     string `form:"groupId"`                          // 群组ID
+	LastMsgId   int64  `form:"lastMsgId,optional"`               // 最后一条消息ID（用于分页，不包含该消息）
+	Limit       int32  `form:"limit,default=20"`                 // 获取条数
+	Direction   int32  `form:"direction,optional,options=0|1|2"` // 读取方向: 0-向前（更早） 1-向后（更新） 2-定位点前后各取一半
+	AnchorMsgId int64  `form:"anchorMsgId,optional"`             // 定位消息ID（结果包含该消息）
+	AnchorSeq   uint64 `form:"anchorSeq,optional"`               // 定位Seq（结果包含该消息）
+	AnchorTime  int64  `form:"anchorTime,optional"`              // 定位时间戳（秒），跳转到指定日期
 }
 
 type GetGroupMessageHistoryResp struct {
-	List    []MessageInfo `json:"list"`
-	HasMore bool          `json:"hasMore"`
+	List         []MessageInfo `json:"list"`         // 按时间倒序
+	HasMore      bool          `json:"hasMore"`      // 是否还有更早的消息
+	HasMoreAfter bool          `json:"hasMoreAfter"` // 是否还有更新的消息
+}
+
+type GetGroupRetentionReq struct {
+	GroupId string `form:"groupId"`
 }
 
 type GetGroupSyncReq struct {
@@ -45,18 +193,28 @@ type GetGroupSyncReq struct {
 }
 
 type GetGroupSyncResp struct {
-	List []MessageInfo `json:"list"`
+	List        []MessageInfo `json:"list"`
+	SkippedSeqs []uint64      `json:"skippedSeqs"` // 区间内因写入失败而跳过的Seq，客户端补洞时忽略
+	HasMore     bool          `json:"hasMore"`     // 是否还有更多（以本次最后一条消息的Seq继续同步）
 }
 
 type GetMessageHistoryReq struct {
-	PeerId    int64 `form:"peerId"`             // 对方用户ID
-	LastMsgId int64 `form:"lastMsgId,optional"` // 最后一条消息ID（用于分页）
-	Limit     int32 `form:"limit,default=20"`   // 获取条数
+	PeerId      int64 `form:"peerId"`                           // 对方用户ID
+	LastMsgId   int64 `form:"lastMsgId,optional"`               // 最后一条消息ID（用于分页，不包含该消息）
+	Limit       int32 `form:"limit,default=20"`                 // 获取条数
+	Direction   int32 `form:"direction,optional,options=0|1|2"` // 读取方向: 0-向前（更早） 1-向后（更新） 2-定位点前后各取一半
+	AnchorMsgId int64 `form:"anchorMsgId,optional"`             // 定位消息ID（结果包含该消息）
+	AnchorTime  int64 `form:"anchorTime,optional"`              // 定位时间戳（秒），跳转到指定日期
 }
 
 type GetMessageHistoryResp struct {
-	List    []MessageInfo `json:"list"`
-	HasMore bool          `json:"hasMore"`
+	List         []MessageInfo `json:"list"`         // 按时间倒序
+	HasMore      bool          `json:"hasMore"`      // 是否还有更早的消息
+	HasMoreAfter bool          `json:"hasMoreAfter"` // 是否还有更新的消息
+}
+
+type GetPollReq struct {
+	MsgId string `form:"msgId"`
 }
 
 type GetPrivateOfflineSyncReq struct {
@@ -70,6 +228,33 @@ type GetPrivateOfflineSyncResp struct {
 	Total   int64         `json:"total"`
 }
 
+type GetPrivateSyncReq struct {
+	PeerId int64  `form:"peerId"`            // 对方用户ID
+	Seq    uint64 `form:"seq,default=0"`     // 起始Seq（不包含）
+	Limit  int32  `form:"limit,default=200"` // 获取条数（后端会限制上限）
+}
+
+type GetPrivateSyncResp struct {
+	List        []MessageInfo `json:"list"`
+	SkippedSeqs []uint64      `json:"skippedSeqs"` // 区间内因写入失败而跳过的Seq，客户端补洞时忽略
+	HasMore     bool          `json:"hasMore"`     // 是否还有更多（以本次最后一条消息的Seq继续同步）
+}
+
+type GetThreadRepliesReq struct {
+	RootMsgId string `form:"rootMsgId"`
+	AfterSeq  uint64 `form:"afterSeq,optional"` // 只返回话题内Seq大于该值的回复，不传表示从头读取
+	Limit     int32  `form:"limit,default=20"`  // 最多100
+}
+
+type GetThreadRepliesResp struct {
+	Root        MessageInfo       `json:"root"` // 话题根消息
+	List        []ThreadReplyInfo `json:"list"` // 按话题内Seq升序
+	HasMore     bool              `json:"hasMore"`
+	Thread      ThreadSummary     `json:"thread"`
+	Following   bool              `json:"following"`   // 当前用户是否关注该话题
+	SkippedSeqs []uint64          `json:"skippedSeqs"` // 区间内因写入失败而跳过的话题内Seq
+}
+
 type GetUnreadCountReq struct {
 	PeerId int64 `form:"peerId,optional"` // 对方用户ID，为空/0则获取全部未读
 }
@@ -78,6 +263,114 @@ type GetUnreadCountResp struct {
 	Count int64 `json:"count"`
 }
 
+type GetUnreadSummaryResp struct {
+	List         []ConversationUnread `json:"list"`         // 有未读消息的会话
+	Total        int64                `json:"total"`        // 未读消息总数
+	PrivateTotal int64                `json:"privateTotal"` // 私聊未读总数
+	GroupTotal   int64                `json:"groupTotal"`   // 群聊未读总数
+	AtMeTotal    int64                `json:"atMeTotal"`    // 群聊@我的未读总数
+}
+
+type GroupRetentionInfo struct {
+	GroupId          string `json:"groupId"`
+	RetentionDays    int32  `json:"retentionDays"`    // 生效的保留天数，0表示永久保留
+	IsDefault        bool   `json:"isDefault"`        // 是否使用全局默认策略
+	DefaultDays      int32  `json:"defaultDays"`      // 全局默认保留天数，0表示永久保留
+	ArchiveAfterDays int32  `json:"archiveAfterDays"` // 超过该天数的消息移入归档存储，0表示不归档
+	UpdatedBy        int64  `json:"updatedBy"`
+	UpdatedAt        int64  `json:"updatedAt"`
+}
+
+type GroupSensitiveWordsResp struct {
+	List []SensitiveWordInfo `json:"list"` // 群的全部自定义敏感词
+}
+
+type ImagePayload struct {
+	Url          string `json:"url"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	ThumbnailUrl string `json:"thumbnailUrl,optional"`
+}
+
+type LinkPreview struct {
+	Url         string `json:"url"`         // 消息中的链接
+	Title       string `json:"title"`       // 标题
+	Description string `json:"description"` // 摘要
+	Image       string `json:"image"`       // 预览图地址
+	SiteName    string `json:"siteName"`    // 站点名称
+}
+
+type ListExportJobsReq struct {
+	Page     int64 `form:"page,default=1"`
+	PageSize int64 `form:"pageSize,default=20"`
+}
+
+type ListExportJobsResp struct {
+	List  []ExportJobInfo `json:"list"`
+	Total int64           `json:"total"`
+}
+
+type ListFavoriteTagsResp struct {
+	List []FavoriteTag `json:"list"` // 按使用次数倒序
+}
+
+type ListFavoritesReq struct {
+	Keyword     string `form:"keyword,optional"` // 匹配内容（含文件名）或备注
+	Tag         string `form:"tag,optional"`
+	ContentType int32  `form:"contentType,optional"` // 消息类型
+	Cursor      int64  `form:"cursor,optional"`      // 上一页返回的 nextCursor，第一页不传
+	Limit       int64  `form:"limit,default=20"`     // 每页条数，最大50
+}
+
+type ListFavoritesResp struct {
+	List       []FavoriteInfo `json:"list"`       // 按收藏时间倒序
+	NextCursor int64          `json:"nextCursor"` // 下一页游标，0表示没有更多
+}
+
+type ListGroupSensitiveWordsReq struct {
+	GroupId string `form:"groupId"`
+}
+
+type ListModerationReviewsReq struct {
+	GroupId string `form:"groupId,optional"` // 为空时查询全部会话（仅平台审核人员）
+	Status  int32  `form:"status,default=0"` // 0-待审核 1-已通过 2-已删除
+	LastId  int64  `form:"lastId,optional"`  // 分页游标（上一页最后一条的ID）
+	Limit   int32  `form:"limit,default=20"` // 最多100
+}
+
+type ListModerationReviewsResp struct {
+	List    []ModerationReviewInfo `json:"list"` // 按ID倒序
+	HasMore bool                   `json:"hasMore"`
+}
+
+type ListPinnedMessagesReq struct {
+	ChatType int32  `form:"chatType"`         // 1-私聊 2-群聊
+	PeerId   int64  `form:"peerId,optional"`  // 私聊对方ID
+	GroupId  string `form:"groupId,optional"` // 群组ID
+}
+
+type ListPinnedMessagesResp struct {
+	List []PinnedMessageInfo `json:"list"` // 按置顶时间倒序
+}
+
+type ListScheduledMessagesReq struct {
+	Status   int32 `form:"status,default=0"` // 状态筛选，默认待发送，-1表示全部
+	Page     int64 `form:"page,default=1"`
+	PageSize int64 `form:"pageSize,default=20"`
+}
+
+type ListScheduledMessagesResp struct {
+	List  []ScheduledMessageInfo `json:"list"`
+	Total int64                  `json:"total"`
+}
+
+type LocationPayload struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name,optional"`
+	Address   string  `json:"address,optional"`
+}
+
 type MarkAsReadReq struct {
 	PeerId int64    `json:"peerId"`          // 对方用户ID
 	MsgIds []string `json:"msgIds,optional"` // 消息ID列表，为空则标记全部
@@ -97,24 +390,256 @@ type MarkGroupReadResp struct {
 }
 
 type MessageInfo struct {
+	Id          int64           `json:"id"`
+	MsgId       string          `json:"msgId"`
+	FromUserId  int64           `json:"fromUserId"`
+	ToUserId    int64           `json:"toUserId"`
+	ChatType    int32           `json:"chatType,optional"` // 1-私聊 2-群聊
+	GroupId     string          `json:"groupId,optional"`  // 群聊时使用
+	Content     string          `json:"content"`           // 非文本消息为对应类型的JSON
+	ContentType int32           `json:"contentType"`       // 1-文本 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片 8-群投票
+	Status      int32           `json:"status"`            // 0-未读 1-已读 2-撤回 4-已销毁（阅后即焚）
+	CreatedAt   int64           `json:"createdAt"`
+	Seq         uint64          `json:"seq,optional"`         // 群聊为群内Seq，私聊为会话内Seq（用于离线同步/已读进度）
+	AtUserIds   []int64         `json:"atUserIds,optional"`   // 被@的用户ID列表
+	Payload     *MessagePayload `json:"payload,optional"`     // 解析后的结构化内容（文本消息为空）
+	ExpireTtl   int32           `json:"expireTtl,optional"`   // 阅后即焚时长(秒)，0表示不过期
+	ExpireMode  int32           `json:"expireMode,optional"`  // 1-发送后计时 2-阅读后计时
+	ExpireAt    int64           `json:"expireAt,optional"`    // 过期时间戳（阅读后计时的消息未读时为0）
+	Thread      *ThreadSummary  `json:"thread,optional"`      // 以该消息为根的话题汇总（群聊且有回复时返回）
+	LinkPreview *LinkPreview    `json:"linkPreview,optional"` // 消息中第一个链接的预览（抓取完成后返回）
+}
+
+type MessagePayload struct {
+	Image    *ImagePayload    `json:"image,optional"`
+	File     *FilePayload     `json:"file,optional"`
+	Voice    *VoicePayload    `json:"voice,optional"`
+	Video    *VideoPayload    `json:"video,optional"`
+	Location *LocationPayload `json:"location,optional"`
+	Contact  *ContactPayload  `json:"contact,optional"`
+	System   *SystemPayload   `json:"system,optional"`
+	Poll     *PollPayload     `json:"poll,optional"`
+}
+
+type ModerationReviewInfo struct {
+	Id          int64           `json:"id"`
+	MsgId       string          `json:"msgId"`
+	RootMsgId   string          `json:"rootMsgId"` // 话题根消息唯一标识（话题回复时有效）
+	ChatType    int32           `json:"chatType"`  // 1-私聊 2-群聊
+	FromUserId  int64           `json:"fromUserId"`
+	ToUserId    int64           `json:"toUserId"` // 私聊时有效
+	GroupId     string          `json:"groupId"`  // 群聊时有效
+	Content     string          `json:"content"`
+	ContentType int32           `json:"contentType"`
+	Payload     *MessagePayload `json:"payload,optional"`
+	HitWords    []string        `json:"hitWords"` // 命中的敏感词
+	Status      int32           `json:"status"`   // 0-待审核 1-已通过 2-已删除
+	ReviewerId  int64           `json:"reviewerId"`
+	ReviewedAt  int64           `json:"reviewedAt"`
+	CreatedAt   int64           `json:"createdAt"` // 送审时间
+}
+
+type PinMessageReq struct {
+	MsgId string `json:"msgId"` // 要置顶的消息唯一标识
+}
+
+type PinnedMessageInfo struct {
+	Id           int64           `json:"id"`                // 置顶ID
+	ChatType     int32           `json:"chatType"`          // 会话类型: 1-私聊 2-群聊
+	GroupId      string          `json:"groupId,omitempty"` // 群组ID
+	MsgId        string          `json:"msgId"`             // 来源消息唯一标识
+	MessageId    int64           `json:"messageId"`         // 来源消息ID（跳转时作为 anchorMsgId）
+	Seq          uint64          `json:"seq"`               // 来源消息Seq
+	FromUserId   int64           `json:"fromUserId"`        // 原消息发送者ID
+	ContentType  int32           `json:"contentType"`       // 消息类型
+	Content      string          `json:"content"`           // 消息内容快照
+	Payload      *MessagePayload `json:"payload,optional"`  // 解析后的结构化内容（文本消息为空）
+	MsgCreatedAt int64           `json:"msgCreatedAt"`      // 原消息发送时间
+	PinnedBy     int64           `json:"pinnedBy"`          // 置顶操作者ID
+	PinnedAt     int64           `json:"pinnedAt"`          // 置顶时间
+}
+
+type PollInfo struct {
+	MsgId      string       `json:"msgId"` // 投票消息唯一标识
+	GroupId    string       `json:"groupId"`
+	CreatorId  int64        `json:"creatorId"` // 发起人ID
+	Question   string       `json:"question"`
+	Options    []PollOption `json:"options"`
+	Multiple   bool         `json:"multiple"`
+	Anonymous  bool         `json:"anonymous"`
+	Deadline   int64        `json:"deadline"`   // 截止时间戳，0 表示不限
+	Closed     bool         `json:"closed"`     // 是否已结束
+	ClosedAt   int64        `json:"closedAt"`   // 结束时间戳
+	VoterCount int64        `json:"voterCount"` // 投票人数
+	MyVotes    []int32      `json:"myVotes"`    // 当前用户选择的选项序号
+	CreatedAt  int64        `json:"createdAt"`
+}
+
+type PollOption struct {
+	Index    int32   `json:"index"` // 选项序号（从 0 开始）
+	Text     string  `json:"text"`
+	Count    int64   `json:"count"`    // 得票数
+	VoterIds []int64 `json:"voterIds"` // 投票人ID（匿名投票为空）
+}
+
+type PollPayload struct {
+	Question  string   `json:"question"`  // 投票主题
+	Options   []string `json:"options"`   // 选项
+	Multiple  bool     `json:"multiple"`  // 是否多选
+	Anonymous bool     `json:"anonymous"` // 是否匿名
+	Deadline  int64    `json:"deadline"`  // 截止时间戳，0 表示不限
+}
+
+type RemoveGroupSensitiveWordsReq struct {
+	GroupId string   `json:"groupId"`
+	Words   []string `json:"words"`
+}
+
+type RetractPollVoteReq struct {
+	MsgId string `json:"msgId"`
+}
+
+type ReviewModerationReq struct {
+	Id     int64 `json:"id"`
+	Remove bool  `json:"remove,optional"` // true-删除消息 false-通过
+}
+
+type ScheduledMessageInfo struct {
 	Id          int64   `json:"id"`
-	MsgId       string  `json:"msgId"`
+	MsgId       string  `json:"msgId"` // 发送后对应的消息唯一标识
 	FromUserId  int64   `json:"fromUserId"`
 	ToUserId    int64   `json:"toUserId"`
-	ChatType    int32   `json:"chatType,optional"` // 1-私聊 2-群聊
-	GroupId     string  `json:"groupId,optional"`  // 群聊时使用
+	ChatType    int32   `json:"chatType"` // 1-私聊 2-群聊
+	GroupId     string  `json:"groupId"`
 	Content     string  `json:"content"`
-	ContentType int32   `json:"contentType"` // 1-文本 2-图片 3-文件 4-语音
-	Status      int32   `json:"status"`      // 0-未读 1-已读 2-撤回
+	ContentType int32   `json:"contentType"`
+	AtUserIds   []int64 `json:"atUserIds"`
+	SendAt      int64   `json:"sendAt"`    // 计划发送时间戳（秒）
+	Status      int32   `json:"status"`    // 0-待发送 1-已发送 2-已取消 3-发送失败 4-发送中
+	MessageId   int64   `json:"messageId"` // 发送成功后对应的消息ID
+	FailReason  string  `json:"failReason"`
 	CreatedAt   int64   `json:"createdAt"`
-	Seq         uint64  `json:"seq,optional"`       // 群聊Seq（用于离线同步/已读进度）
-	AtUserIds   []int64 `json:"atUserIds,optional"` // 被@的用户ID列表
+}
+
+type SearchMessageItem struct {
+	MessageInfo
+	Snippet string `json:"snippet"` // 命中词用<em></em>包裹，其余字符已做HTML转义
 }
 
 type SearchMessageReq struct {
-	Keyword string `form:"keyword"`
+	Keyword     string `form:"keyword"`              // 搜索关键词，空格分隔的多个词需全部命中
+	PeerId      int64  `form:"peerId,optional"`      // 只搜索与该用户的私聊
+	GroupId     string `form:"groupId,optional"`     // 只搜索该群
+	SenderId    int64  `form:"senderId,optional"`    // 发送者过滤
+	StartTime   int64  `form:"startTime,optional"`   // 起始时间戳（秒，包含）
+	EndTime     int64  `form:"endTime,optional"`     // 结束时间戳（秒，不包含）
+	ContentType int32  `form:"contentType,optional"` // 1-文本（默认） 3-文件（按文件名）
+	Cursor      int64  `form:"cursor,optional"`      // 上一页返回的 nextCursor
+	Limit       int64  `form:"limit,default=20"`
 }
 
 type SearchMessageResp struct {
-	List []MessageInfo `json:"list"`
+	List       []SearchMessageItem `json:"list"`
+	NextCursor int64               `json:"nextCursor"`
+	HasMore    bool                `json:"hasMore"`
+}
+
+type SendThreadReplyReq struct {
+	MsgId       string `json:"msgId"`     // 回复唯一标识（客户端生成，重试时复用）
+	RootMsgId   string `json:"rootMsgId"` // 话题根消息唯一标识（任意群消息）
+	Content     string `json:"content"`
+	ContentType int32  `json:"contentType,optional"` // 默认 1-文本，不支持群投票
+}
+
+type SendThreadReplyResp struct {
+	Id        int64         `json:"id"`
+	MsgId     string        `json:"msgId"`
+	Seq       uint64        `json:"seq"` // 话题内Seq
+	CreatedAt int64         `json:"createdAt"`
+	Duplicate bool          `json:"duplicate"` // 是否为重复请求
+	Thread    ThreadSummary `json:"thread"`    // 最新的话题汇总
+	Content   string        `json:"content"`   // 实际保存的内容（敏感词被替换时与请求不同）
+}
+
+type SensitiveWordInfo struct {
+	Word      string `json:"word"`
+	Action    int32  `json:"action"` // 命中后的处理: 1-替换为* 2-放行并送审 3-拦截
+	CreatedBy int64  `json:"createdBy"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+type SetGroupRetentionReq struct {
+	GroupId       string `json:"groupId"`
+	RetentionDays int32  `json:"retentionDays,optional"` // 保留天数，0表示永久保留
+	UseDefault    bool   `json:"useDefault,optional"`    // 为 true 时恢复全局默认策略
+}
+
+type SystemPayload struct {
+	Event      string            `json:"event"`              // 事件: groupCreate/memberInvite/memberJoin/memberQuit/memberKick/adminAdd/adminRemove/memberMute/memberUnmute/muteAllOn/muteAllOff/announcementPublish/ownerTransfer/groupDismiss
+	OperatorId int64             `json:"operatorId"`         // 操作者ID
+	TargetIds  []int64           `json:"targetIds,optional"` // 被操作的成员ID
+	Params     map[string]string `json:"params,optional"`    // 事件参数
+	Text       string            `json:"text"`               // 按事件模板渲染的展示文本
+}
+
+type ThreadReplyInfo struct {
+	Id          int64           `json:"id"`
+	MsgId       string          `json:"msgId"`
+	RootMsgId   string          `json:"rootMsgId"` // 话题根消息唯一标识
+	GroupId     string          `json:"groupId"`
+	Seq         uint64          `json:"seq"` // 话题内Seq
+	FromUserId  int64           `json:"fromUserId"`
+	Content     string          `json:"content"`
+	ContentType int32           `json:"contentType"`
+	Payload     *MessagePayload `json:"payload,optional"`
+	CreatedAt   int64           `json:"createdAt"`
+}
+
+type ThreadSummary struct {
+	ReplyCount  int64  `json:"replyCount"`  // 回复数
+	LastSeq     uint64 `json:"lastSeq"`     // 最后一条回复的话题内Seq
+	LastReplyAt int64  `json:"lastReplyAt"` // 最后回复时间
+}
+
+type UnpinMessageReq struct {
+	ChatType int32  `json:"chatType"`         // 1-私聊 2-群聊
+	PeerId   int64  `json:"peerId,optional"`  // 私聊对方ID
+	GroupId  string `json:"groupId,optional"` // 群组ID
+	MsgId    string `json:"msgId"`
+}
+
+type UnpinMessageResp struct {
+	Success bool `json:"success"`
+}
+
+type UpdateFavoriteReq struct {
+	Id   int64    `json:"id"`
+	Tags []string `json:"tags,optional"`
+	Note string   `json:"note,optional"`
+}
+
+type UpdateScheduledMessageReq struct {
+	Id          int64   `json:"id"`
+	Content     string  `json:"content"`
+	ContentType int32   `json:"contentType,default=1"`
+	AtUserIds   []int64 `json:"atUserIds,optional"`
+	SendAt      int64   `json:"sendAt"`
+}
+
+type VideoPayload struct {
+	Url          string `json:"url"`
+	Duration     int32  `json:"duration"` // 秒
+	Width        int32  `json:"width,optional"`
+	Height       int32  `json:"height,optional"`
+	ThumbnailUrl string `json:"thumbnailUrl,optional"`
+}
+
+type VoicePayload struct {
+	Url      string `json:"url"`
+	Duration int32  `json:"duration"` // 秒
+}
+
+type VotePollReq struct {
+	MsgId         string  `json:"msgId"`
+	OptionIndexes []int32 `json:"optionIndexes"` // 选择的选项序号（单选时只能一个）
 }
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `im_export_job` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '发起导出的用户ID',
+    `owner_id` BIGINT UNSIGNED NOT NULL COMMENT '会话所属用户ID(私聊导出时为会话一方,一般等于user_id)',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
+    `peer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '私聊对方ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `format` VARCHAR(16) NOT NULL COMMENT '导出格式: json/html/text',
+    `start_time` DATETIME DEFAULT NULL COMMENT '起始时间(包含),为空表示不限',
+    `end_time` DATETIME DEFAULT NULL COMMENT '结束时间(不包含),为空表示不限',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-排队中 1-导出中 2-已完成 3-失败',
+    `object_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '导出文件在对象存储中的路径',
+    `file_size` BIGINT NOT NULL DEFAULT 0 COMMENT '导出文件大小(字节)',
+    `message_count` INT NOT NULL DEFAULT 0 COMMENT '导出的消息条数',
+    `fail_reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_user_created` (`user_id`, `created_at`),
+    KEY `idx_status` (`status`, `updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='聊天记录导出任务表';
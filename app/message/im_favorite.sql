@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `im_favorite` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '收藏者ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '来源消息唯一标识',
+    `message_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '来源消息数据库ID(用于跳转回原会话)',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '来源会话类型: 1-私聊 2-群聊',
+    `peer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '私聊对方ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '来源消息Seq',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '原消息发送者ID',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片',
+    `content` TEXT NOT NULL COMMENT '消息内容快照(原消息撤回或清理后仍保留)',
+    `tags` VARCHAR(1024) NOT NULL DEFAULT '[]' COMMENT '标签列表,JSON格式,如["工作","合同"]',
+    `note` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `msg_created_at` DATETIME NOT NULL COMMENT '原消息发送时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '收藏时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_msg` (`user_id`, `msg_id`),
+    KEY `idx_user_id` (`user_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息收藏表';
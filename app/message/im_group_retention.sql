@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `im_group_retention` (
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `retention_days` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消息保留天数,0表示永久保留',
+    `updated_by` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后修改人ID(群主)',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`group_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群消息保留策略表(未设置的群使用全局默认策略)';
\ No newline at end of file
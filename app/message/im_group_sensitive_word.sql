@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `im_group_sensitive_word` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `word` VARCHAR(64) NOT NULL COMMENT '敏感词',
+    `action` TINYINT NOT NULL DEFAULT 1 COMMENT '命中后的处理: 1-替换为* 2-送审 3-拦截',
+    `created_by` BIGINT UNSIGNED NOT NULL COMMENT '添加人ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_group_word` (`group_id`, `word`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群自定义敏感词表';
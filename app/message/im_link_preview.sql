@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS `im_link_preview` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '消息唯一标识',
+    `chat_type` TINYINT NOT NULL COMMENT '聊天类型: 1-私聊 2-群聊',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `to_user_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `url` VARCHAR(2048) NOT NULL COMMENT '消息中的链接',
+    `title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '标题',
+    `description` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '摘要',
+    `image` VARCHAR(2048) NOT NULL DEFAULT '' COMMENT '预览图地址',
+    `site_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '站点名称',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-待抓取 1-抓取中 2-已完成 3-失败',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_status` (`status`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息链接预览表';
@@ -5,11 +5,14 @@ CREATE TABLE IF NOT EXISTS `im_message` (
     `to_user_id` BIGINT UNSIGNED DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
     `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
     `group_id` VARCHAR(64) DEFAULT NULL COMMENT '群组ID(群聊时使用)',
-    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消息序列号(用于群聊消息连续性校验和拉取偏移量)',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消息序列号(群聊为群内Seq,私聊为会话内Seq,用于消息连续性校验和拉取偏移量)',
     `content` TEXT NOT NULL COMMENT '消息内容',
-    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频',
-    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '消息状态: 0-未读/未处理 1-已读 2-撤回 3-删除',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片 10-群系统消息',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '消息状态: 0-未读/未处理 1-已读 2-撤回 3-删除 4-已销毁(阅后即焚) 5-已清理(超过保留期限)',
     `at_user_ids` TEXT COMMENT '被@的用户ID列表,JSON格式,如["123","456"],@all用特殊值"-1"',
+    `expire_ttl` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '阅后即焚时长(秒),0表示不过期',
+    `expire_mode` TINYINT NOT NULL DEFAULT 0 COMMENT '过期计时方式: 0-不过期 1-发送后计时 2-阅读后计时',
+    `expire_at` DATETIME DEFAULT NULL COMMENT '过期时间(阅读后计时的消息在已读时设置)',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`id`),
@@ -20,5 +23,9 @@ CREATE TABLE IF NOT EXISTS `im_message` (
     KEY `idx_conversation` (`from_user_id`, `to_user_id`, `created_at`),
     KEY `idx_unread` (`to_user_id`, `status`, `created_at`),
     KEY `idx_group_seq` (`group_id`, `seq`),
-    KEY `idx_at_users` (`group_id`, `chat_type`) USING BTREE
+    KEY `idx_private_seq` (`from_user_id`, `to_user_id`, `seq`),
+    KEY `idx_expire_at` (`expire_at`),
+    KEY `idx_created_at` (`created_at`),
+    KEY `idx_at_users` (`group_id`, `chat_type`) USING BTREE,
+    FULLTEXT KEY `ft_content` (`content`) WITH PARSER ngram COMMENT '全文检索(ngram分词,支持中文)'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='IM 消息主表(支持私聊与群聊)';
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `im_message_id_alloc` (
+    `biz` VARCHAR(32) NOT NULL COMMENT '业务标识',
+    `max_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '已分配的最大ID',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`biz`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息ID分配表(分片存储时统一按顺序分配消息ID)';
+
+INSERT IGNORE INTO `im_message_id_alloc` (`biz`, `max_id`) VALUES ('im_message', 0);
\ No newline at end of file
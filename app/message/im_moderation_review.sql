@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `im_moderation_review` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '送审消息唯一标识',
+    `root_msg_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '话题根消息唯一标识(话题回复时有效)',
+    `chat_type` TINYINT NOT NULL COMMENT '聊天类型: 1-私聊 2-群聊',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `to_user_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `content` TEXT NOT NULL COMMENT '消息内容(已替换的敏感词保持替换后的内容)',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型',
+    `hit_words` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '命中的敏感词,JSON格式',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '审核状态: 0-待审核 1-已通过 2-已删除',
+    `reviewer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID',
+    `reviewed_at` DATETIME DEFAULT NULL COMMENT '审核时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_group_status` (`group_id`, `status`, `id`),
+    KEY `idx_status` (`status`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='内容审核队列表';
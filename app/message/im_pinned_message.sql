@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `im_pinned_message` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `conversation_key` VARCHAR(80) NOT NULL COMMENT '会话标识(私聊为"小ID_大ID",群聊为"g_群组ID")',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '被置顶消息的唯一标识',
+    `message_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '被置顶消息的数据库ID(用于跳转定位)',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '被置顶消息的Seq',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '原消息发送者ID',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片',
+    `content` TEXT NOT NULL COMMENT '消息内容快照(原消息归档后仍可展示)',
+    `msg_created_at` DATETIME NOT NULL COMMENT '原消息发送时间',
+    `pinned_by` BIGINT UNSIGNED NOT NULL COMMENT '置顶操作者ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '置顶时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_conversation_msg` (`conversation_key`, `msg_id`),
+    KEY `idx_conversation` (`conversation_key`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='置顶消息表';
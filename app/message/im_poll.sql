@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `im_poll` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '投票消息的唯一标识(im_message.msg_id)',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `creator_id` BIGINT UNSIGNED NOT NULL COMMENT '发起人ID',
+    `question` VARCHAR(255) NOT NULL COMMENT '投票主题',
+    `options` TEXT NOT NULL COMMENT '选项列表,JSON格式',
+    `multiple` TINYINT NOT NULL DEFAULT 0 COMMENT '是否多选: 0-单选 1-多选',
+    `anonymous` TINYINT NOT NULL DEFAULT 0 COMMENT '是否匿名: 0-实名 1-匿名',
+    `deadline` DATETIME DEFAULT NULL COMMENT '截止时间(NULL表示不限)',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-进行中 1-已结束',
+    `closed_at` DATETIME DEFAULT NULL COMMENT '结束时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_status_deadline` (`status`, `deadline`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群投票表';
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `im_poll_vote` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `poll_id` BIGINT UNSIGNED NOT NULL COMMENT '投票ID(im_poll.id)',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '投票人ID',
+    `options` VARCHAR(255) NOT NULL COMMENT '选择的选项序号,JSON格式',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_poll_user` (`poll_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群投票记录表';
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `im_scheduled_message` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '投递时使用的消息唯一标识(创建时生成,保证重复投递幂等)',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `to_user_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时使用)',
+    `content` TEXT NOT NULL COMMENT '消息内容',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型(同im_message)',
+    `at_user_ids` TEXT COMMENT '被@的用户ID列表,JSON格式',
+    `send_at` DATETIME NOT NULL COMMENT '计划发送时间',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-待发送 1-已发送 2-已取消 3-发送失败 4-发送中',
+    `message_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '发送成功后对应的im_message.id',
+    `fail_reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '发送失败原因',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_msg_id` (`msg_id`),
+    KEY `idx_user_status` (`from_user_id`, `status`, `send_at`),
+    KEY `idx_status_send_at` (`status`, `send_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='定时消息表';
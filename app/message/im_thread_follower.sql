@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `im_thread_follower` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `root_msg_id` VARCHAR(64) NOT NULL COMMENT '话题根消息的唯一标识',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '关注者ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '关注时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_root_user` (`root_msg_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群话题关注表';
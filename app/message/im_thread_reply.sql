@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `im_thread_reply` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '回复消息唯一标识(客户端生成)',
+    `root_msg_id` VARCHAR(64) NOT NULL COMMENT '话题根消息的唯一标识(im_message.msg_id)',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '话题内序列号(每个话题独立递增)',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `content` TEXT NOT NULL COMMENT '消息内容',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型(同im_message)',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_root_seq` (`root_msg_id`, `seq`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群话题回复表';
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImExportJobModel = (*customImExportJobModel)(nil)
+
+// 导出任务状态
+const (
+	ExportStatusPending = 0 // 排队中
+	ExportStatusRunning = 1 // 导出中（已被导出任务领取）
+	ExportStatusDone    = 2 // 已完成
+	ExportStatusFailed  = 3 // 失败
+)
+
+type (
+	// ImExportJobModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImExportJobModel.
+	ImExportJobModel interface {
+		imExportJobModel
+		// 查询用户发起的导出任务，按创建时间倒序
+		FindByUser(ctx context.Context, userId uint64, offset, limit int64) ([]*ImExportJob, error)
+		CountByUser(ctx context.Context, userId uint64) (int64, error)
+		// 统计用户未完成（排队中/导出中）的导出任务
+		CountUnfinishedByUser(ctx context.Context, userId uint64) (int64, error)
+		// 查询排队中的导出任务
+		FindPending(ctx context.Context, limit int64) ([]*ImExportJob, error)
+		// 领取排队中的任务（排队中 -> 导出中），返回是否领取成功
+		Claim(ctx context.Context, data *ImExportJob) (bool, error)
+		// 将长时间停留在导出中的任务恢复为排队中（导出实例异常退出的场景）
+		ResetStale(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	customImExportJobModel struct {
+		*defaultImExportJobModel
+	}
+)
+
+// NewImExportJobModel returns a model for the database table.
+func NewImExportJobModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImExportJobModel {
+	return &customImExportJobModel{
+		defaultImExportJobModel: newImExportJobModel(conn, c, opts...),
+	}
+}
+
+// FindByUser 查询用户发起的导出任务
+func (m *customImExportJobModel) FindByUser(ctx context.Context, userId uint64, offset, limit int64) ([]*ImExportJob, error) {
+	var resp []*ImExportJob
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? order by `created_at` desc, `id` desc limit ?, ?", imExportJobRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, offset, limit)
+	return resp, err
+}
+
+// CountByUser 统计用户发起的导出任务数量
+func (m *customImExportJobModel) CountByUser(ctx context.Context, userId uint64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId)
+	return count, err
+}
+
+// CountUnfinishedByUser 统计用户排队中和导出中的任务数量
+func (m *customImExportJobModel) CountUnfinishedByUser(ctx context.Context, userId uint64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ? and `status` in (?, ?)", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId, ExportStatusPending, ExportStatusRunning)
+	return count, err
+}
+
+// FindPending 查询排队中的导出任务，先创建的先处理
+func (m *customImExportJobModel) FindPending(ctx context.Context, limit int64) ([]*ImExportJob, error) {
+	var resp []*ImExportJob
+	query := fmt.Sprintf("select %s from %s where `status` = ? order by `id` asc limit ?", imExportJobRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, ExportStatusPending, limit)
+	return resp, err
+}
+
+// Claim 领取排队中的任务，多实例并发扫描时只有一个实例能领取成功
+func (m *customImExportJobModel) Claim(ctx context.Context, data *ImExportJob) (bool, error) {
+	return m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table),
+		ExportStatusRunning, data.Id, ExportStatusPending)
+}
+
+// ResetStale 将长时间停留在导出中的任务恢复为排队中，重新导出会生成新文件
+func (m *customImExportJobModel) ResetStale(ctx context.Context, before time.Time) (int64, error) {
+	var stale []*ImExportJob
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `updated_at` < ?", imExportJobRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &stale, query, ExportStatusRunning, before); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, data := range stale {
+		ok, err := m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table),
+			ExportStatusPending, data.Id, ExportStatusRunning)
+		if err != nil {
+			return count, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// execAffected 执行条件更新并清理缓存，返回是否有行被更新
+func (m *customImExportJobModel) execAffected(ctx context.Context, data *ImExportJob, query string, args ...any) (bool, error) {
+	imAuthImExportJobIdKey := fmt.Sprintf("%s%v", cacheImAuthImExportJobIdPrefix, data.Id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, imAuthImExportJobIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
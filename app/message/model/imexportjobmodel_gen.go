@@ -0,0 +1,125 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imExportJobFieldNames          = builder.RawFieldNames(&ImExportJob{})
+	imExportJobRows                = strings.Join(imExportJobFieldNames, ",")
+	imExportJobRowsExpectAutoSet   = strings.Join(stringx.Remove(imExportJobFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imExportJobRowsWithPlaceHolder = strings.Join(stringx.Remove(imExportJobFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImExportJobIdPrefix = "cache:imAuth:imExportJob:id:"
+)
+
+type (
+	imExportJobModel interface {
+		Insert(ctx context.Context, data *ImExportJob) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImExportJob, error)
+		Update(ctx context.Context, data *ImExportJob) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImExportJobModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImExportJob struct {
+		Id           uint64       `db:"id"`            // 自增主键ID
+		UserId       uint64       `db:"user_id"`       // 发起导出的用户ID
+		OwnerId      uint64       `db:"owner_id"`      // 会话所属用户ID(私聊导出时为会话一方,一般等于user_id)
+		ChatType     int64        `db:"chat_type"`     // 聊天类型: 1-私聊 2-群聊
+		PeerId       uint64       `db:"peer_id"`       // 私聊对方ID(私聊时有效)
+		GroupId      string       `db:"group_id"`      // 群组ID(群聊时有效)
+		Format       string       `db:"format"`        // 导出格式: json/html/text
+		StartTime    sql.NullTime `db:"start_time"`    // 起始时间(包含),为空表示不限
+		EndTime      sql.NullTime `db:"end_time"`      // 结束时间(不包含),为空表示不限
+		Status       int64        `db:"status"`        // 状态: 0-排队中 1-导出中 2-已完成 3-失败
+		ObjectName   string       `db:"object_name"`   // 导出文件在对象存储中的路径
+		FileSize     int64        `db:"file_size"`     // 导出文件大小(字节)
+		MessageCount int64        `db:"message_count"` // 导出的消息条数
+		FailReason   string       `db:"fail_reason"`   // 失败原因
+		CreatedAt    time.Time    `db:"created_at"`    // 创建时间
+		UpdatedAt    time.Time    `db:"updated_at"`    // 更新时间
+	}
+)
+
+func newImExportJobModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImExportJobModel {
+	return &defaultImExportJobModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_export_job`",
+	}
+}
+
+func (m *defaultImExportJobModel) Delete(ctx context.Context, id uint64) error {
+	imAuthImExportJobIdKey := fmt.Sprintf("%s%v", cacheImAuthImExportJobIdPrefix, id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImExportJobIdKey)
+	return err
+}
+
+func (m *defaultImExportJobModel) FindOne(ctx context.Context, id uint64) (*ImExportJob, error) {
+	imAuthImExportJobIdKey := fmt.Sprintf("%s%v", cacheImAuthImExportJobIdPrefix, id)
+	var resp ImExportJob
+	err := m.QueryRowCtx(ctx, &resp, imAuthImExportJobIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imExportJobRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImExportJobModel) Insert(ctx context.Context, data *ImExportJob) (sql.Result, error) {
+	imAuthImExportJobIdKey := fmt.Sprintf("%s%v", cacheImAuthImExportJobIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imExportJobRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.UserId, data.OwnerId, data.ChatType, data.PeerId, data.GroupId, data.Format, data.StartTime, data.EndTime, data.Status, data.ObjectName, data.FileSize, data.MessageCount, data.FailReason)
+	}, imAuthImExportJobIdKey)
+	return ret, err
+}
+
+func (m *defaultImExportJobModel) Update(ctx context.Context, data *ImExportJob) error {
+	imAuthImExportJobIdKey := fmt.Sprintf("%s%v", cacheImAuthImExportJobIdPrefix, data.Id)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imExportJobRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.UserId, data.OwnerId, data.ChatType, data.PeerId, data.GroupId, data.Format, data.StartTime, data.EndTime, data.Status, data.ObjectName, data.FileSize, data.MessageCount, data.FailReason, data.Id)
+	}, imAuthImExportJobIdKey)
+	return err
+}
+
+func (m *defaultImExportJobModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImExportJobIdPrefix, primary)
+}
+
+func (m *defaultImExportJobModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imExportJobRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImExportJobModel) tableName() string {
+	return m.table
+}
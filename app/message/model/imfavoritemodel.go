@@ -0,0 +1,133 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImFavoriteModel = (*customImFavoriteModel)(nil)
+
+type (
+	// ImFavoriteModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImFavoriteModel.
+	ImFavoriteModel interface {
+		imFavoriteModel
+		// 按条件查询用户的收藏，按收藏时间倒序
+		FindByUser(ctx context.Context, cond *FavoriteQuery) ([]*ImFavorite, error)
+		// 统计用户的收藏数量
+		CountByUser(ctx context.Context, userId uint64) (int64, error)
+		// 查询用户所有收藏的标签列表（JSON），用于汇总标签
+		FindTagsByUser(ctx context.Context, userId uint64) ([]string, error)
+		// 删除用户的收藏，返回被删除的收藏
+		DeleteByIds(ctx context.Context, userId uint64, ids []uint64) ([]*ImFavorite, error)
+	}
+
+	customImFavoriteModel struct {
+		*defaultImFavoriteModel
+	}
+
+	// FavoriteQuery 收藏查询条件
+	FavoriteQuery struct {
+		UserId      uint64
+		Keyword     string // 匹配内容快照或备注，为空时不限制
+		Tag         string // 为空时不限制
+		ContentType int64  // 为 0 时不限制
+		Cursor      uint64 // 上一页最后一条收藏ID，0 表示第一页
+		Limit       int64
+	}
+)
+
+// NewImFavoriteModel returns a model for the database table.
+func NewImFavoriteModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImFavoriteModel {
+	return &customImFavoriteModel{
+		defaultImFavoriteModel: newImFavoriteModel(conn, c, opts...),
+	}
+}
+
+// FindByUser 按条件查询用户的收藏
+// 每个用户的收藏数量有上限，关键词使用 LIKE 匹配，扫描范围限定在该用户的收藏内
+func (m *customImFavoriteModel) FindByUser(ctx context.Context, cond *FavoriteQuery) ([]*ImFavorite, error) {
+	where := []string{"`user_id` = ?"}
+	args := []interface{}{cond.UserId}
+	if cond.Cursor > 0 {
+		where = append(where, "`id` < ?")
+		args = append(args, cond.Cursor)
+	}
+	if cond.ContentType > 0 {
+		where = append(where, "`content_type` = ?")
+		args = append(args, cond.ContentType)
+	}
+	if cond.Tag != "" {
+		where = append(where, "json_contains(`tags`, json_quote(?))")
+		args = append(args, cond.Tag)
+	}
+	if cond.Keyword != "" {
+		like := "%" + escapeLike(cond.Keyword) + "%"
+		where = append(where, "(`content` like ? or `note` like ?)")
+		args = append(args, like, like)
+	}
+	args = append(args, cond.Limit)
+
+	var resp []*ImFavorite
+	query := fmt.Sprintf("select %s from %s where %s order by `id` desc limit ?", imFavoriteRows, m.table, strings.Join(where, " and "))
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// CountByUser 统计用户的收藏数量
+func (m *customImFavoriteModel) CountByUser(ctx context.Context, userId uint64) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `user_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, userId)
+	return count, err
+}
+
+// FindTagsByUser 查询用户设置过标签的收藏的标签列表
+func (m *customImFavoriteModel) FindTagsByUser(ctx context.Context, userId uint64) ([]string, error) {
+	var tags []string
+	query := fmt.Sprintf("select `tags` from %s where `user_id` = ? and `tags` <> '[]'", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &tags, query, userId)
+	return tags, err
+}
+
+// DeleteByIds 删除用户的收藏（只删除属于该用户的记录），返回被删除的收藏
+func (m *customImFavoriteModel) DeleteByIds(ctx context.Context, userId uint64, ids []uint64) ([]*ImFavorite, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	args := []interface{}{userId}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	var rows []*ImFavorite
+	query := fmt.Sprintf("select %s from %s where `user_id` = ? and `id` in (%s)", imFavoriteRows, m.table, placeholders)
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(rows)*2)
+	for _, data := range rows {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheImAuthImFavoriteIdPrefix, data.Id),
+			fmt.Sprintf("%s%v:%v", cacheImAuthImFavoriteUserIdMsgIdPrefix, data.UserId, data.MsgId))
+	}
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("delete from %s where `user_id` = ? and `id` in (%s)", m.table, placeholders)
+		return conn.ExecCtx(ctx, query, args...)
+	}, keys...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,160 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imFavoriteFieldNames          = builder.RawFieldNames(&ImFavorite{})
+	imFavoriteRows                = strings.Join(imFavoriteFieldNames, ",")
+	imFavoriteRowsExpectAutoSet   = strings.Join(stringx.Remove(imFavoriteFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imFavoriteRowsWithPlaceHolder = strings.Join(stringx.Remove(imFavoriteFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImFavoriteIdPrefix          = "cache:imAuth:imFavorite:id:"
+	cacheImAuthImFavoriteUserIdMsgIdPrefix = "cache:imAuth:imFavorite:userId:msgId:"
+)
+
+type (
+	imFavoriteModel interface {
+		Insert(ctx context.Context, data *ImFavorite) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImFavorite, error)
+		FindOneByUserIdMsgId(ctx context.Context, userId uint64, msgId string) (*ImFavorite, error)
+		Update(ctx context.Context, data *ImFavorite) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImFavoriteModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImFavorite struct {
+		Id           uint64    `db:"id"`             // 自增主键ID
+		UserId       uint64    `db:"user_id"`        // 收藏者ID
+		MsgId        string    `db:"msg_id"`         // 来源消息唯一标识
+		MessageId    uint64    `db:"message_id"`     // 来源消息数据库ID(用于跳转回原会话)
+		ChatType     int64     `db:"chat_type"`      // 来源会话类型: 1-私聊 2-群聊
+		PeerId       uint64    `db:"peer_id"`        // 私聊对方ID(私聊时有效)
+		GroupId      string    `db:"group_id"`       // 群组ID(群聊时有效)
+		Seq          uint64    `db:"seq"`            // 来源消息Seq
+		FromUserId   uint64    `db:"from_user_id"`   // 原消息发送者ID
+		ContentType  int64     `db:"content_type"`   // 消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片
+		Content      string    `db:"content"`        // 消息内容快照(原消息撤回或清理后仍保留)
+		Tags         string    `db:"tags"`           // 标签列表,JSON格式,如["工作","合同"]
+		Note         string    `db:"note"`           // 备注
+		MsgCreatedAt time.Time `db:"msg_created_at"` // 原消息发送时间
+		CreatedAt    time.Time `db:"created_at"`     // 收藏时间
+		UpdatedAt    time.Time `db:"updated_at"`     // 更新时间
+	}
+)
+
+func newImFavoriteModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImFavoriteModel {
+	return &defaultImFavoriteModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_favorite`",
+	}
+}
+
+func (m *defaultImFavoriteModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImFavoriteIdKey := fmt.Sprintf("%s%v", cacheImAuthImFavoriteIdPrefix, id)
+	imAuthImFavoriteUserIdMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImFavoriteUserIdMsgIdPrefix, data.UserId, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImFavoriteIdKey, imAuthImFavoriteUserIdMsgIdKey)
+	return err
+}
+
+func (m *defaultImFavoriteModel) FindOne(ctx context.Context, id uint64) (*ImFavorite, error) {
+	imAuthImFavoriteIdKey := fmt.Sprintf("%s%v", cacheImAuthImFavoriteIdPrefix, id)
+	var resp ImFavorite
+	err := m.QueryRowCtx(ctx, &resp, imAuthImFavoriteIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imFavoriteRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImFavoriteModel) FindOneByUserIdMsgId(ctx context.Context, userId uint64, msgId string) (*ImFavorite, error) {
+	imAuthImFavoriteUserIdMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImFavoriteUserIdMsgIdPrefix, userId, msgId)
+	var resp ImFavorite
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImFavoriteUserIdMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `user_id` = ? and `msg_id` = ? limit 1", imFavoriteRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, userId, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImFavoriteModel) Insert(ctx context.Context, data *ImFavorite) (sql.Result, error) {
+	imAuthImFavoriteIdKey := fmt.Sprintf("%s%v", cacheImAuthImFavoriteIdPrefix, data.Id)
+	imAuthImFavoriteUserIdMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImFavoriteUserIdMsgIdPrefix, data.UserId, data.MsgId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imFavoriteRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.UserId, data.MsgId, data.MessageId, data.ChatType, data.PeerId, data.GroupId, data.Seq, data.FromUserId, data.ContentType, data.Content, data.Tags, data.Note, data.MsgCreatedAt)
+	}, imAuthImFavoriteIdKey, imAuthImFavoriteUserIdMsgIdKey)
+	return ret, err
+}
+
+func (m *defaultImFavoriteModel) Update(ctx context.Context, newData *ImFavorite) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImFavoriteIdKey := fmt.Sprintf("%s%v", cacheImAuthImFavoriteIdPrefix, data.Id)
+	imAuthImFavoriteUserIdMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImFavoriteUserIdMsgIdPrefix, data.UserId, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imFavoriteRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.UserId, newData.MsgId, newData.MessageId, newData.ChatType, newData.PeerId, newData.GroupId, newData.Seq, newData.FromUserId, newData.ContentType, newData.Content, newData.Tags, newData.Note, newData.MsgCreatedAt, newData.Id)
+	}, imAuthImFavoriteIdKey, imAuthImFavoriteUserIdMsgIdKey)
+	return err
+}
+
+func (m *defaultImFavoriteModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImFavoriteIdPrefix, primary)
+}
+
+func (m *defaultImFavoriteModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imFavoriteRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImFavoriteModel) tableName() string {
+	return m.table
+}
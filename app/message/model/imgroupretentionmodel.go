@@ -0,0 +1,54 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImGroupRetentionModel = (*customImGroupRetentionModel)(nil)
+
+type (
+	// ImGroupRetentionModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImGroupRetentionModel.
+	ImGroupRetentionModel interface {
+		imGroupRetentionModel
+		// 设置群消息保留策略（不存在时插入）
+		Upsert(ctx context.Context, data *ImGroupRetention) error
+		// 查询所有单独设置了保留策略的群
+		FindAll(ctx context.Context) ([]*ImGroupRetention, error)
+	}
+
+	customImGroupRetentionModel struct {
+		*defaultImGroupRetentionModel
+	}
+)
+
+// NewImGroupRetentionModel returns a model for the database table.
+func NewImGroupRetentionModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupRetentionModel {
+	return &customImGroupRetentionModel{
+		defaultImGroupRetentionModel: newImGroupRetentionModel(conn, c, opts...),
+	}
+}
+
+// Upsert 设置群消息保留策略
+func (m *customImGroupRetentionModel) Upsert(ctx context.Context, data *ImGroupRetention) error {
+	imAuthImGroupRetentionGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupRetentionGroupIdPrefix, data.GroupId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?) on duplicate key update `retention_days` = values(`retention_days`), `updated_by` = values(`updated_by`)",
+			m.table, imGroupRetentionRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.RetentionDays, data.UpdatedBy)
+	}, imAuthImGroupRetentionGroupIdKey)
+	return err
+}
+
+// FindAll 查询所有单独设置了保留策略的群（数量与设置过策略的群数一致，由归档任务周期性读取）
+func (m *customImGroupRetentionModel) FindAll(ctx context.Context) ([]*ImGroupRetention, error) {
+	var resp []*ImGroupRetention
+	query := fmt.Sprintf("select %s from %s", imGroupRetentionRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query)
+	return resp, err
+}
@@ -0,0 +1,114 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imGroupRetentionFieldNames          = builder.RawFieldNames(&ImGroupRetention{})
+	imGroupRetentionRows                = strings.Join(imGroupRetentionFieldNames, ",")
+	imGroupRetentionRowsExpectAutoSet   = strings.Join(stringx.Remove(imGroupRetentionFieldNames, "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imGroupRetentionRowsWithPlaceHolder = strings.Join(stringx.Remove(imGroupRetentionFieldNames, "`group_id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImGroupRetentionGroupIdPrefix = "cache:imAuth:imGroupRetention:groupId:"
+)
+
+type (
+	imGroupRetentionModel interface {
+		Insert(ctx context.Context, data *ImGroupRetention) (sql.Result, error)
+		FindOne(ctx context.Context, groupId string) (*ImGroupRetention, error)
+		Update(ctx context.Context, data *ImGroupRetention) error
+		Delete(ctx context.Context, groupId string) error
+	}
+
+	defaultImGroupRetentionModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImGroupRetention struct {
+		GroupId       string    `db:"group_id"`       // 群组ID
+		RetentionDays uint64    `db:"retention_days"` // 消息保留天数,0表示永久保留
+		UpdatedBy     uint64    `db:"updated_by"`     // 最后修改人ID(群主)
+		CreatedAt     time.Time `db:"created_at"`     // 创建时间
+		UpdatedAt     time.Time `db:"updated_at"`     // 更新时间
+	}
+)
+
+func newImGroupRetentionModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImGroupRetentionModel {
+	return &defaultImGroupRetentionModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_group_retention`",
+	}
+}
+
+func (m *defaultImGroupRetentionModel) Delete(ctx context.Context, groupId string) error {
+	imAuthImGroupRetentionGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupRetentionGroupIdPrefix, groupId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `group_id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, groupId)
+	}, imAuthImGroupRetentionGroupIdKey)
+	return err
+}
+
+func (m *defaultImGroupRetentionModel) FindOne(ctx context.Context, groupId string) (*ImGroupRetention, error) {
+	imAuthImGroupRetentionGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupRetentionGroupIdPrefix, groupId)
+	var resp ImGroupRetention
+	err := m.QueryRowCtx(ctx, &resp, imAuthImGroupRetentionGroupIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `group_id` = ? limit 1", imGroupRetentionRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, groupId)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupRetentionModel) Insert(ctx context.Context, data *ImGroupRetention) (sql.Result, error) {
+	imAuthImGroupRetentionGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupRetentionGroupIdPrefix, data.GroupId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, imGroupRetentionRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.RetentionDays, data.UpdatedBy)
+	}, imAuthImGroupRetentionGroupIdKey)
+	return ret, err
+}
+
+func (m *defaultImGroupRetentionModel) Update(ctx context.Context, data *ImGroupRetention) error {
+	imAuthImGroupRetentionGroupIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupRetentionGroupIdPrefix, data.GroupId)
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `group_id` = ?", m.table, imGroupRetentionRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.RetentionDays, data.UpdatedBy, data.GroupId)
+	}, imAuthImGroupRetentionGroupIdKey)
+	return err
+}
+
+func (m *defaultImGroupRetentionModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImGroupRetentionGroupIdPrefix, primary)
+}
+
+func (m *defaultImGroupRetentionModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `group_id` = ? limit 1", imGroupRetentionRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImGroupRetentionModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImGroupSensitiveWordModel = (*customImGroupSensitiveWordModel)(nil)
+
+type (
+	// ImGroupSensitiveWordModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImGroupSensitiveWordModel.
+	ImGroupSensitiveWordModel interface {
+		imGroupSensitiveWordModel
+		// 查询群的全部自定义敏感词
+		FindByGroup(ctx context.Context, groupId string) ([]*ImGroupSensitiveWord, error)
+		// 统计群的自定义敏感词数量
+		CountByGroup(ctx context.Context, groupId string) (int64, error)
+	}
+
+	customImGroupSensitiveWordModel struct {
+		*defaultImGroupSensitiveWordModel
+	}
+)
+
+// NewImGroupSensitiveWordModel returns a model for the database table.
+func NewImGroupSensitiveWordModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImGroupSensitiveWordModel {
+	return &customImGroupSensitiveWordModel{
+		defaultImGroupSensitiveWordModel: newImGroupSensitiveWordModel(conn, c, opts...),
+	}
+}
+
+// FindByGroup 查询群的全部自定义敏感词（按添加顺序）
+func (m *customImGroupSensitiveWordModel) FindByGroup(ctx context.Context, groupId string) ([]*ImGroupSensitiveWord, error) {
+	var resp []*ImGroupSensitiveWord
+	query := fmt.Sprintf("select %s from %s where `group_id` = ? order by `id` asc", imGroupSensitiveWordRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId)
+	return resp, err
+}
+
+// CountByGroup 统计群的自定义敏感词数量
+func (m *customImGroupSensitiveWordModel) CountByGroup(ctx context.Context, groupId string) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `group_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, groupId)
+	return count, err
+}
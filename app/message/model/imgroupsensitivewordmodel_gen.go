@@ -0,0 +1,151 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imGroupSensitiveWordFieldNames          = builder.RawFieldNames(&ImGroupSensitiveWord{})
+	imGroupSensitiveWordRows                = strings.Join(imGroupSensitiveWordFieldNames, ",")
+	imGroupSensitiveWordRowsExpectAutoSet   = strings.Join(stringx.Remove(imGroupSensitiveWordFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imGroupSensitiveWordRowsWithPlaceHolder = strings.Join(stringx.Remove(imGroupSensitiveWordFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImGroupSensitiveWordIdPrefix          = "cache:imAuth:imGroupSensitiveWord:id:"
+	cacheImAuthImGroupSensitiveWordGroupIdWordPrefix = "cache:imAuth:imGroupSensitiveWord:groupId:word:"
+)
+
+type (
+	imGroupSensitiveWordModel interface {
+		Insert(ctx context.Context, data *ImGroupSensitiveWord) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImGroupSensitiveWord, error)
+		FindOneByGroupIdWord(ctx context.Context, groupId string, word string) (*ImGroupSensitiveWord, error)
+		Update(ctx context.Context, data *ImGroupSensitiveWord) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImGroupSensitiveWordModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImGroupSensitiveWord struct {
+		Id        uint64    `db:"id"`         // 自增主键ID
+		GroupId   string    `db:"group_id"`   // 群组ID
+		Word      string    `db:"word"`       // 敏感词
+		Action    int64     `db:"action"`     // 命中后的处理: 1-替换为* 2-送审 3-拦截
+		CreatedBy uint64    `db:"created_by"` // 添加人ID
+		CreatedAt time.Time `db:"created_at"` // 创建时间
+		UpdatedAt time.Time `db:"updated_at"` // 更新时间
+	}
+)
+
+func newImGroupSensitiveWordModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImGroupSensitiveWordModel {
+	return &defaultImGroupSensitiveWordModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_group_sensitive_word`",
+	}
+}
+
+func (m *defaultImGroupSensitiveWordModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupSensitiveWordGroupIdWordKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupSensitiveWordGroupIdWordPrefix, data.GroupId, data.Word)
+	imAuthImGroupSensitiveWordIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupSensitiveWordIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImGroupSensitiveWordGroupIdWordKey, imAuthImGroupSensitiveWordIdKey)
+	return err
+}
+
+func (m *defaultImGroupSensitiveWordModel) FindOne(ctx context.Context, id uint64) (*ImGroupSensitiveWord, error) {
+	imAuthImGroupSensitiveWordIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupSensitiveWordIdPrefix, id)
+	var resp ImGroupSensitiveWord
+	err := m.QueryRowCtx(ctx, &resp, imAuthImGroupSensitiveWordIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupSensitiveWordRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupSensitiveWordModel) FindOneByGroupIdWord(ctx context.Context, groupId string, word string) (*ImGroupSensitiveWord, error) {
+	imAuthImGroupSensitiveWordGroupIdWordKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupSensitiveWordGroupIdWordPrefix, groupId, word)
+	var resp ImGroupSensitiveWord
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImGroupSensitiveWordGroupIdWordKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `group_id` = ? and `word` = ? limit 1", imGroupSensitiveWordRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, groupId, word); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImGroupSensitiveWordModel) Insert(ctx context.Context, data *ImGroupSensitiveWord) (sql.Result, error) {
+	imAuthImGroupSensitiveWordGroupIdWordKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupSensitiveWordGroupIdWordPrefix, data.GroupId, data.Word)
+	imAuthImGroupSensitiveWordIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupSensitiveWordIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", m.table, imGroupSensitiveWordRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.GroupId, data.Word, data.Action, data.CreatedBy)
+	}, imAuthImGroupSensitiveWordGroupIdWordKey, imAuthImGroupSensitiveWordIdKey)
+	return ret, err
+}
+
+func (m *defaultImGroupSensitiveWordModel) Update(ctx context.Context, newData *ImGroupSensitiveWord) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImGroupSensitiveWordGroupIdWordKey := fmt.Sprintf("%s%v:%v", cacheImAuthImGroupSensitiveWordGroupIdWordPrefix, data.GroupId, data.Word)
+	imAuthImGroupSensitiveWordIdKey := fmt.Sprintf("%s%v", cacheImAuthImGroupSensitiveWordIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imGroupSensitiveWordRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.GroupId, newData.Word, newData.Action, newData.CreatedBy, newData.Id)
+	}, imAuthImGroupSensitiveWordGroupIdWordKey, imAuthImGroupSensitiveWordIdKey)
+	return err
+}
+
+func (m *defaultImGroupSensitiveWordModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImGroupSensitiveWordIdPrefix, primary)
+}
+
+func (m *defaultImGroupSensitiveWordModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imGroupSensitiveWordRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImGroupSensitiveWordModel) tableName() string {
+	return m.table
+}
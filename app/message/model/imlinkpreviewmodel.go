@@ -0,0 +1,122 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImLinkPreviewModel = (*customImLinkPreviewModel)(nil)
+
+// 链接预览抓取状态
+const (
+	LinkPreviewStatusPending  = 0 // 待抓取
+	LinkPreviewStatusFetching = 1 // 抓取中（已被抓取任务领取）
+	LinkPreviewStatusDone     = 2 // 已完成
+	LinkPreviewStatusFailed   = 3 // 失败（链接不可访问或没有可用的元数据）
+)
+
+type (
+	// ImLinkPreviewModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImLinkPreviewModel.
+	ImLinkPreviewModel interface {
+		imLinkPreviewModel
+		// 查询待抓取的链接，先创建的先处理
+		FindPending(ctx context.Context, limit int64) ([]*ImLinkPreview, error)
+		// 领取待抓取的链接，返回是否由本次调用领取
+		Claim(ctx context.Context, data *ImLinkPreview) (bool, error)
+		// 将长时间停留在抓取中的记录恢复为待抓取
+		ResetStale(ctx context.Context, before time.Time) (int64, error)
+		// 批量查询消息已完成的链接预览
+		FindDoneByMsgIds(ctx context.Context, msgIds []string) ([]*ImLinkPreview, error)
+	}
+
+	customImLinkPreviewModel struct {
+		*defaultImLinkPreviewModel
+	}
+)
+
+// NewImLinkPreviewModel returns a model for the database table.
+func NewImLinkPreviewModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImLinkPreviewModel {
+	return &customImLinkPreviewModel{
+		defaultImLinkPreviewModel: newImLinkPreviewModel(conn, c, opts...),
+	}
+}
+
+// FindPending 查询待抓取的链接，先创建的先处理
+func (m *customImLinkPreviewModel) FindPending(ctx context.Context, limit int64) ([]*ImLinkPreview, error) {
+	var resp []*ImLinkPreview
+	query := fmt.Sprintf("select %s from %s where `status` = ? order by `id` asc limit ?", imLinkPreviewRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, LinkPreviewStatusPending, limit)
+	return resp, err
+}
+
+// Claim 领取待抓取的链接，多实例并发扫描时只有一个实例能领取成功
+func (m *customImLinkPreviewModel) Claim(ctx context.Context, data *ImLinkPreview) (bool, error) {
+	return m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table),
+		LinkPreviewStatusFetching, data.Id, LinkPreviewStatusPending)
+}
+
+// ResetStale 将长时间停留在抓取中的记录恢复为待抓取（实例在抓取过程中退出）
+func (m *customImLinkPreviewModel) ResetStale(ctx context.Context, before time.Time) (int64, error) {
+	var stale []*ImLinkPreview
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `updated_at` < ?", imLinkPreviewRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &stale, query, LinkPreviewStatusFetching, before); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, data := range stale {
+		ok, err := m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table),
+			LinkPreviewStatusPending, data.Id, LinkPreviewStatusFetching)
+		if err != nil {
+			return count, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// FindDoneByMsgIds 批量查询消息已完成的链接预览（uk_msg_id）
+func (m *customImLinkPreviewModel) FindDoneByMsgIds(ctx context.Context, msgIds []string) ([]*ImLinkPreview, error) {
+	if len(msgIds) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(msgIds)+1)
+	args = append(args, LinkPreviewStatusDone)
+	for _, msgId := range msgIds {
+		args = append(args, msgId)
+	}
+
+	var resp []*ImLinkPreview
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `msg_id` in (%s)", imLinkPreviewRows, m.table,
+		strings.TrimSuffix(strings.Repeat("?,", len(msgIds)), ","))
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// execAffected 执行条件更新并清理缓存，返回是否有行被更新
+func (m *customImLinkPreviewModel) execAffected(ctx context.Context, data *ImLinkPreview, query string, args ...any) (bool, error) {
+	imAuthImLinkPreviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewIdPrefix, data.Id)
+	imAuthImLinkPreviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewMsgIdPrefix, data.MsgId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, imAuthImLinkPreviewIdKey, imAuthImLinkPreviewMsgIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
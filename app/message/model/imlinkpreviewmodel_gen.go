@@ -0,0 +1,158 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imLinkPreviewFieldNames          = builder.RawFieldNames(&ImLinkPreview{})
+	imLinkPreviewRows                = strings.Join(imLinkPreviewFieldNames, ",")
+	imLinkPreviewRowsExpectAutoSet   = strings.Join(stringx.Remove(imLinkPreviewFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imLinkPreviewRowsWithPlaceHolder = strings.Join(stringx.Remove(imLinkPreviewFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImLinkPreviewIdPrefix    = "cache:imAuth:imLinkPreview:id:"
+	cacheImAuthImLinkPreviewMsgIdPrefix = "cache:imAuth:imLinkPreview:msgId:"
+)
+
+type (
+	imLinkPreviewModel interface {
+		Insert(ctx context.Context, data *ImLinkPreview) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImLinkPreview, error)
+		FindOneByMsgId(ctx context.Context, msgId string) (*ImLinkPreview, error)
+		Update(ctx context.Context, data *ImLinkPreview) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImLinkPreviewModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImLinkPreview struct {
+		Id          uint64    `db:"id"`           // 自增主键ID
+		MsgId       string    `db:"msg_id"`       // 消息唯一标识
+		ChatType    int64     `db:"chat_type"`    // 聊天类型: 1-私聊 2-群聊
+		FromUserId  uint64    `db:"from_user_id"` // 发送者ID
+		ToUserId    uint64    `db:"to_user_id"`   // 接收者ID(私聊时有效)
+		GroupId     string    `db:"group_id"`     // 群组ID(群聊时有效)
+		Url         string    `db:"url"`          // 消息中的链接
+		Title       string    `db:"title"`        // 标题
+		Description string    `db:"description"`  // 摘要
+		Image       string    `db:"image"`        // 预览图地址
+		SiteName    string    `db:"site_name"`    // 站点名称
+		Status      int64     `db:"status"`       // 状态: 0-待抓取 1-抓取中 2-已完成 3-失败
+		CreatedAt   time.Time `db:"created_at"`   // 创建时间
+		UpdatedAt   time.Time `db:"updated_at"`   // 更新时间
+	}
+)
+
+func newImLinkPreviewModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImLinkPreviewModel {
+	return &defaultImLinkPreviewModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_link_preview`",
+	}
+}
+
+func (m *defaultImLinkPreviewModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImLinkPreviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewIdPrefix, id)
+	imAuthImLinkPreviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImLinkPreviewIdKey, imAuthImLinkPreviewMsgIdKey)
+	return err
+}
+
+func (m *defaultImLinkPreviewModel) FindOne(ctx context.Context, id uint64) (*ImLinkPreview, error) {
+	imAuthImLinkPreviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewIdPrefix, id)
+	var resp ImLinkPreview
+	err := m.QueryRowCtx(ctx, &resp, imAuthImLinkPreviewIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imLinkPreviewRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImLinkPreviewModel) FindOneByMsgId(ctx context.Context, msgId string) (*ImLinkPreview, error) {
+	imAuthImLinkPreviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewMsgIdPrefix, msgId)
+	var resp ImLinkPreview
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImLinkPreviewMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `msg_id` = ? limit 1", imLinkPreviewRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImLinkPreviewModel) Insert(ctx context.Context, data *ImLinkPreview) (sql.Result, error) {
+	imAuthImLinkPreviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewIdPrefix, data.Id)
+	imAuthImLinkPreviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewMsgIdPrefix, data.MsgId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imLinkPreviewRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MsgId, data.ChatType, data.FromUserId, data.ToUserId, data.GroupId, data.Url, data.Title, data.Description, data.Image, data.SiteName, data.Status)
+	}, imAuthImLinkPreviewIdKey, imAuthImLinkPreviewMsgIdKey)
+	return ret, err
+}
+
+func (m *defaultImLinkPreviewModel) Update(ctx context.Context, newData *ImLinkPreview) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImLinkPreviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewIdPrefix, data.Id)
+	imAuthImLinkPreviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imLinkPreviewRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.MsgId, newData.ChatType, newData.FromUserId, newData.ToUserId, newData.GroupId, newData.Url, newData.Title, newData.Description, newData.Image, newData.SiteName, newData.Status, newData.Id)
+	}, imAuthImLinkPreviewIdKey, imAuthImLinkPreviewMsgIdKey)
+	return err
+}
+
+func (m *defaultImLinkPreviewModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImLinkPreviewIdPrefix, primary)
+}
+
+func (m *defaultImLinkPreviewModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imLinkPreviewRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImLinkPreviewModel) tableName() string {
+	return m.table
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -16,6 +20,9 @@ type (
 	// and implement the added methods in customImMessageModel.
 	ImMessageModel interface {
 		imMessageModel
+		// 按会话查询消息（分片存储时只访问会话所在的分片，conversationKey 见 ConversationKey）
+		FindOneInConversation(ctx context.Context, conversationKey string, id uint64) (*ImMessage, error)
+		FindOneByMsgIdInConversation(ctx context.Context, conversationKey, msgId string) (*ImMessage, error)
 		// 群聊消息查询方法
 		FindGroupMessageList(ctx context.Context, groupId string, lastMsgId, limit int64) ([]*ImMessage, error)
 		// 私聊消息查询方法
@@ -24,14 +31,42 @@ type (
 		// 批量查询所有未读私聊消息
 		FindAllUnreadMessages(ctx context.Context, userId int64) ([]*ImMessage, error)
 		CountUnreadMessages(ctx context.Context, userId, peerId int64) (int64, error)
+		// 按私聊会话统计未读数（未读计数重建）
+		CountUnreadByPeer(ctx context.Context, userId int64) ([]*PeerUnreadCount, error)
+		// 统计群聊已读Seq之后的未读数及@我的未读数（未读计数重建）
+		CountGroupUnread(ctx context.Context, groupId string, userId int64, readSeq uint64, joinedAt time.Time) (*GroupUnreadCount, error)
 		MarkMessagesAsRead(ctx context.Context, userId, peerId int64, msgIds []string) (int64, error)
-		FindGroupMessagesAfterSeq(ctx context.Context, groupId string, seq uint64) ([]*ImMessage, error)
-		// 模糊搜索消息内容
-		SearchByKeyword(ctx context.Context, userId int64, keyword string) ([]*ImMessage, error)
+		FindGroupMessagesAfterSeq(ctx context.Context, groupId string, seq uint64, limit int64) ([]*ImMessage, error)
+		// 群聊历史消息：从指定Seq向前（更早）或向后（更新）读取，按Seq倒序返回（idx_group_seq）
+		FindGroupMessagesBySeq(ctx context.Context, groupId string, cursor HistoryCursor, forward bool, limit int64) ([]*ImMessage, error)
+		// 群聊历史消息：指定时间对应的Seq位置（之前的消息均早于该时间，之后的消息均不早于该时间）
+		FindGroupSeqByTime(ctx context.Context, groupId string, t time.Time) (uint64, error)
+		// 私聊历史消息：从指定时间向前（更早）或向后（更新）读取，按时间倒序返回（idx_conversation）
+		FindPrivateMessagesByTime(ctx context.Context, userId, peerId int64, cursor HistoryCursor, forward bool, limit int64) ([]*ImMessage, error)
+		// 查询大于指定Seq的私聊消息
+		FindPrivateMessagesAfterSeq(ctx context.Context, userId, peerId int64, seq uint64, limit int64) ([]*ImMessage, error)
+		// 按条件搜索消息内容（全文索引）
+		SearchMessages(ctx context.Context, cond *MessageSearchCond) ([]*ImMessage, error)
 		// 查询群组当前最大Seq
 		FindGroupMaxSeq(ctx context.Context, groupId string) (int64, error)
+		// 查询私聊会话当前最大Seq
+		FindPrivateMaxSeq(ctx context.Context, userId, peerId int64) (int64, error)
 		// 查询@我的消息
 		FindAtMeMessages(ctx context.Context, userId int64, groupId string, lastMsgId int64, limit int32) ([]*ImMessage, error)
+		// 阅后即焚：已读时开始计时（仅阅读后计时的消息）
+		StartReadExpiry(ctx context.Context, userId, peerId int64, msgIds []string) (int64, error)
+		// 阅后即焚：查询已到期但未销毁的消息
+		FindExpiredMessages(ctx context.Context, now time.Time, limit int64) ([]*ImMessage, error)
+		// 阅后即焚：销毁消息内容（返回是否由本次调用完成销毁）
+		BurnMessage(ctx context.Context, data *ImMessage) (bool, error)
+		// 内容审核：删除违规消息内容（返回是否由本次调用完成删除）
+		RemoveMessage(ctx context.Context, data *ImMessage) (bool, error)
+		// 归档：查询早于指定时间、可以移入归档表的消息
+		FindArchivableMessages(ctx context.Context, before time.Time, limit int64) ([]*ImMessage, error)
+		// 归档：将消息移入所属月份的归档表
+		ArchiveMessages(ctx context.Context, messages []*ImMessage) error
+		// 保留期限：清理早于指定时间的消息内容（热表与归档表），返回清理条数
+		PurgeMessages(ctx context.Context, scope PurgeScope, before time.Time, limit int64) (int64, error)
 		// 暴露底层数据库操作方法
 		QueryRowsNoCacheCtx(ctx context.Context, v interface{}, query string, args ...interface{}) error
 		QueryRowNoCacheCtx(ctx context.Context, v interface{}, query string, args ...interface{}) error
@@ -40,62 +75,94 @@ type (
 
 	customImMessageModel struct {
 		*defaultImMessageModel
+		// purgeArchives 按默认范围清理时是否同时处理归档表
+		// 同一数据库中的多个分片共享归档表，只由其中一个分片负责
+		purgeArchives bool
+	}
+
+	// MessageSearchCond 消息搜索条件
+	MessageSearchCond struct {
+		UserId         int64
+		Against        string   // 全文检索表达式（BOOLEAN MODE），为空时不走全文索引
+		Likes          []string // 短于 ngram 分词长度的关键词，使用 LIKE 匹配
+		IncludePrivate bool     // 是否搜索私聊
+		PeerId         int64    // 私聊对方ID，0 表示所有私聊
+		Groups         []GroupSearchScope
+		SenderId       int64
+		ContentTypes   []int64
+		StartTime      time.Time // 为零值时不限制
+		EndTime        time.Time // 为零值时不限制
+		Cursor         int64     // 上一页最后一条消息ID，0 表示第一页
+		Limit          int64
+	}
+
+	// GroupSearchScope 可搜索的群组，只能搜到加入之后的消息
+	GroupSearchScope struct {
+		GroupId  string
+		JoinedAt time.Time
+	}
+
+	// HistoryCursor 历史消息读取位置（不包含该位置本身）
+	HistoryCursor struct {
+		Seq       uint64    // 群聊：按 Seq 定位
+		CreatedAt time.Time // 私聊：按发送时间定位；群聊：可选，用于跳过无关月份的归档表
+		Id        uint64    // 私聊：发送时间相同的消息按ID区分，为 0 时只按时间定位（向后读取时包含该时间的消息）
+	}
+
+	// PeerUnreadCount 私聊会话未读数
+	PeerUnreadCount struct {
+		PeerId int64 `db:"peer_id"`
+		Count  int64 `db:"count"`
+	}
+
+	// GroupUnreadCount 群聊未读数
+	GroupUnreadCount struct {
+		Unread int64 `db:"unread"`
+		AtMe   int64 `db:"at_me"`
+	}
+
+	// PurgeScope 按保留期限清理的消息范围
+	PurgeScope struct {
+		GroupId         string   // 只清理该群的消息（包括归档表）
+		ExcludeGroupIds []string // GroupId 为空时清理私聊及其余群聊，跳过这些单独设置了保留策略的群
 	}
 )
 
+// PrivateConversationKey 生成私聊会话标识（与发送方向无关，小ID在前）
+func PrivateConversationKey(userId, peerId int64) string {
+	if userId > peerId {
+		userId, peerId = peerId, userId
+	}
+	return fmt.Sprintf("%d_%d", userId, peerId)
+}
+
 // NewImMessageModel returns a model for the database table.
 func NewImMessageModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImMessageModel {
 	return &customImMessageModel{
 		defaultImMessageModel: newImMessageModel(conn, c, opts...),
+		purgeArchives:         true,
 	}
 }
 
-// FindGroupMessageList 查询群聊历史消息（分页）
-func (m *customImMessageModel) FindGroupMessageList(ctx context.Context, groupId string, lastMsgId, limit int64) ([]*ImMessage, error) {
-	var resp []*ImMessage
-
-	var query string
-	var args []interface{}
+// FindOneInConversation 按消息ID查询（单表存储时与 FindOne 相同）
+func (m *customImMessageModel) FindOneInConversation(ctx context.Context, conversationKey string, id uint64) (*ImMessage, error) {
+	return m.FindOne(ctx, id)
+}
 
-	if lastMsgId > 0 {
-		query = fmt.Sprintf("select %s from %s where `chat_type` = 2 and `group_id` = ? and `id` < ? order by `id` desc limit ?", imMessageRows, m.table)
-		args = []interface{}{groupId, lastMsgId, limit}
-	} else {
-		query = fmt.Sprintf("select %s from %s where `chat_type` = 2 and `group_id` = ? order by `id` desc limit ?", imMessageRows, m.table)
-		args = []interface{}{groupId, limit}
-	}
+// FindOneByMsgIdInConversation 按 msg_id 查询（单表存储时与 FindOneByMsgId 相同）
+func (m *customImMessageModel) FindOneByMsgIdInConversation(ctx context.Context, conversationKey, msgId string) (*ImMessage, error) {
+	return m.FindOneByMsgId(ctx, msgId)
+}
 
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
-	switch err {
-	case nil:
-		return resp, nil
-	default:
-		return nil, err
-	}
+// FindGroupMessageList 查询群聊历史消息（分页，热表不足一页时继续读取归档表）
+func (m *customImMessageModel) FindGroupMessageList(ctx context.Context, groupId string, lastMsgId, limit int64) ([]*ImMessage, error) {
+	return m.findHistory(ctx, "`chat_type` = 2 and `group_id` = ?", []interface{}{groupId}, lastMsgId, limit)
 }
 
-// FindPrivateMessageList 查询私聊历史消息（分页）
+// FindPrivateMessageList 查询私聊历史消息（分页，热表不足一页时继续读取归档表）
 func (m *customImMessageModel) FindPrivateMessageList(ctx context.Context, userId, peerId, lastMsgId, limit int64) ([]*ImMessage, error) {
-	var resp []*ImMessage
-
-	var query string
-	var args []interface{}
-
-	if lastMsgId > 0 {
-		query = fmt.Sprintf("select %s from %s where `chat_type` = 1 and ((from_user_id = ? and to_user_id = ?) or (from_user_id = ? and to_user_id = ?)) and `id` < ? order by `id` desc limit ?", imMessageRows, m.table)
-		args = []interface{}{userId, peerId, peerId, userId, lastMsgId, limit}
-	} else {
-		query = fmt.Sprintf("select %s from %s where `chat_type` = 1 and ((from_user_id = ? and to_user_id = ?) or (from_user_id = ? and to_user_id = ?)) order by `id` desc limit ?", imMessageRows, m.table)
-		args = []interface{}{userId, peerId, peerId, userId, limit}
-	}
-
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
-	switch err {
-	case nil:
-		return resp, nil
-	default:
-		return nil, err
-	}
+	return m.findHistory(ctx, "`chat_type` = 1 and ((from_user_id = ? and to_user_id = ?) or (from_user_id = ? and to_user_id = ?))",
+		[]interface{}{userId, peerId, peerId, userId}, lastMsgId, limit)
 }
 
 // FindUnreadMessages 获取未读消息列表
@@ -144,6 +211,28 @@ func (m *customImMessageModel) CountUnreadMessages(ctx context.Context, userId,
 	return count, err
 }
 
+// CountUnreadByPeer 按发送者分组统计用户的私聊未读数
+func (m *customImMessageModel) CountUnreadByPeer(ctx context.Context, userId int64) ([]*PeerUnreadCount, error) {
+	var resp []*PeerUnreadCount
+	query := fmt.Sprintf("select `from_user_id` as `peer_id`, count(*) as `count` from %s where `chat_type` = 1 and `to_user_id` = ? and `status` = 0 group by `from_user_id`", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
+
+// CountGroupUnread 统计群聊已读Seq之后、加入群组之后其他成员发送的消息数，以及其中@我（含@全体）的消息数
+// at_user_ids 由发送逻辑序列化为 JSON 数值数组（如 [123,-1]），群系统消息（content_type=10）不计入未读
+// 只统计热表：超过归档期限仍未读的消息不计入
+func (m *customImMessageModel) CountGroupUnread(ctx context.Context, groupId string, userId int64, readSeq uint64, joinedAt time.Time) (*GroupUnreadCount, error) {
+	var resp GroupUnreadCount
+	query := fmt.Sprintf("select count(*) as `unread`, coalesce(sum(json_contains(`at_user_ids`, ?) or json_contains(`at_user_ids`, '-1')), 0) as `at_me` from %s "+
+		"where `chat_type` = 2 and `group_id` = ? and `seq` > ? and `created_at` >= ? and `from_user_id` <> ? and `content_type` <> 10 and `status` not in (2, 3)", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, strconv.FormatInt(userId, 10), groupId, readSeq, joinedAt, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // MarkMessagesAsRead 标记消息为已读
 func (m *customImMessageModel) MarkMessagesAsRead(ctx context.Context, userId, peerId int64, msgIds []string) (int64, error) {
 	var query string
@@ -157,7 +246,7 @@ func (m *customImMessageModel) MarkMessagesAsRead(ctx context.Context, userId, p
 			}
 			placeholders += "?"
 		}
-		query = fmt.Sprintf("update %s set `status` = 1 where `chat_type` = 1 and `to_user_id` = ? and `from_user_id` = ? and `msg_id` in (%s)", m.table, placeholders)
+		query = fmt.Sprintf("update %s set `status` = 1 where `chat_type` = 1 and `to_user_id` = ? and `from_user_id` = ? and `status` = 0 and `msg_id` in (%s)", m.table, placeholders)
 		args = append([]interface{}{userId, peerId}, convertStringsToInterfaces(msgIds)...)
 	} else {
 		query = fmt.Sprintf("update %s set `status` = 1 where `chat_type` = 1 and `to_user_id` = ? and `from_user_id` = ? and `status` = 0", m.table)
@@ -196,24 +285,91 @@ func (m *customImMessageModel) ExecNoCacheCtx(ctx context.Context, query string,
 	return m.CachedConn.ExecNoCacheCtx(ctx, query, args...)
 }
 
-// FindGroupMessagesAfterSeq 查询大于指定Seq的群聊消息
-// SearchByKeyword 模糊搜索消息内容（仅限用户参与的聊天）
-func (m *customImMessageModel) SearchByKeyword(ctx context.Context, userId int64, keyword string) ([]*ImMessage, error) {
-	var resp []*ImMessage
-	likeKeyword := "%" + keyword + "%"
+// SearchMessages 按条件搜索消息内容
+// 只返回用户参与的私聊和 cond.Groups 中的群聊（且不早于加入时间），按ID倒序
+func (m *customImMessageModel) SearchMessages(ctx context.Context, cond *MessageSearchCond) ([]*ImMessage, error) {
+	if cond.Against == "" && len(cond.Likes) == 0 {
+		return nil, nil
+	}
+
+	var where []string
+	var args []interface{}
+
+	// 会话范围：私聊限定本人参与，群聊限定已加入的群且不早于加入时间
+	var scopes []string
+	if cond.IncludePrivate {
+		if cond.PeerId > 0 {
+			scopes = append(scopes, "(`chat_type` = 1 and ((`from_user_id` = ? and `to_user_id` = ?) or (`from_user_id` = ? and `to_user_id` = ?)))")
+			args = append(args, cond.UserId, cond.PeerId, cond.PeerId, cond.UserId)
+		} else {
+			scopes = append(scopes, "(`chat_type` = 1 and (`from_user_id` = ? or `to_user_id` = ?))")
+			args = append(args, cond.UserId, cond.UserId)
+		}
+	}
+	for _, g := range cond.Groups {
+		scopes = append(scopes, "(`chat_type` = 2 and `group_id` = ? and `created_at` >= ?)")
+		args = append(args, g.GroupId, g.JoinedAt)
+	}
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+	where = append(where, "("+strings.Join(scopes, " or ")+")")
+
+	if cond.Against != "" {
+		where = append(where, "match(`content`) against(? in boolean mode)")
+		args = append(args, cond.Against)
+	}
+	for _, like := range cond.Likes {
+		where = append(where, "`content` like ?")
+		args = append(args, "%"+escapeLike(like)+"%")
+	}
 
-	// 这里的逻辑稍微复杂点：搜索用户参与的私聊消息，或者用户所在群组的消息（这里简化为全库搜索内容匹配的消息，实际生产环境需要关联群成员表）
-	// 为了演示，我们先实现基础的内容匹配，关联用户 ID 以保证只能搜到自己的私聊
-	query := fmt.Sprintf("select %s from %s where `content` like ? and ((`chat_type` = 1 and (`from_user_id` = ? or `to_user_id` = ?)) or (`chat_type` = 2)) order by `created_at` desc limit 100", imMessageRows, m.table)
+	// 排除已撤回、已删除、已销毁及已到期的阅后即焚消息，以及群系统消息（content 为结构化事件）
+	where = append(where, "`status` not in (2, 3, 4)", "(`expire_at` is null or `expire_at` > ?)", "`content_type` <> 10")
+	args = append(args, time.Now())
 
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, likeKeyword, userId, userId)
+	if len(cond.ContentTypes) > 0 {
+		placeholders := strings.Repeat("?,", len(cond.ContentTypes))
+		where = append(where, fmt.Sprintf("`content_type` in (%s)", placeholders[:len(placeholders)-1]))
+		for _, t := range cond.ContentTypes {
+			args = append(args, t)
+		}
+	}
+	if cond.SenderId > 0 {
+		where = append(where, "`from_user_id` = ?")
+		args = append(args, cond.SenderId)
+	}
+	if !cond.StartTime.IsZero() {
+		where = append(where, "`created_at` >= ?")
+		args = append(args, cond.StartTime)
+	}
+	if !cond.EndTime.IsZero() {
+		where = append(where, "`created_at` < ?")
+		args = append(args, cond.EndTime)
+	}
+	if cond.Cursor > 0 {
+		where = append(where, "`id` < ?")
+		args = append(args, cond.Cursor)
+	}
+
+	query := fmt.Sprintf("select %s from %s where %s order by `id` desc limit ?", imMessageRows, m.table, strings.Join(where, " and "))
+	args = append(args, cond.Limit)
+
+	var resp []*ImMessage
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
 
-func (m *customImMessageModel) FindGroupMessagesAfterSeq(ctx context.Context, groupId string, seq uint64) ([]*ImMessage, error) {
+// escapeLike 转义 LIKE 通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// FindGroupMessagesAfterSeq 查询大于指定Seq的群聊消息（按Seq升序，最多 limit 条）
+func (m *customImMessageModel) FindGroupMessagesAfterSeq(ctx context.Context, groupId string, seq uint64, limit int64) ([]*ImMessage, error) {
 	var resp []*ImMessage
-	query := fmt.Sprintf("select %s from %s where `chat_type` = 2 and `group_id` = ? and `seq` > ? order by `seq` asc limit 200", imMessageRows, m.table)
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId, seq)
+	query := fmt.Sprintf("select %s from %s where `chat_type` = 2 and `group_id` = ? and `seq` > ? order by `seq` asc limit ?", imMessageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId, seq, limit)
 	switch err {
 	case nil:
 		return resp, nil
@@ -233,7 +389,36 @@ func (m *customImMessageModel) FindGroupMaxSeq(ctx context.Context, groupId stri
 	if maxSeq.Valid {
 		return maxSeq.Int64, nil
 	}
-	return 0, nil
+	// 热表中的消息已全部归档时从归档表查询，避免Seq回退
+	return m.findArchiveMaxSeq(ctx, "`chat_type` = 2 and `group_id` = ?", groupId)
+}
+
+// FindPrivateMessagesAfterSeq 查询大于指定Seq的私聊消息（双向，按Seq升序，最多 limit 条）
+func (m *customImMessageModel) FindPrivateMessagesAfterSeq(ctx context.Context, userId, peerId int64, seq uint64, limit int64) ([]*ImMessage, error) {
+	var resp []*ImMessage
+	query := fmt.Sprintf("select %s from %s where `chat_type` = 1 and ((from_user_id = ? and to_user_id = ?) or (from_user_id = ? and to_user_id = ?)) and `seq` > ? order by `seq` asc limit ?", imMessageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, peerId, peerId, userId, seq, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	default:
+		return nil, err
+	}
+}
+
+// FindPrivateMaxSeq 查询私聊会话当前最大Seq
+func (m *customImMessageModel) FindPrivateMaxSeq(ctx context.Context, userId, peerId int64) (int64, error) {
+	var maxSeq sql.NullInt64
+	query := fmt.Sprintf("select max(seq) from %s where `chat_type` = 1 and ((from_user_id = ? and to_user_id = ?) or (from_user_id = ? and to_user_id = ?))", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &maxSeq, query, userId, peerId, peerId, userId)
+	if err != nil {
+		return 0, err
+	}
+	if maxSeq.Valid {
+		return maxSeq.Int64, nil
+	}
+	return m.findArchiveMaxSeq(ctx, "`chat_type` = 1 and ((from_user_id = ? and to_user_id = ?) or (from_user_id = ? and to_user_id = ?))",
+		userId, peerId, peerId, userId)
 }
 
 // FindAtMeMessages 查询@我的消息（群聊）
@@ -313,3 +498,448 @@ func (m *customImMessageModel) FindAtMeMessages(ctx context.Context, userId int6
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
+
+// StartReadExpiry 阅读后计时的消息在已读时设置过期时间
+// 先查出需要开始计时的消息，更新后删除这些消息的缓存
+func (m *customImMessageModel) StartReadExpiry(ctx context.Context, userId, peerId int64, msgIds []string) (int64, error) {
+	query := fmt.Sprintf("select `id`, `msg_id` from %s where `chat_type` = 1 and `to_user_id` = ? and `from_user_id` = ? and `expire_mode` = 2 and `expire_at` is null and `status` = 1", m.table)
+	args := []interface{}{userId, peerId}
+
+	if len(msgIds) > 0 {
+		query += fmt.Sprintf(" and `msg_id` in (%s)", strings.TrimSuffix(strings.Repeat("?,", len(msgIds)), ","))
+		args = append(args, convertStringsToInterfaces(msgIds)...)
+	}
+
+	var rows []struct {
+		Id    uint64 `db:"id"`
+		MsgId string `db:"msg_id"`
+	}
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]interface{}, 0, len(rows))
+	keys := make([]string, 0, len(rows)*2)
+	for _, row := range rows {
+		ids = append(ids, row.Id)
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, row.Id),
+			fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, row.MsgId))
+	}
+
+	query = fmt.Sprintf("update %s set `expire_at` = DATE_ADD(NOW(), INTERVAL `expire_ttl` SECOND) where `id` in (%s) and `expire_at` is null", m.table, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+	result, err := m.ExecNoCacheCtx(ctx, query, ids...)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.DelCacheCtx(ctx, keys...); err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// FindExpiredMessages 查询已到期但未销毁的消息（只处理未读/已读的正常消息，撤回、删除、销毁、清理的消息内容已清空）
+func (m *customImMessageModel) FindExpiredMessages(ctx context.Context, now time.Time, limit int64) ([]*ImMessage, error) {
+	var resp []*ImMessage
+	query := fmt.Sprintf("select %s from %s where `expire_at` is not null and `expire_at` <= ? and `status` in (0, 1) order by `expire_at` asc limit ?", imMessageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, now, limit)
+	switch err {
+	case nil:
+		return resp, nil
+	default:
+		return nil, err
+	}
+}
+
+// BurnMessage 清空消息内容并标记为已销毁
+// 多实例并发扫描时只有一个实例能更新成功，返回 true 的调用方负责推送销毁事件
+func (m *customImMessageModel) BurnMessage(ctx context.Context, data *ImMessage) (bool, error) {
+	imAuthImMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, data.Id)
+	imAuthImMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, data.MsgId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `content` = '', `at_user_ids` = null, `status` = 4 where `id` = ? and `status` in (0, 1)", m.table)
+		return conn.ExecCtx(ctx, query, data.Id)
+	}, imAuthImMessageIdKey, imAuthImMessageMsgIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// RemoveMessage 清空消息内容并标记为已删除（审核删除违规消息）
+// 已撤回、删除、销毁、清理的消息不再处理，返回 true 的调用方负责推送删除事件
+func (m *customImMessageModel) RemoveMessage(ctx context.Context, data *ImMessage) (bool, error) {
+	imAuthImMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, data.Id)
+	imAuthImMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, data.MsgId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `content` = '', `at_user_ids` = null, `status` = 3 where `id` = ? and `status` in (0, 1)", m.table)
+		return conn.ExecCtx(ctx, query, data.Id)
+	}, imAuthImMessageIdKey, imAuthImMessageMsgIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// archiveTablePrefix 归档表前缀，归档表按消息创建月份拆分，如 im_message_archive_202401
+const archiveTablePrefix = "im_message_archive_"
+
+// ArchiveTableName 消息所属月份的归档表名
+func ArchiveTableName(t time.Time) string {
+	return archiveTablePrefix + t.Format("200601")
+}
+
+// findHistory 按ID倒序分页查询历史消息
+// 热表不足一页时按月份从新到旧继续读取归档表，归档表中的消息ID均小于热表，分页游标保持连续
+func (m *customImMessageModel) findHistory(ctx context.Context, where string, whereArgs []interface{}, lastMsgId, limit int64) ([]*ImMessage, error) {
+	resp, err := m.findPage(ctx, m.table, where, whereArgs, lastMsgId, limit)
+	if err != nil || int64(len(resp)) >= limit {
+		return resp, err
+	}
+
+	tables, err := m.archiveTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		if len(resp) > 0 {
+			lastMsgId = int64(resp[len(resp)-1].Id)
+		}
+		page, err := m.findPage(ctx, table, where, whereArgs, lastMsgId, limit-int64(len(resp)))
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, page...)
+		if int64(len(resp)) >= limit {
+			break
+		}
+	}
+	return resp, nil
+}
+
+// findPage 在指定表中按ID倒序查询一页消息
+func (m *customImMessageModel) findPage(ctx context.Context, table, where string, whereArgs []interface{}, lastMsgId, limit int64) ([]*ImMessage, error) {
+	var resp []*ImMessage
+	args := append([]interface{}{}, whereArgs...)
+	if lastMsgId > 0 {
+		where += " and `id` < ?"
+		args = append(args, lastMsgId)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf("select %s from %s where %s order by `id` desc limit ?", imMessageRows, table, where)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FindGroupMessagesBySeq 从指定Seq向前或向后读取群聊消息，按Seq倒序返回
+func (m *customImMessageModel) FindGroupMessagesBySeq(ctx context.Context, groupId string, cursor HistoryCursor, forward bool, limit int64) ([]*ImMessage, error) {
+	cmp, order := "<", "desc"
+	if forward {
+		cmp, order = ">", "asc"
+	}
+	return m.findWindow(ctx, cursor.CreatedAt, forward, limit, func(table string, limit int64) ([]*ImMessage, error) {
+		var resp []*ImMessage
+		query := fmt.Sprintf("select %s from %s where `chat_type` = 2 and `group_id` = ? and `seq` %s ? order by `seq` %s limit ?",
+			imMessageRows, table, cmp, order)
+		err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId, cursor.Seq, limit)
+		return resp, err
+	})
+}
+
+// FindGroupSeqByTime 指定时间在群消息时间线中的Seq位置
+// 群消息的Seq与发送时间同序，在 idx_group_seq 上二分查找，每次探测只读取一条索引记录
+// 时间早于热表中最早的消息时返回最早消息的Seq，向前读取时继续进入归档表
+func (m *customImMessageModel) FindGroupSeqByTime(ctx context.Context, groupId string, t time.Time) (uint64, error) {
+	var bounds struct {
+		MinSeq sql.NullInt64 `db:"min_seq"`
+		MaxSeq sql.NullInt64 `db:"max_seq"`
+	}
+	query := fmt.Sprintf("select min(seq) as min_seq, max(seq) as max_seq from %s where `chat_type` = 2 and `group_id` = ?", m.table)
+	if err := m.QueryRowNoCacheCtx(ctx, &bounds, query, groupId); err != nil {
+		return 0, err
+	}
+	if !bounds.MaxSeq.Valid {
+		// 热表中没有消息，归档的消息都早于该时间
+		maxSeq, err := m.findArchiveMaxSeq(ctx, "`chat_type` = 2 and `group_id` = ?", groupId)
+		return uint64(maxSeq) + 1, err
+	}
+
+	// 结果位于 [lo, hi]，hi 为最大Seq之后的位置
+	lo, hi := uint64(bounds.MinSeq.Int64), uint64(bounds.MaxSeq.Int64)+1
+	probe := fmt.Sprintf("select %s from %s where `chat_type` = 2 and `group_id` = ? and `seq` >= ? order by `seq` asc limit 1", imMessageRows, m.table)
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		var msg ImMessage
+		if err := m.QueryRowNoCacheCtx(ctx, &msg, probe, groupId, mid); err != nil {
+			return 0, err
+		}
+		if msg.CreatedAt.Before(t) {
+			lo = msg.Seq + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+// FindPrivateMessagesByTime 从指定时间向前或向后读取私聊消息，按时间倒序返回
+// 两个发送方向分别在 idx_conversation 上按时间顺序读取后合并，避免对整个会话排序
+func (m *customImMessageModel) FindPrivateMessagesByTime(ctx context.Context, userId, peerId int64, cursor HistoryCursor, forward bool, limit int64) ([]*ImMessage, error) {
+	cmp, order := "<", "desc"
+	if forward {
+		cmp, order = ">", "asc"
+	}
+	return m.findWindow(ctx, cursor.CreatedAt, forward, limit, func(table string, limit int64) ([]*ImMessage, error) {
+		query := fmt.Sprintf("select %s from %s where `chat_type` = 1 and `from_user_id` = ? and `to_user_id` = ? "+
+			"and `created_at` %s= ? and (`created_at` %s ? or `id` %s ?) order by `created_at` %s, `id` %s limit ?",
+			imMessageRows, table, cmp, cmp, cmp, order, order)
+
+		var resp []*ImMessage
+		for _, pair := range [][2]int64{{userId, peerId}, {peerId, userId}} {
+			var list []*ImMessage
+			err := m.QueryRowsNoCacheCtx(ctx, &list, query, pair[0], pair[1], cursor.CreatedAt, cursor.CreatedAt, cursor.Id, limit)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, list...)
+		}
+
+		sort.Slice(resp, func(i, j int) bool {
+			a, b := resp[i], resp[j]
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt) != forward
+			}
+			return (a.Id > b.Id) != forward
+		})
+		if int64(len(resp)) > limit {
+			resp = resp[:limit]
+		}
+		return resp, nil
+	})
+}
+
+// findWindow 从读取位置开始按时间顺序依次查询热表与归档表，直到读满 limit 条，结果按时间倒序返回
+// 归档表中的消息都早于热表：向前读取先查热表再按月份从新到旧查归档表，向后读取顺序相反
+// anchor 不为零值时跳过不可能包含结果的月份
+func (m *customImMessageModel) findWindow(ctx context.Context, anchor time.Time, forward bool, limit int64,
+	query func(table string, limit int64) ([]*ImMessage, error)) ([]*ImMessage, error) {
+	var resp []*ImMessage
+	if !forward {
+		page, err := query(m.table, limit)
+		if err != nil || int64(len(page)) >= limit {
+			return page, err
+		}
+		resp = page
+	}
+
+	archives, err := m.archiveTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	month := ""
+	if !anchor.IsZero() {
+		month = ArchiveTableName(anchor)
+	}
+
+	var tables []string
+	if forward {
+		for i := len(archives) - 1; i >= 0; i-- {
+			if month == "" || archives[i] >= month {
+				tables = append(tables, archives[i])
+			}
+		}
+		tables = append(tables, m.table)
+	} else {
+		for _, table := range archives {
+			if month == "" || table <= month {
+				tables = append(tables, table)
+			}
+		}
+	}
+
+	for _, table := range tables {
+		page, err := query(table, limit-int64(len(resp)))
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, page...)
+		if int64(len(resp)) >= limit {
+			break
+		}
+	}
+
+	if forward {
+		for i, j := 0, len(resp)-1; i < j; i, j = i+1, j-1 {
+			resp[i], resp[j] = resp[j], resp[i]
+		}
+	}
+	return resp, nil
+}
+
+// findArchiveMaxSeq 从归档表查询会话最大Seq（按月份从新到旧，找到即返回）
+func (m *customImMessageModel) findArchiveMaxSeq(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	tables, err := m.archiveTables(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, table := range tables {
+		var maxSeq sql.NullInt64
+		query := fmt.Sprintf("select max(seq) from %s where %s", table, where)
+		if err := m.QueryRowNoCacheCtx(ctx, &maxSeq, query, args...); err != nil {
+			return 0, err
+		}
+		if maxSeq.Valid {
+			return maxSeq.Int64, nil
+		}
+	}
+	return 0, nil
+}
+
+// archiveTables 查询已创建的归档表，按月份从新到旧排列
+func (m *customImMessageModel) archiveTables(ctx context.Context) ([]string, error) {
+	var tables []string
+	query := "select `table_name` from information_schema.tables where `table_schema` = database() and `table_name` like ? order by `table_name` desc"
+	err := m.QueryRowsNoCacheCtx(ctx, &tables, query, strings.ReplaceAll(archiveTablePrefix, "_", `\_`)+"%")
+	return tables, err
+}
+
+// FindArchivableMessages 查询早于指定时间、可以归档的消息
+// 未读/已读的阅后即焚消息在销毁前仍需由过期任务处理，暂不归档
+func (m *customImMessageModel) FindArchivableMessages(ctx context.Context, before time.Time, limit int64) ([]*ImMessage, error) {
+	var resp []*ImMessage
+	query := fmt.Sprintf("select %s from %s where `created_at` < ? and (`expire_mode` = 0 or `status` not in (0, 1)) order by `id` asc limit ?", imMessageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, before, limit)
+	return resp, err
+}
+
+// ArchiveMessages 将消息移入所属月份的归档表（归档表不存在时按热表结构创建）
+// 复制与删除在同一事务中完成，重复归档时忽略已存在的行
+func (m *customImMessageModel) ArchiveMessages(ctx context.Context, messages []*ImMessage) error {
+	byTable := make(map[string][]interface{})
+	var keys []string
+	for _, msg := range messages {
+		table := ArchiveTableName(msg.CreatedAt)
+		byTable[table] = append(byTable[table], msg.Id)
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, msg.Id),
+			fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, msg.MsgId))
+	}
+
+	for table, ids := range byTable {
+		if _, err := m.ExecNoCacheCtx(ctx, fmt.Sprintf("create table if not exists %s like %s", table, m.table)); err != nil {
+			return err
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+		err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+			query := fmt.Sprintf("insert ignore into %s (%s) select %s from %s where `id` in (%s)", table, imMessageRows, imMessageRows, m.table, placeholders)
+			if _, err := session.ExecCtx(ctx, query, ids...); err != nil {
+				return err
+			}
+			query = fmt.Sprintf("delete from %s where `id` in (%s)", m.table, placeholders)
+			_, err := session.ExecCtx(ctx, query, ids...)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+// PurgeMessages 清理早于指定时间的消息：清空内容并标记为已清理(5)，保留消息ID与Seq作为占位
+// 依次处理热表和归档表，单次最多清理 limit 条
+func (m *customImMessageModel) PurgeMessages(ctx context.Context, scope PurgeScope, before time.Time, limit int64) (int64, error) {
+	where := "`created_at` < ? and `status` <> 5"
+	args := []interface{}{before}
+	if scope.GroupId != "" {
+		where += " and `chat_type` = 2 and `group_id` = ?"
+		args = append(args, scope.GroupId)
+	} else if len(scope.ExcludeGroupIds) > 0 {
+		where += fmt.Sprintf(" and (`chat_type` = 1 or `group_id` not in (%s))", strings.TrimSuffix(strings.Repeat("?,", len(scope.ExcludeGroupIds)), ","))
+		args = append(args, convertStringsToInterfaces(scope.ExcludeGroupIds)...)
+	}
+
+	tables := []string{m.table}
+	var archives []string
+	if scope.GroupId != "" || m.purgeArchives {
+		var err error
+		if archives, err = m.archiveTables(ctx); err != nil {
+			return 0, err
+		}
+	}
+	for _, table := range archives {
+		// 归档表按月份拆分，整月都在保留期内的表无需扫描
+		month, err := time.ParseInLocation("200601", strings.TrimPrefix(table, archiveTablePrefix), before.Location())
+		if err == nil && !month.Before(before) {
+			continue
+		}
+		tables = append(tables, table)
+	}
+
+	var purged int64
+	for _, table := range tables {
+		if purged >= limit {
+			break
+		}
+		n, err := m.purgeTable(ctx, table, where, args, limit-purged)
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+	}
+	return purged, nil
+}
+
+// purgeTable 清理单张表中满足条件的消息，热表需要同时清理缓存
+func (m *customImMessageModel) purgeTable(ctx context.Context, table, where string, whereArgs []interface{}, limit int64) (int64, error) {
+	var rows []struct {
+		Id    uint64 `db:"id"`
+		MsgId string `db:"msg_id"`
+	}
+	query := fmt.Sprintf("select `id`, `msg_id` from %s where %s order by `id` asc limit ?", table, where)
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, append(append([]interface{}{}, whereArgs...), limit)...); err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]interface{}, 0, len(rows))
+	var keys []string
+	for _, row := range rows {
+		ids = append(ids, row.Id)
+		if table == m.table {
+			keys = append(keys,
+				fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, row.Id),
+				fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, row.MsgId))
+		}
+	}
+
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `content` = '', `at_user_ids` = null, `status` = 5 where `id` in (%s) and `status` <> 5",
+			table, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+		return conn.ExecCtx(ctx, query, ids...)
+	}, keys...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -49,13 +49,16 @@ type (
 		ToUserId    uint64         `db:"to_user_id"`   // 接收者ID（私聊时有效）
 		ChatType    int64          `db:"chat_type"`    // 聊天类型: 1-私聊 2-群聊
 		GroupId     sql.NullString `db:"group_id"`     // 群组ID（群聊时使用）
-		Seq         uint64         `db:"seq"`          // 消息序列号（用于群聊消息连续性校验和拉取偏移量）
+		Seq         uint64         `db:"seq"`          // 消息序列号（群聊为群内Seq，私聊为会话内Seq）
 		Content     string         `db:"content"`      // 消息内容
-		ContentType int64          `db:"content_type"` // 消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频
-		Status      int64          `db:"status"`       // 消息状态: 0-未读/未处理 1-已读 2-撤回 3-删除
+		ContentType int64          `db:"content_type"` // 消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片 10-群系统消息
+		Status      int64          `db:"status"`       // 消息状态: 0-未读/未处理 1-已读 2-撤回 3-删除 4-已销毁(阅后即焚) 5-已清理(超过保留期限)
 		CreatedAt   time.Time      `db:"created_at"`   // 创建时间
 		UpdatedAt   time.Time      `db:"updated_at"`   // 更新时间
 		AtUserIds   sql.NullString `db:"at_user_ids"`  // 被@的用户ID列表,JSON格式,如["123","456"],@all用特殊值"-1"
+		ExpireTtl   uint64         `db:"expire_ttl"`   // 阅后即焚时长(秒),0表示不过期
+		ExpireMode  int64          `db:"expire_mode"`  // 过期计时方式: 0-不过期 1-发送后计时 2-阅读后计时
+		ExpireAt    sql.NullTime   `db:"expire_at"`    // 过期时间(阅读后计时的消息在已读时设置)
 	}
 )
 
@@ -122,8 +125,8 @@ func (m *defaultImMessageModel) Insert(ctx context.Context, data *ImMessage) (sq
 	imAuthImMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, data.Id)
 	imAuthImMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, data.MsgId)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imMessageRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.MsgId, data.FromUserId, data.ToUserId, data.ChatType, data.GroupId, data.Seq, data.Content, data.ContentType, data.Status, data.AtUserIds)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imMessageRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MsgId, data.FromUserId, data.ToUserId, data.ChatType, data.GroupId, data.Seq, data.Content, data.ContentType, data.Status, data.AtUserIds, data.ExpireTtl, data.ExpireMode, data.ExpireAt)
 	}, imAuthImMessageIdKey, imAuthImMessageMsgIdKey)
 	return ret, err
 }
@@ -138,7 +141,7 @@ func (m *defaultImMessageModel) Update(ctx context.Context, newData *ImMessage)
 	imAuthImMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, data.MsgId)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imMessageRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.MsgId, newData.FromUserId, newData.ToUserId, newData.ChatType, newData.GroupId, newData.Seq, newData.Content, newData.ContentType, newData.Status, newData.AtUserIds, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.MsgId, newData.FromUserId, newData.ToUserId, newData.ChatType, newData.GroupId, newData.Seq, newData.Content, newData.ContentType, newData.Status, newData.AtUserIds, newData.ExpireTtl, newData.ExpireMode, newData.ExpireAt, newData.Id)
 	}, imAuthImMessageIdKey, imAuthImMessageMsgIdKey)
 	return err
 }
@@ -0,0 +1,504 @@
+package model
+
+// immessageshardmodel.go - 消息分片存储
+//
+// 按会话标识的 CRC32 取模，将消息路由到 N 张分片表 im_message_00 ~ im_message_{N-1}，
+// 分片表可以分布在多个数据库中（分片 i 使用第 i % len(Conns) 个数据库）：
+// 1. 单会话的读写（历史消息、Seq、未读、已读、阅后即焚）只访问会话所在的分片
+// 2. 跨会话的查询（全部未读、搜索、@我的消息、到期扫描、归档）并发查询所有分片后合并
+// 3. 消息ID由 im_message_id_alloc 表逐条分配，全局唯一且按分配顺序递增，与分片数量无关，重新分片时保持不变
+//    （历史消息分页、搜索和归档都依赖消息ID与发送顺序一致，因此不能按实例预取号段）
+//
+// 已有数据迁移与一致性校验见 app/message/tools/reshard
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImMessageModel = (*shardedImMessageModel)(nil)
+
+const (
+	// shardTablePrefix 分片表前缀
+	shardTablePrefix = "im_message_"
+	// idAllocTable 消息ID分配表
+	idAllocTable = "`im_message_id_alloc`"
+	// idAllocBiz 分配表中消息ID对应的业务标识
+	idAllocBiz = "im_message"
+)
+
+type (
+	// ShardOptions 消息分片配置
+	ShardOptions struct {
+		Count  int            // 分片数量
+		Conns  []sqlx.SqlConn // 分片所在数据库，分片 i 使用 Conns[i % len(Conns)]
+		IdConn sqlx.SqlConn   // 消息ID分配表所在数据库
+	}
+
+	shardedImMessageModel struct {
+		shards []*customImMessageModel
+		ids    *idAllocator
+	}
+
+	// insertResult 分片写入结果，LastInsertId 返回分配的消息ID
+	insertResult struct {
+		sql.Result
+		id int64
+	}
+)
+
+// NewShardedImMessageModel returns a model that routes messages to shard tables by conversation.
+func NewShardedImMessageModel(o ShardOptions, c cache.CacheConf, opts ...cache.Option) ImMessageModel {
+	m := &shardedImMessageModel{
+		shards: make([]*customImMessageModel, o.Count),
+		ids:    &idAllocator{conn: o.IdConn},
+	}
+	for i := range m.shards {
+		shard := newImMessageModel(o.Conns[i%len(o.Conns)], c, opts...)
+		shard.table = "`" + ShardTableName(i) + "`"
+		m.shards[i] = &customImMessageModel{
+			defaultImMessageModel: shard,
+			// 每个数据库的第一个分片负责清理该库的归档表
+			purgeArchives: i < len(o.Conns),
+		}
+	}
+	return m
+}
+
+// ShardTableName 分片表名
+func ShardTableName(index int) string {
+	return fmt.Sprintf("%s%02d", shardTablePrefix, index)
+}
+
+// ShardIndex 会话所在的分片
+func ShardIndex(conversationKey string, count int) int {
+	return int(crc32.ChecksumIEEE([]byte(conversationKey)) % uint32(count))
+}
+
+// GroupConversationKey 生成群聊会话标识
+func GroupConversationKey(groupId string) string {
+	return "g_" + groupId
+}
+
+// ConversationKey 消息所属会话标识（私聊与发送方向无关）
+func ConversationKey(data *ImMessage) string {
+	if data.ChatType == 2 {
+		return GroupConversationKey(data.GroupId.String)
+	}
+	return PrivateConversationKey(int64(data.FromUserId), int64(data.ToUserId))
+}
+
+func (r insertResult) LastInsertId() (int64, error) {
+	return r.id, nil
+}
+
+// Insert 分配消息ID后写入会话所在的分片
+func (m *shardedImMessageModel) Insert(ctx context.Context, data *ImMessage) (sql.Result, error) {
+	id, err := m.ids.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Id = id
+
+	result, err := m.route(data).insertWithId(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return insertResult{Result: result, id: int64(id)}, nil
+}
+
+// FindOne 按消息ID查询（ID不包含分片信息，需要查询所有分片；已知会话时使用 FindOneInConversation）
+func (m *shardedImMessageModel) FindOne(ctx context.Context, id uint64) (*ImMessage, error) {
+	return m.findOneNoCache(ctx, "id", id)
+}
+
+// FindOneByMsgId 按 msg_id 查询（需要查询所有分片；已知会话时使用 FindOneByMsgIdInConversation）
+func (m *shardedImMessageModel) FindOneByMsgId(ctx context.Context, msgId string) (*ImMessage, error) {
+	return m.findOneNoCache(ctx, "msg_id", msgId)
+}
+
+// FindOneInConversation 按消息ID查询，只访问会话所在的分片
+func (m *shardedImMessageModel) FindOneInConversation(ctx context.Context, conversationKey string, id uint64) (*ImMessage, error) {
+	return m.conversation(conversationKey).FindOne(ctx, id)
+}
+
+// FindOneByMsgIdInConversation 按 msg_id 查询，只访问会话所在的分片
+func (m *shardedImMessageModel) FindOneByMsgIdInConversation(ctx context.Context, conversationKey, msgId string) (*ImMessage, error) {
+	return m.conversation(conversationKey).FindOneByMsgId(ctx, msgId)
+}
+
+func (m *shardedImMessageModel) Update(ctx context.Context, data *ImMessage) error {
+	return m.route(data).Update(ctx, data)
+}
+
+func (m *shardedImMessageModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+	return m.route(data).Delete(ctx, id)
+}
+
+func (m *shardedImMessageModel) FindGroupMessageList(ctx context.Context, groupId string, lastMsgId, limit int64) ([]*ImMessage, error) {
+	return m.group(groupId).FindGroupMessageList(ctx, groupId, lastMsgId, limit)
+}
+
+func (m *shardedImMessageModel) FindPrivateMessageList(ctx context.Context, userId, peerId, lastMsgId, limit int64) ([]*ImMessage, error) {
+	return m.private(userId, peerId).FindPrivateMessageList(ctx, userId, peerId, lastMsgId, limit)
+}
+
+func (m *shardedImMessageModel) FindUnreadMessages(ctx context.Context, userId, peerId int64) ([]*ImMessage, error) {
+	return m.private(userId, peerId).FindUnreadMessages(ctx, userId, peerId)
+}
+
+// FindAllUnreadMessages 查询所有分片后按创建时间正序合并
+func (m *shardedImMessageModel) FindAllUnreadMessages(ctx context.Context, userId int64) ([]*ImMessage, error) {
+	resp, err := m.gather(func(shard *customImMessageModel) ([]*ImMessage, error) {
+		return shard.FindAllUnreadMessages(ctx, userId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+	})
+	return resp, nil
+}
+
+// CountUnreadMessages 指定会话时只查询会话所在的分片，否则汇总所有分片
+func (m *shardedImMessageModel) CountUnreadMessages(ctx context.Context, userId, peerId int64) (int64, error) {
+	if peerId > 0 {
+		return m.private(userId, peerId).CountUnreadMessages(ctx, userId, peerId)
+	}
+
+	counts := make([]int64, len(m.shards))
+	err := m.each(func(i int, shard *customImMessageModel) error {
+		var err error
+		counts[i], err = shard.CountUnreadMessages(ctx, userId, 0)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	return total, nil
+}
+
+// CountUnreadByPeer 汇总所有分片（每个私聊会话只存在于一个分片）
+func (m *shardedImMessageModel) CountUnreadByPeer(ctx context.Context, userId int64) ([]*PeerUnreadCount, error) {
+	results := make([][]*PeerUnreadCount, len(m.shards))
+	err := m.each(func(i int, shard *customImMessageModel) error {
+		var err error
+		results[i], err = shard.CountUnreadByPeer(ctx, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*PeerUnreadCount
+	for _, list := range results {
+		resp = append(resp, list...)
+	}
+	return resp, nil
+}
+
+func (m *shardedImMessageModel) CountGroupUnread(ctx context.Context, groupId string, userId int64, readSeq uint64, joinedAt time.Time) (*GroupUnreadCount, error) {
+	return m.group(groupId).CountGroupUnread(ctx, groupId, userId, readSeq, joinedAt)
+}
+
+func (m *shardedImMessageModel) MarkMessagesAsRead(ctx context.Context, userId, peerId int64, msgIds []string) (int64, error) {
+	return m.private(userId, peerId).MarkMessagesAsRead(ctx, userId, peerId, msgIds)
+}
+
+func (m *shardedImMessageModel) FindGroupMessagesAfterSeq(ctx context.Context, groupId string, seq uint64, limit int64) ([]*ImMessage, error) {
+	return m.group(groupId).FindGroupMessagesAfterSeq(ctx, groupId, seq, limit)
+}
+
+func (m *shardedImMessageModel) FindGroupMessagesBySeq(ctx context.Context, groupId string, cursor HistoryCursor, forward bool, limit int64) ([]*ImMessage, error) {
+	return m.group(groupId).FindGroupMessagesBySeq(ctx, groupId, cursor, forward, limit)
+}
+
+func (m *shardedImMessageModel) FindGroupSeqByTime(ctx context.Context, groupId string, t time.Time) (uint64, error) {
+	return m.group(groupId).FindGroupSeqByTime(ctx, groupId, t)
+}
+
+func (m *shardedImMessageModel) FindPrivateMessagesByTime(ctx context.Context, userId, peerId int64, cursor HistoryCursor, forward bool, limit int64) ([]*ImMessage, error) {
+	return m.private(userId, peerId).FindPrivateMessagesByTime(ctx, userId, peerId, cursor, forward, limit)
+}
+
+func (m *shardedImMessageModel) FindPrivateMessagesAfterSeq(ctx context.Context, userId, peerId int64, seq uint64, limit int64) ([]*ImMessage, error) {
+	return m.private(userId, peerId).FindPrivateMessagesAfterSeq(ctx, userId, peerId, seq, limit)
+}
+
+// SearchMessages 只搜索单个会话时查询会话所在的分片，否则查询所有分片后按ID倒序合并
+func (m *shardedImMessageModel) SearchMessages(ctx context.Context, cond *MessageSearchCond) ([]*ImMessage, error) {
+	switch {
+	case cond.IncludePrivate && cond.PeerId > 0 && len(cond.Groups) == 0:
+		return m.private(cond.UserId, cond.PeerId).SearchMessages(ctx, cond)
+	case !cond.IncludePrivate && len(cond.Groups) == 1:
+		return m.group(cond.Groups[0].GroupId).SearchMessages(ctx, cond)
+	}
+
+	resp, err := m.gather(func(shard *customImMessageModel) ([]*ImMessage, error) {
+		return shard.SearchMessages(ctx, cond)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newestFirst(resp, cond.Limit), nil
+}
+
+func (m *shardedImMessageModel) FindGroupMaxSeq(ctx context.Context, groupId string) (int64, error) {
+	return m.group(groupId).FindGroupMaxSeq(ctx, groupId)
+}
+
+func (m *shardedImMessageModel) FindPrivateMaxSeq(ctx context.Context, userId, peerId int64) (int64, error) {
+	return m.private(userId, peerId).FindPrivateMaxSeq(ctx, userId, peerId)
+}
+
+// FindAtMeMessages 指定群组时只查询群所在的分片，否则查询所有分片后按ID倒序合并
+func (m *shardedImMessageModel) FindAtMeMessages(ctx context.Context, userId int64, groupId string, lastMsgId int64, limit int32) ([]*ImMessage, error) {
+	if groupId != "" {
+		return m.group(groupId).FindAtMeMessages(ctx, userId, groupId, lastMsgId, limit)
+	}
+
+	if limit <= 0 {
+		limit = 20
+	}
+	resp, err := m.gather(func(shard *customImMessageModel) ([]*ImMessage, error) {
+		return shard.FindAtMeMessages(ctx, userId, groupId, lastMsgId, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newestFirst(resp, int64(limit)), nil
+}
+
+func (m *shardedImMessageModel) StartReadExpiry(ctx context.Context, userId, peerId int64, msgIds []string) (int64, error) {
+	return m.private(userId, peerId).StartReadExpiry(ctx, userId, peerId, msgIds)
+}
+
+// FindExpiredMessages 查询所有分片后按过期时间正序合并
+func (m *shardedImMessageModel) FindExpiredMessages(ctx context.Context, now time.Time, limit int64) ([]*ImMessage, error) {
+	resp, err := m.gather(func(shard *customImMessageModel) ([]*ImMessage, error) {
+		return shard.FindExpiredMessages(ctx, now, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].ExpireAt.Time.Before(resp[j].ExpireAt.Time)
+	})
+	if int64(len(resp)) > limit {
+		resp = resp[:limit]
+	}
+	return resp, nil
+}
+
+func (m *shardedImMessageModel) BurnMessage(ctx context.Context, data *ImMessage) (bool, error) {
+	return m.route(data).BurnMessage(ctx, data)
+}
+
+func (m *shardedImMessageModel) RemoveMessage(ctx context.Context, data *ImMessage) (bool, error) {
+	return m.route(data).RemoveMessage(ctx, data)
+}
+
+// FindArchivableMessages 查询所有分片后按ID正序合并
+func (m *shardedImMessageModel) FindArchivableMessages(ctx context.Context, before time.Time, limit int64) ([]*ImMessage, error) {
+	resp, err := m.gather(func(shard *customImMessageModel) ([]*ImMessage, error) {
+		return shard.FindArchivableMessages(ctx, before, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Id < resp[j].Id
+	})
+	if int64(len(resp)) > limit {
+		resp = resp[:limit]
+	}
+	return resp, nil
+}
+
+// ArchiveMessages 按分片拆分后分别归档
+func (m *shardedImMessageModel) ArchiveMessages(ctx context.Context, messages []*ImMessage) error {
+	byShard := make(map[*customImMessageModel][]*ImMessage)
+	for _, msg := range messages {
+		shard := m.route(msg)
+		byShard[shard] = append(byShard[shard], msg)
+	}
+	for shard, list := range byShard {
+		if err := shard.ArchiveMessages(ctx, list); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeMessages 指定群组时只清理群所在的分片，否则每个分片各清理最多 limit 条
+func (m *shardedImMessageModel) PurgeMessages(ctx context.Context, scope PurgeScope, before time.Time, limit int64) (int64, error) {
+	if scope.GroupId != "" {
+		return m.group(scope.GroupId).PurgeMessages(ctx, scope, before, limit)
+	}
+
+	counts := make([]int64, len(m.shards))
+	err := m.each(func(i int, shard *customImMessageModel) error {
+		var err error
+		counts[i], err = shard.PurgeMessages(ctx, scope, before, limit)
+		return err
+	})
+
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	return total, err
+}
+
+// QueryRowsNoCacheCtx 原始SQL无法按会话路由，在第一个分片所在的数据库执行
+func (m *shardedImMessageModel) QueryRowsNoCacheCtx(ctx context.Context, v interface{}, query string, args ...interface{}) error {
+	return m.shards[0].QueryRowsNoCacheCtx(ctx, v, query, args...)
+}
+
+// QueryRowNoCacheCtx 原始SQL无法按会话路由，在第一个分片所在的数据库执行
+func (m *shardedImMessageModel) QueryRowNoCacheCtx(ctx context.Context, v interface{}, query string, args ...interface{}) error {
+	return m.shards[0].QueryRowNoCacheCtx(ctx, v, query, args...)
+}
+
+// ExecNoCacheCtx 原始SQL无法按会话路由，在第一个分片所在的数据库执行
+func (m *shardedImMessageModel) ExecNoCacheCtx(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return m.shards[0].ExecNoCacheCtx(ctx, query, args...)
+}
+
+func (m *shardedImMessageModel) route(data *ImMessage) *customImMessageModel {
+	return m.shards[ShardIndex(ConversationKey(data), len(m.shards))]
+}
+
+func (m *shardedImMessageModel) conversation(key string) *customImMessageModel {
+	return m.shards[ShardIndex(key, len(m.shards))]
+}
+
+func (m *shardedImMessageModel) group(groupId string) *customImMessageModel {
+	return m.conversation(GroupConversationKey(groupId))
+}
+
+func (m *shardedImMessageModel) private(userId, peerId int64) *customImMessageModel {
+	return m.conversation(PrivateConversationKey(userId, peerId))
+}
+
+// each 并发在所有分片上执行 fn，返回第一个错误
+func (m *shardedImMessageModel) each(fn func(i int, shard *customImMessageModel) error) error {
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i, shard := range m.shards {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(i, shard); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// gather 并发查询所有分片并合并结果（未排序）
+func (m *shardedImMessageModel) gather(fn func(shard *customImMessageModel) ([]*ImMessage, error)) ([]*ImMessage, error) {
+	results := make([][]*ImMessage, len(m.shards))
+	err := m.each(func(i int, shard *customImMessageModel) error {
+		var err error
+		results[i], err = fn(shard)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*ImMessage
+	for _, list := range results {
+		resp = append(resp, list...)
+	}
+	return resp, nil
+}
+
+// findOneNoCache 在所有分片中按唯一列查询
+// 不使用行缓存：在不包含该消息的分片上查询会缓存"不存在"，影响其他分片
+func (m *shardedImMessageModel) findOneNoCache(ctx context.Context, column string, value any) (*ImMessage, error) {
+	resp, err := m.gather(func(shard *customImMessageModel) ([]*ImMessage, error) {
+		var list []*ImMessage
+		query := fmt.Sprintf("select %s from %s where `%s` = ? limit 1", imMessageRows, shard.table, column)
+		err := shard.QueryRowsNoCacheCtx(ctx, &list, query, value)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, ErrNotFound
+	}
+	return resp[0], nil
+}
+
+// newestFirst 按ID倒序排列并截取前 limit 条
+func newestFirst(messages []*ImMessage, limit int64) []*ImMessage {
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Id > messages[j].Id
+	})
+	if limit > 0 && int64(len(messages)) > limit {
+		messages = messages[:limit]
+	}
+	return messages
+}
+
+// insertWithId 使用已分配的消息ID写入
+func (m *customImMessageModel) insertWithId(ctx context.Context, data *ImMessage) (sql.Result, error) {
+	imAuthImMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageIdPrefix, data.Id)
+	imAuthImMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImMessageMsgIdPrefix, data.MsgId)
+	return m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("insert into %s (`id`,%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imMessageRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Id, data.MsgId, data.FromUserId, data.ToUserId, data.ChatType, data.GroupId, data.Seq, data.Content, data.ContentType, data.Status, data.AtUserIds, data.ExpireTtl, data.ExpireMode, data.ExpireAt)
+	}, imAuthImMessageIdKey, imAuthImMessageMsgIdKey)
+}
+
+// idAllocator 消息ID分配器
+// 每条消息单独从分配表递增取号（与自增主键一致），多实例部署时ID全局唯一且按分配顺序递增
+type idAllocator struct {
+	conn sqlx.SqlConn
+}
+
+// Next 分配一个消息ID
+// 通过 LAST_INSERT_ID(expr) 在一条语句内完成递增和取值，无需事务
+func (a *idAllocator) Next(ctx context.Context) (uint64, error) {
+	result, err := a.conn.ExecCtx(ctx, fmt.Sprintf("update %s set `max_id` = last_insert_id(`max_id` + 1) where `biz` = ?", idAllocTable), idAllocBiz)
+	if err != nil {
+		return 0, err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return 0, err
+	} else if affected == 0 {
+		return 0, errors.New("消息ID分配表未初始化: im_message_id_alloc")
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(id), nil
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImModerationReviewModel = (*customImModerationReviewModel)(nil)
+
+// 审核状态
+const (
+	ReviewStatusPending  = 0 // 待审核
+	ReviewStatusApproved = 1 // 已通过（保留消息）
+	ReviewStatusRemoved  = 2 // 已删除消息
+)
+
+type (
+	// ImModerationReviewModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImModerationReviewModel.
+	ImModerationReviewModel interface {
+		imModerationReviewModel
+		// 按ID倒序分页查询审核记录，groupId 为空时查询全部会话
+		FindPage(ctx context.Context, groupId string, status int64, lastId uint64, limit int64) ([]*ImModerationReview, error)
+		// 处理待审核记录（返回是否由本次调用完成处理）
+		Resolve(ctx context.Context, data *ImModerationReview, status int64, reviewerId uint64, now time.Time) (bool, error)
+	}
+
+	customImModerationReviewModel struct {
+		*defaultImModerationReviewModel
+	}
+)
+
+// NewImModerationReviewModel returns a model for the database table.
+func NewImModerationReviewModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImModerationReviewModel {
+	return &customImModerationReviewModel{
+		defaultImModerationReviewModel: newImModerationReviewModel(conn, c, opts...),
+	}
+}
+
+// FindPage 按ID倒序分页查询指定状态的审核记录（idx_group_status / idx_status）
+func (m *customImModerationReviewModel) FindPage(ctx context.Context, groupId string, status int64, lastId uint64, limit int64) ([]*ImModerationReview, error) {
+	where := "`status` = ?"
+	args := []interface{}{status}
+	if groupId != "" {
+		where = "`group_id` = ? and " + where
+		args = append([]interface{}{groupId}, args...)
+	}
+	if lastId > 0 {
+		where += " and `id` < ?"
+		args = append(args, lastId)
+	}
+	args = append(args, limit)
+
+	var resp []*ImModerationReview
+	query := fmt.Sprintf("select %s from %s where %s order by `id` desc limit ?", imModerationReviewRows, m.table, where)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// Resolve 将待审核记录更新为通过或删除，多个审核人同时处理时只有一个能成功
+func (m *customImModerationReviewModel) Resolve(ctx context.Context, data *ImModerationReview, status int64, reviewerId uint64, now time.Time) (bool, error) {
+	imAuthImModerationReviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewIdPrefix, data.Id)
+	imAuthImModerationReviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewMsgIdPrefix, data.MsgId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `reviewer_id` = ?, `reviewed_at` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, status, reviewerId, now, data.Id, ReviewStatusPending)
+	}, imAuthImModerationReviewIdKey, imAuthImModerationReviewMsgIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,159 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imModerationReviewFieldNames          = builder.RawFieldNames(&ImModerationReview{})
+	imModerationReviewRows                = strings.Join(imModerationReviewFieldNames, ",")
+	imModerationReviewRowsExpectAutoSet   = strings.Join(stringx.Remove(imModerationReviewFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imModerationReviewRowsWithPlaceHolder = strings.Join(stringx.Remove(imModerationReviewFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImModerationReviewIdPrefix    = "cache:imAuth:imModerationReview:id:"
+	cacheImAuthImModerationReviewMsgIdPrefix = "cache:imAuth:imModerationReview:msgId:"
+)
+
+type (
+	imModerationReviewModel interface {
+		Insert(ctx context.Context, data *ImModerationReview) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImModerationReview, error)
+		FindOneByMsgId(ctx context.Context, msgId string) (*ImModerationReview, error)
+		Update(ctx context.Context, data *ImModerationReview) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImModerationReviewModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImModerationReview struct {
+		Id          uint64       `db:"id"`           // 自增主键ID
+		MsgId       string       `db:"msg_id"`       // 送审消息唯一标识
+		RootMsgId   string       `db:"root_msg_id"`  // 话题根消息唯一标识(话题回复时有效)
+		ChatType    int64        `db:"chat_type"`    // 聊天类型: 1-私聊 2-群聊
+		FromUserId  uint64       `db:"from_user_id"` // 发送者ID
+		ToUserId    uint64       `db:"to_user_id"`   // 接收者ID(私聊时有效)
+		GroupId     string       `db:"group_id"`     // 群组ID(群聊时有效)
+		Content     string       `db:"content"`      // 消息内容(已替换的敏感词保持替换后的内容)
+		ContentType int64        `db:"content_type"` // 消息内容类型
+		HitWords    string       `db:"hit_words"`    // 命中的敏感词,JSON格式
+		Status      int64        `db:"status"`       // 审核状态: 0-待审核 1-已通过 2-已删除
+		ReviewerId  uint64       `db:"reviewer_id"`  // 审核人ID
+		ReviewedAt  sql.NullTime `db:"reviewed_at"`  // 审核时间
+		CreatedAt   time.Time    `db:"created_at"`   // 创建时间
+		UpdatedAt   time.Time    `db:"updated_at"`   // 更新时间
+	}
+)
+
+func newImModerationReviewModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImModerationReviewModel {
+	return &defaultImModerationReviewModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_moderation_review`",
+	}
+}
+
+func (m *defaultImModerationReviewModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImModerationReviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewIdPrefix, id)
+	imAuthImModerationReviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImModerationReviewIdKey, imAuthImModerationReviewMsgIdKey)
+	return err
+}
+
+func (m *defaultImModerationReviewModel) FindOne(ctx context.Context, id uint64) (*ImModerationReview, error) {
+	imAuthImModerationReviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewIdPrefix, id)
+	var resp ImModerationReview
+	err := m.QueryRowCtx(ctx, &resp, imAuthImModerationReviewIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imModerationReviewRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImModerationReviewModel) FindOneByMsgId(ctx context.Context, msgId string) (*ImModerationReview, error) {
+	imAuthImModerationReviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewMsgIdPrefix, msgId)
+	var resp ImModerationReview
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImModerationReviewMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `msg_id` = ? limit 1", imModerationReviewRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImModerationReviewModel) Insert(ctx context.Context, data *ImModerationReview) (sql.Result, error) {
+	imAuthImModerationReviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewIdPrefix, data.Id)
+	imAuthImModerationReviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewMsgIdPrefix, data.MsgId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imModerationReviewRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MsgId, data.RootMsgId, data.ChatType, data.FromUserId, data.ToUserId, data.GroupId, data.Content, data.ContentType, data.HitWords, data.Status, data.ReviewerId, data.ReviewedAt)
+	}, imAuthImModerationReviewIdKey, imAuthImModerationReviewMsgIdKey)
+	return ret, err
+}
+
+func (m *defaultImModerationReviewModel) Update(ctx context.Context, newData *ImModerationReview) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImModerationReviewIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewIdPrefix, data.Id)
+	imAuthImModerationReviewMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImModerationReviewMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imModerationReviewRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.MsgId, newData.RootMsgId, newData.ChatType, newData.FromUserId, newData.ToUserId, newData.GroupId, newData.Content, newData.ContentType, newData.HitWords, newData.Status, newData.ReviewerId, newData.ReviewedAt, newData.Id)
+	}, imAuthImModerationReviewIdKey, imAuthImModerationReviewMsgIdKey)
+	return err
+}
+
+func (m *defaultImModerationReviewModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImModerationReviewIdPrefix, primary)
+}
+
+func (m *defaultImModerationReviewModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imModerationReviewRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImModerationReviewModel) tableName() string {
+	return m.table
+}
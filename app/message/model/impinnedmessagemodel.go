@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImPinnedMessageModel = (*customImPinnedMessageModel)(nil)
+
+type (
+	// ImPinnedMessageModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImPinnedMessageModel.
+	ImPinnedMessageModel interface {
+		imPinnedMessageModel
+		// 查询会话的置顶消息，最近置顶的在前
+		FindByConversation(ctx context.Context, conversationKey string) ([]*ImPinnedMessage, error)
+		// 统计会话的置顶消息数量
+		CountByConversation(ctx context.Context, conversationKey string) (int64, error)
+	}
+
+	customImPinnedMessageModel struct {
+		*defaultImPinnedMessageModel
+	}
+)
+
+// NewImPinnedMessageModel returns a model for the database table.
+func NewImPinnedMessageModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImPinnedMessageModel {
+	return &customImPinnedMessageModel{
+		defaultImPinnedMessageModel: newImPinnedMessageModel(conn, c, opts...),
+	}
+}
+
+// FindByConversation 查询会话的置顶消息
+func (m *customImPinnedMessageModel) FindByConversation(ctx context.Context, conversationKey string) ([]*ImPinnedMessage, error) {
+	var resp []*ImPinnedMessage
+	query := fmt.Sprintf("select %s from %s where `conversation_key` = ? order by `id` desc", imPinnedMessageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, conversationKey)
+	return resp, err
+}
+
+// CountByConversation 统计会话的置顶消息数量
+func (m *customImPinnedMessageModel) CountByConversation(ctx context.Context, conversationKey string) (int64, error) {
+	var count int64
+	query := fmt.Sprintf("select count(*) from %s where `conversation_key` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, conversationKey)
+	return count, err
+}
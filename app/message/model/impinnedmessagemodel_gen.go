@@ -0,0 +1,157 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imPinnedMessageFieldNames          = builder.RawFieldNames(&ImPinnedMessage{})
+	imPinnedMessageRows                = strings.Join(imPinnedMessageFieldNames, ",")
+	imPinnedMessageRowsExpectAutoSet   = strings.Join(stringx.Remove(imPinnedMessageFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imPinnedMessageRowsWithPlaceHolder = strings.Join(stringx.Remove(imPinnedMessageFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImPinnedMessageIdPrefix                   = "cache:imAuth:imPinnedMessage:id:"
+	cacheImAuthImPinnedMessageConversationKeyMsgIdPrefix = "cache:imAuth:imPinnedMessage:conversationKey:msgId:"
+)
+
+type (
+	imPinnedMessageModel interface {
+		Insert(ctx context.Context, data *ImPinnedMessage) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImPinnedMessage, error)
+		FindOneByConversationKeyMsgId(ctx context.Context, conversationKey string, msgId string) (*ImPinnedMessage, error)
+		Update(ctx context.Context, data *ImPinnedMessage) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImPinnedMessageModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImPinnedMessage struct {
+		Id              uint64    `db:"id"`               // 自增主键ID
+		ConversationKey string    `db:"conversation_key"` // 会话标识(私聊为"小ID_大ID",群聊为"g_群组ID")
+		ChatType        int64     `db:"chat_type"`        // 聊天类型: 1-私聊 2-群聊
+		GroupId         string    `db:"group_id"`         // 群组ID(群聊时有效)
+		MsgId           string    `db:"msg_id"`           // 被置顶消息的唯一标识
+		MessageId       uint64    `db:"message_id"`       // 被置顶消息的数据库ID(用于跳转定位)
+		Seq             uint64    `db:"seq"`              // 被置顶消息的Seq
+		FromUserId      uint64    `db:"from_user_id"`     // 原消息发送者ID
+		ContentType     int64     `db:"content_type"`     // 消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片
+		Content         string    `db:"content"`          // 消息内容快照(原消息归档后仍可展示)
+		MsgCreatedAt    time.Time `db:"msg_created_at"`   // 原消息发送时间
+		PinnedBy        uint64    `db:"pinned_by"`        // 置顶操作者ID
+		CreatedAt       time.Time `db:"created_at"`       // 置顶时间
+	}
+)
+
+func newImPinnedMessageModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImPinnedMessageModel {
+	return &defaultImPinnedMessageModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_pinned_message`",
+	}
+}
+
+func (m *defaultImPinnedMessageModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImPinnedMessageConversationKeyMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPinnedMessageConversationKeyMsgIdPrefix, data.ConversationKey, data.MsgId)
+	imAuthImPinnedMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImPinnedMessageIdPrefix, id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImPinnedMessageConversationKeyMsgIdKey, imAuthImPinnedMessageIdKey)
+	return err
+}
+
+func (m *defaultImPinnedMessageModel) FindOne(ctx context.Context, id uint64) (*ImPinnedMessage, error) {
+	imAuthImPinnedMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImPinnedMessageIdPrefix, id)
+	var resp ImPinnedMessage
+	err := m.QueryRowCtx(ctx, &resp, imAuthImPinnedMessageIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imPinnedMessageRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImPinnedMessageModel) FindOneByConversationKeyMsgId(ctx context.Context, conversationKey string, msgId string) (*ImPinnedMessage, error) {
+	imAuthImPinnedMessageConversationKeyMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPinnedMessageConversationKeyMsgIdPrefix, conversationKey, msgId)
+	var resp ImPinnedMessage
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImPinnedMessageConversationKeyMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `conversation_key` = ? and `msg_id` = ? limit 1", imPinnedMessageRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, conversationKey, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImPinnedMessageModel) Insert(ctx context.Context, data *ImPinnedMessage) (sql.Result, error) {
+	imAuthImPinnedMessageConversationKeyMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPinnedMessageConversationKeyMsgIdPrefix, data.ConversationKey, data.MsgId)
+	imAuthImPinnedMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImPinnedMessageIdPrefix, data.Id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imPinnedMessageRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ConversationKey, data.ChatType, data.GroupId, data.MsgId, data.MessageId, data.Seq, data.FromUserId, data.ContentType, data.Content, data.MsgCreatedAt, data.PinnedBy)
+	}, imAuthImPinnedMessageConversationKeyMsgIdKey, imAuthImPinnedMessageIdKey)
+	return ret, err
+}
+
+func (m *defaultImPinnedMessageModel) Update(ctx context.Context, newData *ImPinnedMessage) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImPinnedMessageConversationKeyMsgIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPinnedMessageConversationKeyMsgIdPrefix, data.ConversationKey, data.MsgId)
+	imAuthImPinnedMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImPinnedMessageIdPrefix, data.Id)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imPinnedMessageRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.ConversationKey, newData.ChatType, newData.GroupId, newData.MsgId, newData.MessageId, newData.Seq, newData.FromUserId, newData.ContentType, newData.Content, newData.MsgCreatedAt, newData.PinnedBy, newData.Id)
+	}, imAuthImPinnedMessageConversationKeyMsgIdKey, imAuthImPinnedMessageIdKey)
+	return err
+}
+
+func (m *defaultImPinnedMessageModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImPinnedMessageIdPrefix, primary)
+}
+
+func (m *defaultImPinnedMessageModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imPinnedMessageRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImPinnedMessageModel) tableName() string {
+	return m.table
+}
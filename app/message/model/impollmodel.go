@@ -0,0 +1,69 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImPollModel = (*customImPollModel)(nil)
+
+// 投票状态
+const (
+	PollStatusOpen   = 0 // 进行中
+	PollStatusClosed = 1 // 已结束
+)
+
+type (
+	// ImPollModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImPollModel.
+	ImPollModel interface {
+		imPollModel
+		// 查询已到截止时间但仍在进行中的投票
+		FindDue(ctx context.Context, now time.Time, limit int64) ([]*ImPoll, error)
+		// 结束进行中的投票，返回是否由本次调用结束
+		Close(ctx context.Context, data *ImPoll, now time.Time) (bool, error)
+	}
+
+	customImPollModel struct {
+		*defaultImPollModel
+	}
+)
+
+// NewImPollModel returns a model for the database table.
+func NewImPollModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImPollModel {
+	return &customImPollModel{
+		defaultImPollModel: newImPollModel(conn, c, opts...),
+	}
+}
+
+// FindDue 查询已到截止时间但仍在进行中的投票
+func (m *customImPollModel) FindDue(ctx context.Context, now time.Time, limit int64) ([]*ImPoll, error) {
+	var resp []*ImPoll
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `deadline` <= ? order by `deadline` asc limit ?", imPollRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, PollStatusOpen, now, limit)
+	return resp, err
+}
+
+// Close 结束进行中的投票，多实例并发扫描时只有一个实例能结束成功
+func (m *customImPollModel) Close(ctx context.Context, data *ImPoll, now time.Time) (bool, error) {
+	imAuthImPollIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollIdPrefix, data.Id)
+	imAuthImPollMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollMsgIdPrefix, data.MsgId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `closed_at` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, PollStatusClosed, now, data.Id, PollStatusOpen)
+	}, imAuthImPollIdKey, imAuthImPollMsgIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,157 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imPollFieldNames          = builder.RawFieldNames(&ImPoll{})
+	imPollRows                = strings.Join(imPollFieldNames, ",")
+	imPollRowsExpectAutoSet   = strings.Join(stringx.Remove(imPollFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imPollRowsWithPlaceHolder = strings.Join(stringx.Remove(imPollFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImPollIdPrefix    = "cache:imAuth:imPoll:id:"
+	cacheImAuthImPollMsgIdPrefix = "cache:imAuth:imPoll:msgId:"
+)
+
+type (
+	imPollModel interface {
+		Insert(ctx context.Context, data *ImPoll) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImPoll, error)
+		FindOneByMsgId(ctx context.Context, msgId string) (*ImPoll, error)
+		Update(ctx context.Context, data *ImPoll) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImPollModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImPoll struct {
+		Id        uint64       `db:"id"`         // 自增主键ID
+		MsgId     string       `db:"msg_id"`     // 投票消息的唯一标识(im_message.msg_id)
+		GroupId   string       `db:"group_id"`   // 群组ID
+		CreatorId uint64       `db:"creator_id"` // 发起人ID
+		Question  string       `db:"question"`   // 投票主题
+		Options   string       `db:"options"`    // 选项列表,JSON格式
+		Multiple  int64        `db:"multiple"`   // 是否多选: 0-单选 1-多选
+		Anonymous int64        `db:"anonymous"`  // 是否匿名: 0-实名 1-匿名
+		Deadline  sql.NullTime `db:"deadline"`   // 截止时间(NULL表示不限)
+		Status    int64        `db:"status"`     // 状态: 0-进行中 1-已结束
+		ClosedAt  sql.NullTime `db:"closed_at"`  // 结束时间
+		CreatedAt time.Time    `db:"created_at"` // 创建时间
+		UpdatedAt time.Time    `db:"updated_at"` // 更新时间
+	}
+)
+
+func newImPollModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImPollModel {
+	return &defaultImPollModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_poll`",
+	}
+}
+
+func (m *defaultImPollModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImPollIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollIdPrefix, id)
+	imAuthImPollMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImPollIdKey, imAuthImPollMsgIdKey)
+	return err
+}
+
+func (m *defaultImPollModel) FindOne(ctx context.Context, id uint64) (*ImPoll, error) {
+	imAuthImPollIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollIdPrefix, id)
+	var resp ImPoll
+	err := m.QueryRowCtx(ctx, &resp, imAuthImPollIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imPollRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImPollModel) FindOneByMsgId(ctx context.Context, msgId string) (*ImPoll, error) {
+	imAuthImPollMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollMsgIdPrefix, msgId)
+	var resp ImPoll
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImPollMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `msg_id` = ? limit 1", imPollRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImPollModel) Insert(ctx context.Context, data *ImPoll) (sql.Result, error) {
+	imAuthImPollIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollIdPrefix, data.Id)
+	imAuthImPollMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollMsgIdPrefix, data.MsgId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imPollRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MsgId, data.GroupId, data.CreatorId, data.Question, data.Options, data.Multiple, data.Anonymous, data.Deadline, data.Status, data.ClosedAt)
+	}, imAuthImPollIdKey, imAuthImPollMsgIdKey)
+	return ret, err
+}
+
+func (m *defaultImPollModel) Update(ctx context.Context, newData *ImPoll) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImPollIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollIdPrefix, data.Id)
+	imAuthImPollMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imPollRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.MsgId, newData.GroupId, newData.CreatorId, newData.Question, newData.Options, newData.Multiple, newData.Anonymous, newData.Deadline, newData.Status, newData.ClosedAt, newData.Id)
+	}, imAuthImPollIdKey, imAuthImPollMsgIdKey)
+	return err
+}
+
+func (m *defaultImPollModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImPollIdPrefix, primary)
+}
+
+func (m *defaultImPollModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imPollRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImPollModel) tableName() string {
+	return m.table
+}
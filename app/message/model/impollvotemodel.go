@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImPollVoteModel = (*customImPollVoteModel)(nil)
+
+type (
+	// ImPollVoteModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImPollVoteModel.
+	ImPollVoteModel interface {
+		imPollVoteModel
+		// 查询投票的全部投票记录
+		FindByPoll(ctx context.Context, pollId uint64) ([]*ImPollVote, error)
+		// 写入用户的选择，已投过票时覆盖原选择
+		Upsert(ctx context.Context, pollId, userId uint64, options string) error
+	}
+
+	customImPollVoteModel struct {
+		*defaultImPollVoteModel
+	}
+)
+
+// NewImPollVoteModel returns a model for the database table.
+func NewImPollVoteModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImPollVoteModel {
+	return &customImPollVoteModel{
+		defaultImPollVoteModel: newImPollVoteModel(conn, c, opts...),
+	}
+}
+
+// FindByPoll 查询投票的全部投票记录（每个投票人一条）
+func (m *customImPollVoteModel) FindByPoll(ctx context.Context, pollId uint64) ([]*ImPollVote, error) {
+	var resp []*ImPollVote
+	query := fmt.Sprintf("select %s from %s where `poll_id` = ? order by `id` asc", imPollVoteRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, pollId)
+	return resp, err
+}
+
+// Upsert 写入用户的选择，已投过票时覆盖原选择（改票）
+func (m *customImPollVoteModel) Upsert(ctx context.Context, pollId, userId uint64, options string) error {
+	keys := []string{fmt.Sprintf("%s%v:%v", cacheImAuthImPollVotePollIdUserIdPrefix, pollId, userId)}
+
+	// 改票时同时清理按主键缓存的记录
+	var id uint64
+	query := fmt.Sprintf("select `id` from %s where `poll_id` = ? and `user_id` = ? limit 1", m.table)
+	switch err := m.QueryRowNoCacheCtx(ctx, &id, query, pollId, userId); err {
+	case nil:
+		keys = append(keys, fmt.Sprintf("%s%v", cacheImAuthImPollVoteIdPrefix, id))
+	case ErrNotFound:
+	default:
+		return err
+	}
+
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("insert into %s (`poll_id`, `user_id`, `options`) values (?, ?, ?) on duplicate key update `options` = values(`options`)", m.table)
+		return conn.ExecCtx(ctx, query, pollId, userId, options)
+	}, keys...)
+	return err
+}
@@ -0,0 +1,150 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imPollVoteFieldNames          = builder.RawFieldNames(&ImPollVote{})
+	imPollVoteRows                = strings.Join(imPollVoteFieldNames, ",")
+	imPollVoteRowsExpectAutoSet   = strings.Join(stringx.Remove(imPollVoteFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imPollVoteRowsWithPlaceHolder = strings.Join(stringx.Remove(imPollVoteFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImPollVoteIdPrefix           = "cache:imAuth:imPollVote:id:"
+	cacheImAuthImPollVotePollIdUserIdPrefix = "cache:imAuth:imPollVote:pollId:userId:"
+)
+
+type (
+	imPollVoteModel interface {
+		Insert(ctx context.Context, data *ImPollVote) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImPollVote, error)
+		FindOneByPollIdUserId(ctx context.Context, pollId uint64, userId uint64) (*ImPollVote, error)
+		Update(ctx context.Context, data *ImPollVote) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImPollVoteModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImPollVote struct {
+		Id        uint64    `db:"id"`         // 自增主键ID
+		PollId    uint64    `db:"poll_id"`    // 投票ID(im_poll.id)
+		UserId    uint64    `db:"user_id"`    // 投票人ID
+		Options   string    `db:"options"`    // 选择的选项序号,JSON格式
+		CreatedAt time.Time `db:"created_at"` // 创建时间
+		UpdatedAt time.Time `db:"updated_at"` // 更新时间
+	}
+)
+
+func newImPollVoteModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImPollVoteModel {
+	return &defaultImPollVoteModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_poll_vote`",
+	}
+}
+
+func (m *defaultImPollVoteModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImPollVoteIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollVoteIdPrefix, id)
+	imAuthImPollVotePollIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPollVotePollIdUserIdPrefix, data.PollId, data.UserId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImPollVoteIdKey, imAuthImPollVotePollIdUserIdKey)
+	return err
+}
+
+func (m *defaultImPollVoteModel) FindOne(ctx context.Context, id uint64) (*ImPollVote, error) {
+	imAuthImPollVoteIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollVoteIdPrefix, id)
+	var resp ImPollVote
+	err := m.QueryRowCtx(ctx, &resp, imAuthImPollVoteIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imPollVoteRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImPollVoteModel) FindOneByPollIdUserId(ctx context.Context, pollId uint64, userId uint64) (*ImPollVote, error) {
+	imAuthImPollVotePollIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPollVotePollIdUserIdPrefix, pollId, userId)
+	var resp ImPollVote
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImPollVotePollIdUserIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `poll_id` = ? and `user_id` = ? limit 1", imPollVoteRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, pollId, userId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImPollVoteModel) Insert(ctx context.Context, data *ImPollVote) (sql.Result, error) {
+	imAuthImPollVoteIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollVoteIdPrefix, data.Id)
+	imAuthImPollVotePollIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPollVotePollIdUserIdPrefix, data.PollId, data.UserId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, imPollVoteRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.PollId, data.UserId, data.Options)
+	}, imAuthImPollVoteIdKey, imAuthImPollVotePollIdUserIdKey)
+	return ret, err
+}
+
+func (m *defaultImPollVoteModel) Update(ctx context.Context, newData *ImPollVote) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImPollVoteIdKey := fmt.Sprintf("%s%v", cacheImAuthImPollVoteIdPrefix, data.Id)
+	imAuthImPollVotePollIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImPollVotePollIdUserIdPrefix, data.PollId, data.UserId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imPollVoteRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.PollId, newData.UserId, newData.Options, newData.Id)
+	}, imAuthImPollVoteIdKey, imAuthImPollVotePollIdUserIdKey)
+	return err
+}
+
+func (m *defaultImPollVoteModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImPollVoteIdPrefix, primary)
+}
+
+func (m *defaultImPollVoteModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imPollVoteRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImPollVoteModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImScheduledMessageModel = (*customImScheduledMessageModel)(nil)
+
+// 定时消息状态
+const (
+	ScheduledStatusPending  = 0 // 待发送
+	ScheduledStatusSent     = 1 // 已发送
+	ScheduledStatusCanceled = 2 // 已取消
+	ScheduledStatusFailed   = 3 // 发送失败
+	ScheduledStatusSending  = 4 // 发送中（已被调度任务领取）
+)
+
+type (
+	// ImScheduledMessageModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImScheduledMessageModel.
+	ImScheduledMessageModel interface {
+		imScheduledMessageModel
+		// 查询用户的定时消息（status < 0 表示不过滤状态）
+		FindByUser(ctx context.Context, userId uint64, status int64, offset, limit int64) ([]*ImScheduledMessage, error)
+		CountByUser(ctx context.Context, userId uint64, status int64) (int64, error)
+		// 查询已到发送时间的待发送消息
+		FindDue(ctx context.Context, now time.Time, limit int64) ([]*ImScheduledMessage, error)
+		// 领取已到发送时间的待发送消息（待发送 -> 发送中），返回是否领取成功
+		Claim(ctx context.Context, data *ImScheduledMessage, now time.Time) (bool, error)
+		// 记录投递结果（发送中 -> 已发送/发送失败），返回是否更新成功
+		Finish(ctx context.Context, data *ImScheduledMessage) (bool, error)
+		// 将长时间停留在发送中的消息恢复为待发送（调度实例异常退出的场景）
+		ResetStale(ctx context.Context, before time.Time) (int64, error)
+		// 修改待发送的定时消息，返回是否修改成功
+		UpdatePending(ctx context.Context, data *ImScheduledMessage) (bool, error)
+		// 取消待发送的定时消息，返回是否取消成功
+		CancelPending(ctx context.Context, data *ImScheduledMessage) (bool, error)
+	}
+
+	customImScheduledMessageModel struct {
+		*defaultImScheduledMessageModel
+	}
+)
+
+// NewImScheduledMessageModel returns a model for the database table.
+func NewImScheduledMessageModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImScheduledMessageModel {
+	return &customImScheduledMessageModel{
+		defaultImScheduledMessageModel: newImScheduledMessageModel(conn, c, opts...),
+	}
+}
+
+// FindByUser 查询用户的定时消息，按计划发送时间升序
+func (m *customImScheduledMessageModel) FindByUser(ctx context.Context, userId uint64, status int64, offset, limit int64) ([]*ImScheduledMessage, error) {
+	var resp []*ImScheduledMessage
+	var err error
+	if status >= 0 {
+		query := fmt.Sprintf("select %s from %s where `from_user_id` = ? and `status` = ? order by `send_at` asc limit ?, ?", imScheduledMessageRows, m.table)
+		err = m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, status, offset, limit)
+	} else {
+		query := fmt.Sprintf("select %s from %s where `from_user_id` = ? order by `send_at` desc limit ?, ?", imScheduledMessageRows, m.table)
+		err = m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, offset, limit)
+	}
+	return resp, err
+}
+
+// CountByUser 统计用户的定时消息数量
+func (m *customImScheduledMessageModel) CountByUser(ctx context.Context, userId uint64, status int64) (int64, error) {
+	var count int64
+	var err error
+	if status >= 0 {
+		query := fmt.Sprintf("select count(*) from %s where `from_user_id` = ? and `status` = ?", m.table)
+		err = m.QueryRowNoCacheCtx(ctx, &count, query, userId, status)
+	} else {
+		query := fmt.Sprintf("select count(*) from %s where `from_user_id` = ?", m.table)
+		err = m.QueryRowNoCacheCtx(ctx, &count, query, userId)
+	}
+	return count, err
+}
+
+// FindDue 查询已到发送时间的待发送消息
+func (m *customImScheduledMessageModel) FindDue(ctx context.Context, now time.Time, limit int64) ([]*ImScheduledMessage, error) {
+	var resp []*ImScheduledMessage
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `send_at` <= ? order by `send_at` asc limit ?", imScheduledMessageRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, ScheduledStatusPending, now, limit)
+	return resp, err
+}
+
+// Claim 领取待发送消息，多实例并发扫描时只有一个实例能领取成功
+// 以发送时间为条件，查询到期后被用户改到以后发送的消息不会被领取
+func (m *customImScheduledMessageModel) Claim(ctx context.Context, data *ImScheduledMessage, now time.Time) (bool, error) {
+	return m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ? and `send_at` <= ?", m.table),
+		ScheduledStatusSending, data.Id, ScheduledStatusPending, now)
+}
+
+// Finish 记录投递结果，只更新状态、消息ID和失败原因，且仅在发送中时更新
+func (m *customImScheduledMessageModel) Finish(ctx context.Context, data *ImScheduledMessage) (bool, error) {
+	return m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ?, `message_id` = ?, `fail_reason` = ? where `id` = ? and `status` = ?", m.table),
+		data.Status, data.MessageId, data.FailReason, data.Id, ScheduledStatusSending)
+}
+
+// ResetStale 将长时间停留在发送中的消息恢复为待发送（投递使用固定 msg_id，重复投递是幂等的）
+func (m *customImScheduledMessageModel) ResetStale(ctx context.Context, before time.Time) (int64, error) {
+	var stale []*ImScheduledMessage
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `updated_at` < ?", imScheduledMessageRows, m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &stale, query, ScheduledStatusSending, before); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, data := range stale {
+		ok, err := m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table),
+			ScheduledStatusPending, data.Id, ScheduledStatusSending)
+		if err != nil {
+			return count, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// UpdatePending 修改待发送的定时消息（仅本人、仅待发送状态）
+func (m *customImScheduledMessageModel) UpdatePending(ctx context.Context, data *ImScheduledMessage) (bool, error) {
+	return m.execAffected(ctx, data, fmt.Sprintf("update %s set `content` = ?, `content_type` = ?, `at_user_ids` = ?, `send_at` = ? where `id` = ? and `from_user_id` = ? and `status` = ?", m.table),
+		data.Content, data.ContentType, data.AtUserIds, data.SendAt, data.Id, data.FromUserId, ScheduledStatusPending)
+}
+
+// CancelPending 取消待发送的定时消息（仅本人、仅待发送状态）
+func (m *customImScheduledMessageModel) CancelPending(ctx context.Context, data *ImScheduledMessage) (bool, error) {
+	return m.execAffected(ctx, data, fmt.Sprintf("update %s set `status` = ? where `id` = ? and `from_user_id` = ? and `status` = ?", m.table),
+		ScheduledStatusCanceled, data.Id, data.FromUserId, ScheduledStatusPending)
+}
+
+// execAffected 执行条件更新并清理缓存，返回是否有行被更新
+func (m *customImScheduledMessageModel) execAffected(ctx context.Context, data *ImScheduledMessage, query string, args ...any) (bool, error) {
+	imAuthImScheduledMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageIdPrefix, data.Id)
+	imAuthImScheduledMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageMsgIdPrefix, data.MsgId)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, query, args...)
+	}, imAuthImScheduledMessageIdKey, imAuthImScheduledMessageMsgIdKey)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
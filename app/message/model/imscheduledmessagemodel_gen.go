@@ -0,0 +1,159 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imScheduledMessageFieldNames          = builder.RawFieldNames(&ImScheduledMessage{})
+	imScheduledMessageRows                = strings.Join(imScheduledMessageFieldNames, ",")
+	imScheduledMessageRowsExpectAutoSet   = strings.Join(stringx.Remove(imScheduledMessageFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imScheduledMessageRowsWithPlaceHolder = strings.Join(stringx.Remove(imScheduledMessageFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImScheduledMessageIdPrefix    = "cache:imAuth:imScheduledMessage:id:"
+	cacheImAuthImScheduledMessageMsgIdPrefix = "cache:imAuth:imScheduledMessage:msgId:"
+)
+
+type (
+	imScheduledMessageModel interface {
+		Insert(ctx context.Context, data *ImScheduledMessage) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImScheduledMessage, error)
+		FindOneByMsgId(ctx context.Context, msgId string) (*ImScheduledMessage, error)
+		Update(ctx context.Context, data *ImScheduledMessage) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImScheduledMessageModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImScheduledMessage struct {
+		Id          uint64         `db:"id"`           // 自增主键ID
+		MsgId       string         `db:"msg_id"`       // 投递时使用的消息唯一标识(创建时生成,保证重复投递幂等)
+		FromUserId  uint64         `db:"from_user_id"` // 发送者ID
+		ToUserId    uint64         `db:"to_user_id"`   // 接收者ID(私聊时有效)
+		ChatType    int64          `db:"chat_type"`    // 聊天类型: 1-私聊 2-群聊
+		GroupId     string         `db:"group_id"`     // 群组ID(群聊时使用)
+		Content     string         `db:"content"`      // 消息内容
+		ContentType int64          `db:"content_type"` // 消息内容类型(同im_message)
+		AtUserIds   sql.NullString `db:"at_user_ids"`  // 被@的用户ID列表,JSON格式
+		SendAt      time.Time      `db:"send_at"`      // 计划发送时间
+		Status      int64          `db:"status"`       // 状态: 0-待发送 1-已发送 2-已取消 3-发送失败 4-发送中
+		MessageId   uint64         `db:"message_id"`   // 发送成功后对应的im_message.id
+		FailReason  string         `db:"fail_reason"`  // 发送失败原因
+		CreatedAt   time.Time      `db:"created_at"`   // 创建时间
+		UpdatedAt   time.Time      `db:"updated_at"`   // 更新时间
+	}
+)
+
+func newImScheduledMessageModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImScheduledMessageModel {
+	return &defaultImScheduledMessageModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_scheduled_message`",
+	}
+}
+
+func (m *defaultImScheduledMessageModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImScheduledMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageIdPrefix, id)
+	imAuthImScheduledMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImScheduledMessageIdKey, imAuthImScheduledMessageMsgIdKey)
+	return err
+}
+
+func (m *defaultImScheduledMessageModel) FindOne(ctx context.Context, id uint64) (*ImScheduledMessage, error) {
+	imAuthImScheduledMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageIdPrefix, id)
+	var resp ImScheduledMessage
+	err := m.QueryRowCtx(ctx, &resp, imAuthImScheduledMessageIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imScheduledMessageRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImScheduledMessageModel) FindOneByMsgId(ctx context.Context, msgId string) (*ImScheduledMessage, error) {
+	imAuthImScheduledMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageMsgIdPrefix, msgId)
+	var resp ImScheduledMessage
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImScheduledMessageMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `msg_id` = ? limit 1", imScheduledMessageRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImScheduledMessageModel) Insert(ctx context.Context, data *ImScheduledMessage) (sql.Result, error) {
+	imAuthImScheduledMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageIdPrefix, data.Id)
+	imAuthImScheduledMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageMsgIdPrefix, data.MsgId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, imScheduledMessageRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MsgId, data.FromUserId, data.ToUserId, data.ChatType, data.GroupId, data.Content, data.ContentType, data.AtUserIds, data.SendAt, data.Status, data.MessageId, data.FailReason)
+	}, imAuthImScheduledMessageIdKey, imAuthImScheduledMessageMsgIdKey)
+	return ret, err
+}
+
+func (m *defaultImScheduledMessageModel) Update(ctx context.Context, newData *ImScheduledMessage) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImScheduledMessageIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageIdPrefix, data.Id)
+	imAuthImScheduledMessageMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imScheduledMessageRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.MsgId, newData.FromUserId, newData.ToUserId, newData.ChatType, newData.GroupId, newData.Content, newData.ContentType, newData.AtUserIds, newData.SendAt, newData.Status, newData.MessageId, newData.FailReason, newData.Id)
+	}, imAuthImScheduledMessageIdKey, imAuthImScheduledMessageMsgIdKey)
+	return err
+}
+
+func (m *defaultImScheduledMessageModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImScheduledMessageIdPrefix, primary)
+}
+
+func (m *defaultImScheduledMessageModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imScheduledMessageRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImScheduledMessageModel) tableName() string {
+	return m.table
+}
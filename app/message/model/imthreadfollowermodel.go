@@ -0,0 +1,40 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImThreadFollowerModel = (*customImThreadFollowerModel)(nil)
+
+type (
+	// ImThreadFollowerModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImThreadFollowerModel.
+	ImThreadFollowerModel interface {
+		imThreadFollowerModel
+		// 查询话题的全部关注者
+		FindUserIdsByRoot(ctx context.Context, rootMsgId string) ([]int64, error)
+	}
+
+	customImThreadFollowerModel struct {
+		*defaultImThreadFollowerModel
+	}
+)
+
+// NewImThreadFollowerModel returns a model for the database table.
+func NewImThreadFollowerModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImThreadFollowerModel {
+	return &customImThreadFollowerModel{
+		defaultImThreadFollowerModel: newImThreadFollowerModel(conn, c, opts...),
+	}
+}
+
+// FindUserIdsByRoot 查询话题的全部关注者ID
+func (m *customImThreadFollowerModel) FindUserIdsByRoot(ctx context.Context, rootMsgId string) ([]int64, error) {
+	var userIds []int64
+	query := fmt.Sprintf("select `user_id` from %s where `root_msg_id` = ?", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &userIds, query, rootMsgId)
+	return userIds, err
+}
@@ -0,0 +1,149 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imThreadFollowerFieldNames          = builder.RawFieldNames(&ImThreadFollower{})
+	imThreadFollowerRows                = strings.Join(imThreadFollowerFieldNames, ",")
+	imThreadFollowerRowsExpectAutoSet   = strings.Join(stringx.Remove(imThreadFollowerFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imThreadFollowerRowsWithPlaceHolder = strings.Join(stringx.Remove(imThreadFollowerFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImThreadFollowerIdPrefix              = "cache:imAuth:imThreadFollower:id:"
+	cacheImAuthImThreadFollowerRootMsgIdUserIdPrefix = "cache:imAuth:imThreadFollower:rootMsgId:userId:"
+)
+
+type (
+	imThreadFollowerModel interface {
+		Insert(ctx context.Context, data *ImThreadFollower) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImThreadFollower, error)
+		FindOneByRootMsgIdUserId(ctx context.Context, rootMsgId string, userId uint64) (*ImThreadFollower, error)
+		Update(ctx context.Context, data *ImThreadFollower) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImThreadFollowerModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImThreadFollower struct {
+		Id        uint64    `db:"id"`          // 自增主键ID
+		RootMsgId string    `db:"root_msg_id"` // 话题根消息的唯一标识
+		GroupId   string    `db:"group_id"`    // 群组ID
+		UserId    uint64    `db:"user_id"`     // 关注者ID
+		CreatedAt time.Time `db:"created_at"`  // 关注时间
+	}
+)
+
+func newImThreadFollowerModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImThreadFollowerModel {
+	return &defaultImThreadFollowerModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_thread_follower`",
+	}
+}
+
+func (m *defaultImThreadFollowerModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImThreadFollowerIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadFollowerIdPrefix, id)
+	imAuthImThreadFollowerRootMsgIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImThreadFollowerRootMsgIdUserIdPrefix, data.RootMsgId, data.UserId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImThreadFollowerIdKey, imAuthImThreadFollowerRootMsgIdUserIdKey)
+	return err
+}
+
+func (m *defaultImThreadFollowerModel) FindOne(ctx context.Context, id uint64) (*ImThreadFollower, error) {
+	imAuthImThreadFollowerIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadFollowerIdPrefix, id)
+	var resp ImThreadFollower
+	err := m.QueryRowCtx(ctx, &resp, imAuthImThreadFollowerIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imThreadFollowerRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImThreadFollowerModel) FindOneByRootMsgIdUserId(ctx context.Context, rootMsgId string, userId uint64) (*ImThreadFollower, error) {
+	imAuthImThreadFollowerRootMsgIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImThreadFollowerRootMsgIdUserIdPrefix, rootMsgId, userId)
+	var resp ImThreadFollower
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImThreadFollowerRootMsgIdUserIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `root_msg_id` = ? and `user_id` = ? limit 1", imThreadFollowerRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, rootMsgId, userId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImThreadFollowerModel) Insert(ctx context.Context, data *ImThreadFollower) (sql.Result, error) {
+	imAuthImThreadFollowerIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadFollowerIdPrefix, data.Id)
+	imAuthImThreadFollowerRootMsgIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImThreadFollowerRootMsgIdUserIdPrefix, data.RootMsgId, data.UserId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, imThreadFollowerRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.RootMsgId, data.GroupId, data.UserId)
+	}, imAuthImThreadFollowerIdKey, imAuthImThreadFollowerRootMsgIdUserIdKey)
+	return ret, err
+}
+
+func (m *defaultImThreadFollowerModel) Update(ctx context.Context, newData *ImThreadFollower) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImThreadFollowerIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadFollowerIdPrefix, data.Id)
+	imAuthImThreadFollowerRootMsgIdUserIdKey := fmt.Sprintf("%s%v:%v", cacheImAuthImThreadFollowerRootMsgIdUserIdPrefix, data.RootMsgId, data.UserId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imThreadFollowerRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.RootMsgId, newData.GroupId, newData.UserId, newData.Id)
+	}, imAuthImThreadFollowerIdKey, imAuthImThreadFollowerRootMsgIdUserIdKey)
+	return err
+}
+
+func (m *defaultImThreadFollowerModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImThreadFollowerIdPrefix, primary)
+}
+
+func (m *defaultImThreadFollowerModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imThreadFollowerRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImThreadFollowerModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ImThreadReplyModel = (*customImThreadReplyModel)(nil)
+
+type (
+	// ImThreadReplyModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customImThreadReplyModel.
+	ImThreadReplyModel interface {
+		imThreadReplyModel
+		// 按话题内 Seq 升序读取 afterSeq 之后的回复
+		FindByRoot(ctx context.Context, rootMsgId string, afterSeq uint64, limit int64) ([]*ImThreadReply, error)
+		// 查询话题当前最大 Seq（Redis 不可用时生成 Seq）
+		FindMaxSeq(ctx context.Context, rootMsgId string) (int64, error)
+		// 批量汇总话题的回复数和最后回复时间，没有回复的根消息不返回
+		FindSummaries(ctx context.Context, rootMsgIds []string) ([]*ThreadSummary, error)
+	}
+
+	customImThreadReplyModel struct {
+		*defaultImThreadReplyModel
+	}
+
+	// ThreadSummary 话题汇总
+	ThreadSummary struct {
+		RootMsgId   string    `db:"root_msg_id"`
+		ReplyCount  int64     `db:"reply_count"`
+		LastSeq     uint64    `db:"last_seq"`
+		LastReplyAt time.Time `db:"last_reply_at"`
+	}
+)
+
+// NewImThreadReplyModel returns a model for the database table.
+func NewImThreadReplyModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ImThreadReplyModel {
+	return &customImThreadReplyModel{
+		defaultImThreadReplyModel: newImThreadReplyModel(conn, c, opts...),
+	}
+}
+
+// FindByRoot 按话题内 Seq 升序读取回复（走 idx_root_seq）
+func (m *customImThreadReplyModel) FindByRoot(ctx context.Context, rootMsgId string, afterSeq uint64, limit int64) ([]*ImThreadReply, error) {
+	var resp []*ImThreadReply
+	query := fmt.Sprintf("select %s from %s where `root_msg_id` = ? and `seq` > ? order by `seq` asc limit ?", imThreadReplyRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, rootMsgId, afterSeq, limit)
+	return resp, err
+}
+
+// FindMaxSeq 查询话题当前最大 Seq
+func (m *customImThreadReplyModel) FindMaxSeq(ctx context.Context, rootMsgId string) (int64, error) {
+	var maxSeq int64
+	query := fmt.Sprintf("select ifnull(max(`seq`), 0) from %s where `root_msg_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &maxSeq, query, rootMsgId)
+	return maxSeq, err
+}
+
+// FindSummaries 批量汇总话题（按 idx_root_seq 分组统计，不需要单独维护计数）
+func (m *customImThreadReplyModel) FindSummaries(ctx context.Context, rootMsgIds []string) ([]*ThreadSummary, error) {
+	if len(rootMsgIds) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(rootMsgIds)), ",")
+	args := make([]interface{}, 0, len(rootMsgIds))
+	for _, id := range rootMsgIds {
+		args = append(args, id)
+	}
+
+	var resp []*ThreadSummary
+	query := fmt.Sprintf("select `root_msg_id`, count(*) as `reply_count`, max(`seq`) as `last_seq`, max(`created_at`) as `last_reply_at` from %s where `root_msg_id` in (%s) group by `root_msg_id`", m.table, placeholders)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
@@ -0,0 +1,154 @@
+// Code generated by goctl. DO NOT EDIT.
+// versions:
+//  goctl version: 1.9.2
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+var (
+	imThreadReplyFieldNames          = builder.RawFieldNames(&ImThreadReply{})
+	imThreadReplyRows                = strings.Join(imThreadReplyFieldNames, ",")
+	imThreadReplyRowsExpectAutoSet   = strings.Join(stringx.Remove(imThreadReplyFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
+	imThreadReplyRowsWithPlaceHolder = strings.Join(stringx.Remove(imThreadReplyFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
+
+	cacheImAuthImThreadReplyIdPrefix    = "cache:imAuth:imThreadReply:id:"
+	cacheImAuthImThreadReplyMsgIdPrefix = "cache:imAuth:imThreadReply:msgId:"
+)
+
+type (
+	imThreadReplyModel interface {
+		Insert(ctx context.Context, data *ImThreadReply) (sql.Result, error)
+		FindOne(ctx context.Context, id uint64) (*ImThreadReply, error)
+		FindOneByMsgId(ctx context.Context, msgId string) (*ImThreadReply, error)
+		Update(ctx context.Context, data *ImThreadReply) error
+		Delete(ctx context.Context, id uint64) error
+	}
+
+	defaultImThreadReplyModel struct {
+		sqlc.CachedConn
+		table string
+	}
+
+	ImThreadReply struct {
+		Id          uint64    `db:"id"`           // 自增主键ID
+		MsgId       string    `db:"msg_id"`       // 回复消息唯一标识(客户端生成)
+		RootMsgId   string    `db:"root_msg_id"`  // 话题根消息的唯一标识(im_message.msg_id)
+		GroupId     string    `db:"group_id"`     // 群组ID
+		Seq         uint64    `db:"seq"`          // 话题内序列号(每个话题独立递增)
+		FromUserId  uint64    `db:"from_user_id"` // 发送者ID
+		Content     string    `db:"content"`      // 消息内容
+		ContentType int64     `db:"content_type"` // 消息内容类型(同im_message)
+		CreatedAt   time.Time `db:"created_at"`   // 创建时间
+		UpdatedAt   time.Time `db:"updated_at"`   // 更新时间
+	}
+)
+
+func newImThreadReplyModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) *defaultImThreadReplyModel {
+	return &defaultImThreadReplyModel{
+		CachedConn: sqlc.NewConn(conn, c, opts...),
+		table:      "`im_thread_reply`",
+	}
+}
+
+func (m *defaultImThreadReplyModel) Delete(ctx context.Context, id uint64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImThreadReplyIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyIdPrefix, id)
+	imAuthImThreadReplyMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, id)
+	}, imAuthImThreadReplyIdKey, imAuthImThreadReplyMsgIdKey)
+	return err
+}
+
+func (m *defaultImThreadReplyModel) FindOne(ctx context.Context, id uint64) (*ImThreadReply, error) {
+	imAuthImThreadReplyIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyIdPrefix, id)
+	var resp ImThreadReply
+	err := m.QueryRowCtx(ctx, &resp, imAuthImThreadReplyIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imThreadReplyRows, m.table)
+		return conn.QueryRowCtx(ctx, v, query, id)
+	})
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImThreadReplyModel) FindOneByMsgId(ctx context.Context, msgId string) (*ImThreadReply, error) {
+	imAuthImThreadReplyMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyMsgIdPrefix, msgId)
+	var resp ImThreadReply
+	err := m.QueryRowIndexCtx(ctx, &resp, imAuthImThreadReplyMsgIdKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `msg_id` = ? limit 1", imThreadReplyRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, msgId); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultImThreadReplyModel) Insert(ctx context.Context, data *ImThreadReply) (sql.Result, error) {
+	imAuthImThreadReplyIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyIdPrefix, data.Id)
+	imAuthImThreadReplyMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyMsgIdPrefix, data.MsgId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?)", m.table, imThreadReplyRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.MsgId, data.RootMsgId, data.GroupId, data.Seq, data.FromUserId, data.Content, data.ContentType)
+	}, imAuthImThreadReplyIdKey, imAuthImThreadReplyMsgIdKey)
+	return ret, err
+}
+
+func (m *defaultImThreadReplyModel) Update(ctx context.Context, newData *ImThreadReply) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	imAuthImThreadReplyIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyIdPrefix, data.Id)
+	imAuthImThreadReplyMsgIdKey := fmt.Sprintf("%s%v", cacheImAuthImThreadReplyMsgIdPrefix, data.MsgId)
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, imThreadReplyRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, newData.MsgId, newData.RootMsgId, newData.GroupId, newData.Seq, newData.FromUserId, newData.Content, newData.ContentType, newData.Id)
+	}, imAuthImThreadReplyIdKey, imAuthImThreadReplyMsgIdKey)
+	return err
+}
+
+func (m *defaultImThreadReplyModel) formatPrimary(primary any) string {
+	return fmt.Sprintf("%s%v", cacheImAuthImThreadReplyIdPrefix, primary)
+}
+
+func (m *defaultImThreadReplyModel) queryPrimary(ctx context.Context, conn sqlx.SqlConn, v, primary any) error {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1", imThreadReplyRows, m.table)
+	return conn.QueryRowCtx(ctx, v, query, primary)
+}
+
+func (m *defaultImThreadReplyModel) tableName() string {
+	return m.table
+}
@@ -0,0 +1,149 @@
+package archive
+
+// worker.go - 消息保留期限与冷数据归档
+//
+// 定时执行两项任务：
+// 1. 归档：创建时间早于 ArchiveAfterDays 的消息移入按月拆分的归档表（im_message_archive_YYYYMM），
+//    历史消息分页查询在热表不足一页时自动继续读取归档表
+// 2. 清理：超过保留期限的消息清空内容并标记为已清理(5)，保留消息ID与Seq作为占位，
+//    群主单独设置的保留期限优先于全局默认值
+//
+// 多实例部署时重复执行是安全的：归档使用 insert ignore + delete，清理为条件更新
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxBatchesPerScan 单次扫描每项任务最多处理的批次数，避免积压时长时间占用数据库
+const maxBatchesPerScan = 20
+
+type Worker struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	batch    int64
+	done     chan struct{}
+}
+
+func NewWorker(svcCtx *svc.ServiceContext) *Worker {
+	return &Worker{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Retention.ScanInterval) * time.Second,
+		batch:    int64(svcCtx.Config.Retention.BatchSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动扫描循环（阻塞，由 ServiceGroup 管理）
+func (w *Worker) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.scan()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+// scan 先清理超过保留期限的消息，再归档冷数据
+func (w *Worker) scan() {
+	ctx := context.Background()
+	w.purge(ctx)
+	w.archive(ctx)
+}
+
+// archive 将超过归档天数的消息移入月度归档表
+func (w *Worker) archive(ctx context.Context) {
+	days := w.svcCtx.Config.Retention.ArchiveAfterDays
+	if days <= 0 {
+		return
+	}
+	before := time.Now().AddDate(0, 0, -days)
+
+	var total int
+	for i := 0; i < maxBatchesPerScan; i++ {
+		messages, err := w.svcCtx.ImMessageModel.FindArchivableMessages(ctx, before, w.batch)
+		if err != nil {
+			logx.Errorf("[Archive] 查询待归档消息失败: %v", err)
+			break
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		if err := w.svcCtx.ImMessageModel.ArchiveMessages(ctx, messages); err != nil {
+			logx.Errorf("[Archive] 归档消息失败: firstId=%d, err=%v", messages[0].Id, err)
+			break
+		}
+		total += len(messages)
+
+		if int64(len(messages)) < w.batch {
+			break
+		}
+	}
+
+	if total > 0 {
+		logx.Infof("[Archive] 归档 %d 条消息（早于 %s）", total, before.Format(time.DateTime))
+	}
+}
+
+// purge 按全局默认策略和各群单独设置的策略清理过期消息
+func (w *Worker) purge(ctx context.Context) {
+	now := time.Now()
+
+	overrides, err := w.svcCtx.ImGroupRetentionModel.FindAll(ctx)
+	if err != nil {
+		logx.Errorf("[Archive] 查询群消息保留策略失败: %v", err)
+		return
+	}
+
+	if days := w.svcCtx.Config.Retention.DefaultDays; days > 0 {
+		scope := model.PurgeScope{}
+		for _, r := range overrides {
+			scope.ExcludeGroupIds = append(scope.ExcludeGroupIds, r.GroupId)
+		}
+		w.purgeScope(ctx, scope, now.AddDate(0, 0, -days))
+	}
+
+	for _, r := range overrides {
+		// 0 表示该群永久保留
+		if r.RetentionDays == 0 {
+			continue
+		}
+		w.purgeScope(ctx, model.PurgeScope{GroupId: r.GroupId}, now.AddDate(0, 0, -int(r.RetentionDays)))
+	}
+}
+
+// purgeScope 分批清理指定范围内早于 before 的消息
+func (w *Worker) purgeScope(ctx context.Context, scope model.PurgeScope, before time.Time) {
+	var total int64
+	for i := 0; i < maxBatchesPerScan; i++ {
+		n, err := w.svcCtx.ImMessageModel.PurgeMessages(ctx, scope, before, w.batch)
+		total += n
+		if err != nil {
+			logx.Errorf("[Archive] 清理过期消息失败: groupId=%s, err=%v", scope.GroupId, err)
+			break
+		}
+		if n < w.batch {
+			break
+		}
+	}
+
+	if total > 0 {
+		logx.Infof("[Archive] 清理 %d 条超过保留期限的消息: groupId=%s, before=%s", total, scope.GroupId, before.Format(time.DateTime))
+	}
+}
@@ -10,6 +10,85 @@ type Config struct {
 	MySQL struct {
 		DataSource string
 	}
-	Cache    cache.CacheConf
-	GroupRpc zrpc.RpcClientConf
+	Cache     cache.CacheConf
+	GroupRpc  zrpc.RpcClientConf
+	FriendRpc zrpc.RpcClientConf
+	UserRpc   zrpc.RpcClientConf
+
+	// AllowStrangerMessage 是否允许非好友之间发送私聊消息（拉黑时始终拒绝）
+	AllowStrangerMessage bool `json:",default=false"`
+
+	// WebSocket 服务地址
+	WsServiceUrl string
+
+	// WebSocket 内部推送鉴权（可选）
+	WsPushSecret string `json:",optional"`
+
+	// 阅后即焚过期扫描间隔（秒）和每批处理条数
+	ExpireScanInterval int `json:",default=5"`
+	ExpireBatchSize    int `json:",default=200"`
+
+	// 定时消息扫描间隔（秒）和每批处理条数
+	ScheduleScanInterval int `json:",default=5"`
+	ScheduleBatchSize    int `json:",default=100"`
+
+	// 群投票截止扫描间隔（秒）和每批处理条数
+	PollScanInterval int `json:",default=5"`
+	PollBatchSize    int `json:",default=100"`
+
+	// 聊天记录导出
+	Export struct {
+		ScanInterval int     `json:",default=10"`     // 扫描排队任务间隔（秒）
+		MaxMessages  int     `json:",default=100000"` // 单次导出最多消息条数
+		LinkExpire   int     `json:",default=86400"`  // 下载链接有效期（秒）
+		AuditorIds   []int64 `json:",optional"`       // 合规审计人员，可导出任意会话
+		MinIO        struct {
+			Endpoint        string // MinIO内部访问地址
+			PublicEndpoint  string // 下载链接使用的公共访问地址
+			AccessKeyID     string
+			SecretAccessKey string
+			UseSSL          bool   `json:",default=false"`
+			Bucket          string `json:",default=exports"`
+		}
+	}
+
+	// 消息分片存储（已有数据需先使用 app/message/tools/reshard 迁移）
+	Sharding struct {
+		Count       int      `json:",default=0"` // 分片数量，0 表示不分片（使用 im_message 单表）
+		DataSources []string `json:",optional"`  // 分片所在数据库，分片 i 使用第 i % len 个；为空时与 MySQL.DataSource 相同
+	}
+
+	// 消息保留期限与冷数据归档
+	Retention struct {
+		DefaultDays      int `json:",default=0"`    // 全局默认保留天数，0 表示永久保留
+		MinDays          int `json:",default=7"`    // 群主可设置的最短保留天数
+		MaxDays          int `json:",default=3650"` // 群主可设置的最长保留天数
+		ArchiveAfterDays int `json:",default=90"`   // 超过该天数的消息移入月度归档表，0 表示不归档
+		ScanInterval     int `json:",default=600"`  // 归档与清理扫描间隔（秒）
+		BatchSize        int `json:",default=500"`  // 每批处理条数
+	}
+
+	// 内容审核（敏感词过滤）
+	Moderation struct {
+		WordFile          string  `json:",optional"`                               // 全局敏感词文件，为空时不启用全局词库
+		DefaultAction     string  `json:",default=mask,options=mask|review|block"` // 词库文件中未指定动作时的处理
+		ReloadInterval    int     `json:",default=30"`                             // 检查词库文件变化的间隔（秒）
+		GroupCacheSeconds int     `json:",default=60"`                             // 群自定义词库本地缓存时间（秒）
+		MaxGroupWords     int     `json:",default=500"`                            // 每个群最多自定义的敏感词数量
+		ReviewerIds       []int64 `json:",optional"`                               // 平台审核人员，可处理所有会话的送审消息
+	}
+
+	// 链接预览（抓取消息中链接的 OpenGraph / Twitter Card 信息）
+	Unfurl struct {
+		Enabled              bool   `json:",default=true"`
+		ScanInterval         int    `json:",default=2"`                      // 扫描待抓取链接间隔（秒）
+		BatchSize            int    `json:",default=20"`                     // 每批处理条数
+		Concurrency          int    `json:",default=4"`                      // 同时抓取的链接数
+		Timeout              int    `json:",default=5"`                      // 单个链接抓取超时（秒，含重定向）
+		MaxBodyKB            int64  `json:",default=512"`                    // 最多读取的网页大小（KB）
+		MaxRedirects         int    `json:",default=3"`                      // 最多跟随的重定向次数
+		CacheSeconds         int    `json:",default=3600"`                   // 同一链接的抓取结果缓存时间（秒）
+		UserAgent            string `json:",default=SkyeIM-LinkPreview/1.0"` // 抓取时使用的 User-Agent
+		AllowPrivateNetworks bool   `json:",default=false"`                  // 允许访问内网地址（仅用于本地测试）
+	}
 }
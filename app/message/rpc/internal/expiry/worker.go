@@ -0,0 +1,116 @@
+package expiry
+
+// worker.go - 阅后即焚过期任务
+//
+// 定时扫描已到期（expire_at <= now）但未销毁的消息：
+// 1. 清空消息内容，状态置为 4-已销毁
+// 2. 通过 WebSocket 推送 message_expired 事件（私聊推送给双方，群聊推送给群成员）
+//
+// 多实例部署时依赖 BurnMessage 的条件更新保证每条消息只推送一次
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EventMessageExpired 消息销毁事件类型
+const EventMessageExpired = "message_expired"
+
+type Worker struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	batch    int64
+	done     chan struct{}
+}
+
+func NewWorker(svcCtx *svc.ServiceContext) *Worker {
+	return &Worker{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.ExpireScanInterval) * time.Second,
+		batch:    int64(svcCtx.Config.ExpireBatchSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动扫描循环（阻塞，由 ServiceGroup 管理）
+func (w *Worker) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.scan()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+// scan 处理一批到期消息，批次满时继续处理下一批
+func (w *Worker) scan() {
+	ctx := context.Background()
+
+	for {
+		messages, err := w.svcCtx.ImMessageModel.FindExpiredMessages(ctx, time.Now(), w.batch)
+		if err != nil {
+			logx.Errorf("[Expiry] 查询到期消息失败: %v", err)
+			return
+		}
+
+		for _, msg := range messages {
+			w.burn(ctx, msg)
+		}
+
+		if int64(len(messages)) < w.batch {
+			return
+		}
+	}
+}
+
+// burn 销毁单条消息并推送事件
+func (w *Worker) burn(ctx context.Context, msg *model.ImMessage) {
+	burned, err := w.svcCtx.ImMessageModel.BurnMessage(ctx, msg)
+	if err != nil {
+		logx.Errorf("[Expiry] 销毁消息失败: msgId=%s, err=%v", msg.MsgId, err)
+		return
+	}
+	if !burned {
+		// 已被其他实例处理
+		return
+	}
+
+	data := map[string]interface{}{
+		"id":         msg.Id,
+		"msgId":      msg.MsgId,
+		"chatType":   msg.ChatType,
+		"fromUserId": msg.FromUserId,
+		"toUserId":   msg.ToUserId,
+		"groupId":    msg.GroupId.String,
+		"seq":        msg.Seq,
+		"expiredAt":  time.Now().Unix(),
+	}
+
+	if msg.ChatType == 2 {
+		if err := w.svcCtx.WsPushClient.PushGroupEvent(msg.GroupId.String, EventMessageExpired, data); err != nil {
+			logx.Errorf("[Expiry] 推送群消息销毁事件失败: msgId=%s, err=%v", msg.MsgId, err)
+		}
+		return
+	}
+
+	for _, userId := range []uint64{msg.FromUserId, msg.ToUserId} {
+		if err := w.svcCtx.WsPushClient.PushToUser(int64(userId), EventMessageExpired, data); err != nil {
+			logx.Errorf("[Expiry] 推送消息销毁事件失败: msgId=%s, userId=%d, err=%v", msg.MsgId, userId, err)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+)
+
+// 导出格式
+const (
+	FormatJSON = "json"
+	FormatHTML = "html"
+	FormatText = "text"
+)
+
+// timeLayout 导出文件中的时间格式
+const timeLayout = "2006-01-02 15:04:05"
+
+// formatInfo 导出格式对应的文件扩展名与 MIME 类型
+var formatInfo = map[string]struct {
+	ext         string
+	contentType string
+}{
+	FormatJSON: {".json", "application/json; charset=utf-8"},
+	FormatHTML: {".html", "text/html; charset=utf-8"},
+	FormatText: {".txt", "text/plain; charset=utf-8"},
+}
+
+// ValidFormat 是否为支持的导出格式
+func ValidFormat(format string) bool {
+	_, ok := formatInfo[format]
+	return ok
+}
+
+// FileName 导出文件的下载文件名
+func FileName(job *model.ImExportJob) string {
+	var target string
+	if job.ChatType == 2 {
+		target = "group_" + job.GroupId
+	} else {
+		target = fmt.Sprintf("private_%d_%d", job.OwnerId, job.PeerId)
+	}
+	return fmt.Sprintf("chat_%s_%s%s", target, job.CreatedAt.Format("20060102150405"), formatInfo[job.Format].ext)
+}
+
+// Record 导出的单条消息
+type Record struct {
+	Id          uint64    `json:"id"`
+	MsgId       string    `json:"msgId"`
+	Seq         uint64    `json:"seq"`
+	SenderId    uint64    `json:"senderId"`
+	SenderName  string    `json:"senderName"`
+	ContentType int64     `json:"contentType"`
+	Status      int64     `json:"status"`
+	Content     string    `json:"content"`            // 原始内容（撤回/删除/销毁/阅后即焚的消息为空）
+	Text        string    `json:"text"`               // 可读文本（非文本消息为摘要，如"[图片] url"）
+	Url         string    `json:"url,omitempty"`      // 图片/文件/语音/视频的资源地址
+	FileName    string    `json:"fileName,omitempty"` // 文件名（文件消息）
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Document 导出文件内容
+type Document struct {
+	Title      string     `json:"title"`
+	ChatType   int64      `json:"chatType"`
+	OwnerId    uint64     `json:"ownerId,omitempty"`
+	PeerId     uint64     `json:"peerId,omitempty"`
+	GroupId    string     `json:"groupId,omitempty"`
+	StartTime  *time.Time `json:"startTime,omitempty"`
+	EndTime    *time.Time `json:"endTime,omitempty"`
+	ExportedAt time.Time  `json:"exportedAt"`
+	Truncated  bool       `json:"truncated"` // 超过单次导出上限，只包含最近的消息
+	Messages   []Record   `json:"messages"`
+}
+
+// newRecord 转换为导出记录，撤回、删除、销毁、已清理的消息和阅后即焚消息不导出原始内容
+func newRecord(msg *model.ImMessage, senderName string, now time.Time) Record {
+	r := Record{
+		Id:          msg.Id,
+		MsgId:       msg.MsgId,
+		Seq:         msg.Seq,
+		SenderId:    msg.FromUserId,
+		SenderName:  senderName,
+		ContentType: msg.ContentType,
+		Status:      msg.Status,
+		CreatedAt:   msg.CreatedAt,
+	}
+
+	switch {
+	case msg.Status == 2:
+		r.Text = "[消息已撤回]"
+		return r
+	case msg.Status == 3:
+		r.Text = "[消息已删除]"
+		return r
+	case msg.Status == 4 || (msg.ExpireAt.Valid && !msg.ExpireAt.Time.After(now)):
+		r.Text = "[消息已销毁]"
+		return r
+	case msg.Status == 5:
+		r.Text = "[消息已超过保留期限]"
+		return r
+	case msg.ExpireMode != 0:
+		// 阅后即焚消息与收藏、置顶一样不允许留存副本，未到期也不导出原文
+		r.Text = "[阅后即焚消息]"
+		return r
+	}
+
+	r.Content = msg.Content
+	r.Text = msg.Content
+	p := payload.Parse(int32(msg.ContentType), msg.Content)
+	switch {
+	case p == nil:
+	case p.Image != nil:
+		r.Url = p.Image.Url
+		r.Text = "[图片] " + p.Image.Url
+	case p.File != nil:
+		r.Url, r.FileName = p.File.Url, p.File.Name
+		r.Text = fmt.Sprintf("[文件] %s %s", p.File.Name, p.File.Url)
+	case p.Voice != nil:
+		r.Url = p.Voice.Url
+		r.Text = fmt.Sprintf("[语音 %d秒] %s", p.Voice.Duration, p.Voice.Url)
+	case p.Video != nil:
+		r.Url = p.Video.Url
+		r.Text = fmt.Sprintf("[视频 %d秒] %s", p.Video.Duration, p.Video.Url)
+	case p.Location != nil:
+		r.Text = fmt.Sprintf("[位置] %s %s (%f, %f)", p.Location.Name, p.Location.Address, p.Location.Latitude, p.Location.Longitude)
+	case p.Contact != nil:
+		r.Text = fmt.Sprintf("[名片] %s (ID: %d)", p.Contact.Nickname, p.Contact.UserId)
+	case p.Poll != nil:
+		r.Text = fmt.Sprintf("[投票] %s（%s）", p.Poll.Question, strings.Join(p.Poll.Options, " / "))
+	case p.System != nil:
+		r.Text = "[系统消息] " + p.System.Text
+	}
+	return r
+}
+
+// render 按格式渲染导出文件，返回内容及 MIME 类型
+func render(format string, doc *Document) ([]byte, string, error) {
+	var (
+		buf bytes.Buffer
+		err error
+	)
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(doc)
+	case FormatHTML:
+		err = htmlTemplate.Execute(&buf, doc)
+	case FormatText:
+		renderText(&buf, doc)
+	default:
+		err = fmt.Errorf("不支持的导出格式: %s", format)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), formatInfo[format].contentType, nil
+}
+
+// renderText 纯文本格式，每条消息一行: [时间] 发送者: 内容
+func renderText(buf *bytes.Buffer, doc *Document) {
+	fmt.Fprintf(buf, "%s\n", doc.Title)
+	fmt.Fprintf(buf, "导出时间: %s\n", doc.ExportedAt.Format(timeLayout))
+	fmt.Fprintf(buf, "时间范围: %s\n", timeRange(doc))
+	fmt.Fprintf(buf, "消息条数: %d\n", len(doc.Messages))
+	if doc.Truncated {
+		buf.WriteString("注意: 超过单次导出上限，仅包含最近的消息\n")
+	}
+	buf.WriteString("\n")
+
+	for _, r := range doc.Messages {
+		fmt.Fprintf(buf, "[%s] %s: %s\n", r.CreatedAt.Format(timeLayout), r.SenderName, r.Text)
+	}
+}
+
+// timeRange 时间范围描述
+func timeRange(doc *Document) string {
+	start, end := "不限", "不限"
+	if doc.StartTime != nil {
+		start = doc.StartTime.Format(timeLayout)
+	}
+	if doc.EndTime != nil {
+		end = doc.EndTime.Format(timeLayout)
+	}
+	return start + " ~ " + end
+}
+
+// htmlTemplate 自包含的 HTML 页面（内联样式，不依赖外部资源）
+var htmlTemplate = template.Must(template.New("export").Funcs(template.FuncMap{
+	"fmtTime":   func(t time.Time) string { return t.Format(timeLayout) },
+	"timeRange": timeRange,
+}).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; padding: 24px; background: #f5f5f5; font-family: -apple-system, "PingFang SC", "Microsoft YaHei", sans-serif; color: #333; }
+.container { max-width: 860px; margin: 0 auto; background: #fff; border-radius: 8px; padding: 24px; }
+h1 { font-size: 20px; margin: 0 0 8px; }
+.meta { color: #888; font-size: 13px; line-height: 1.8; border-bottom: 1px solid #eee; padding-bottom: 12px; margin-bottom: 12px; }
+.warn { color: #d46b08; }
+.msg { padding: 10px 0; border-bottom: 1px solid #f5f5f5; }
+.msg .head { font-size: 13px; color: #888; }
+.msg .sender { color: #1677ff; font-weight: 600; margin-right: 8px; }
+.msg .body { margin-top: 4px; white-space: pre-wrap; word-break: break-word; }
+.msg .body.system { color: #aaa; font-style: italic; }
+.msg img { max-width: 240px; border-radius: 4px; display: block; margin-top: 4px; }
+</style>
+</head>
+<body>
+<div class="container">
+<h1>{{.Title}}</h1>
+<div class="meta">
+<div>导出时间：{{fmtTime .ExportedAt}}</div>
+<div>时间范围：{{timeRange .}}</div>
+<div>消息条数：{{len .Messages}}</div>
+{{if .Truncated}}<div class="warn">超过单次导出上限，仅包含最近的消息</div>{{end}}
+</div>
+{{range .Messages}}<div class="msg">
+<div class="head"><span class="sender">{{.SenderName}}</span>{{fmtTime .CreatedAt}}</div>
+{{if and (eq .ContentType 2) .Url}}<div class="body"><a href="{{.Url}}" target="_blank" rel="noopener"><img src="{{.Url}}" alt="图片"></a></div>
+{{else if and (eq .ContentType 3) .Url}}<div class="body">[文件] <a href="{{.Url}}" target="_blank" rel="noopener">{{.FileName}}</a></div>
+{{else if eq .Content ""}}<div class="body system">{{.Text}}</div>
+{{else}}<div class="body">{{.Text}}</div>
+{{end}}</div>
+{{end}}</div>
+</body>
+</html>
+`))
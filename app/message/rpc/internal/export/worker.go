@@ -0,0 +1,309 @@
+package export
+
+// worker.go - 聊天记录导出任务
+//
+// 定时扫描排队中的导出任务：
+// 1. 条件更新领取任务（排队中 -> 导出中），多实例部署时每个任务只会被一个实例处理
+// 2. 按消息ID倒序分页读取会话消息，直到早于起始时间或达到单次导出上限
+// 3. 通过 UserRpc.BatchGetUsers 解析发送者昵称，渲染为 JSON / HTML / 纯文本
+// 4. 上传到对象存储，通过 WebSocket 通知发起者下载链接
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"SkyeIM/app/group/rpc/groupclient"
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/user/rpc/userClient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// EventExportReady 导出任务完成（成功或失败）通知
+	EventExportReady = "export_ready"
+
+	// pageSize 每次读取的消息条数
+	pageSize = 500
+	// userBatchSize BatchGetUsers 单次最多查询的用户数
+	userBatchSize = 100
+	// scanBatch 每次扫描领取的任务数
+	scanBatch = 10
+	// staleRunningTimeout 导出中超过该时长视为实例异常退出，恢复为排队中
+	staleRunningTimeout = 30 * time.Minute
+	// jobTimeout 单个任务的最长执行时间
+	jobTimeout = 20 * time.Minute
+)
+
+type Worker struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+func NewWorker(svcCtx *svc.ServiceContext) *Worker {
+	return &Worker{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Export.ScanInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动扫描循环（阻塞，由 ServiceGroup 管理）
+func (w *Worker) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.scan()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+// scan 领取并处理一批排队中的任务
+func (w *Worker) scan() {
+	ctx := context.Background()
+
+	if n, err := w.svcCtx.ImExportJobModel.ResetStale(ctx, time.Now().Add(-staleRunningTimeout)); err != nil {
+		logx.Errorf("[Export] 恢复超时导出任务失败: %v", err)
+	} else if n > 0 {
+		logx.Infof("[Export] 恢复 %d 个超时未完成的导出任务", n)
+	}
+
+	jobs, err := w.svcCtx.ImExportJobModel.FindPending(ctx, scanBatch)
+	if err != nil {
+		logx.Errorf("[Export] 查询排队中的导出任务失败: %v", err)
+		return
+	}
+
+	for _, job := range jobs {
+		claimed, err := w.svcCtx.ImExportJobModel.Claim(ctx, job)
+		if err != nil {
+			logx.Errorf("[Export] 领取导出任务失败: id=%d, err=%v", job.Id, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		w.process(ctx, job)
+	}
+}
+
+// process 执行单个导出任务并记录结果
+func (w *Worker) process(ctx context.Context, job *model.ImExportJob) {
+	jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+	defer cancel()
+
+	job.Status = model.ExportStatusRunning
+	err := w.export(jobCtx, job)
+	if err != nil {
+		logx.Errorf("[Export] 导出失败: id=%d, err=%v", job.Id, err)
+		job.Status = model.ExportStatusFailed
+		job.FailReason = err.Error()
+		if len(job.FailReason) > 255 {
+			job.FailReason = job.FailReason[:255]
+		}
+	} else {
+		job.Status = model.ExportStatusDone
+		job.FailReason = ""
+	}
+
+	if err := w.svcCtx.ImExportJobModel.Update(ctx, job); err != nil {
+		logx.Errorf("[Export] 更新导出任务状态失败: id=%d, err=%v", job.Id, err)
+		return
+	}
+
+	data := map[string]interface{}{
+		"id":           job.Id,
+		"status":       job.Status,
+		"messageCount": job.MessageCount,
+		"fileSize":     job.FileSize,
+		"failReason":   job.FailReason,
+	}
+	if job.Status == model.ExportStatusDone {
+		url, expireAt, err := w.svcCtx.ExportStorage.DownloadURL(ctx, job.ObjectName, FileName(job))
+		if err != nil {
+			logx.Errorf("[Export] 生成下载链接失败: id=%d, err=%v", job.Id, err)
+		} else {
+			data["downloadUrl"] = url
+			data["urlExpireAt"] = expireAt.Unix()
+		}
+	}
+	if err := w.svcCtx.WsPushClient.PushToUser(int64(job.UserId), EventExportReady, data); err != nil {
+		logx.Errorf("[Export] 推送导出结果失败: id=%d, err=%v", job.Id, err)
+	}
+}
+
+// export 读取消息、渲染并上传，成功后回填文件信息
+func (w *Worker) export(ctx context.Context, job *model.ImExportJob) error {
+	messages, truncated, err := w.collect(ctx, job)
+	if err != nil {
+		return errors.New("读取聊天记录失败")
+	}
+
+	senderIds := make([]uint64, 0, len(messages)+2)
+	for _, msg := range messages {
+		senderIds = append(senderIds, msg.FromUserId)
+	}
+	if job.ChatType == 1 {
+		senderIds = append(senderIds, job.OwnerId, job.PeerId)
+	}
+	names := w.resolveNames(ctx, senderIds)
+
+	now := time.Now()
+	doc := &Document{
+		Title:      w.title(ctx, job, names),
+		ChatType:   job.ChatType,
+		GroupId:    job.GroupId,
+		ExportedAt: now,
+		Truncated:  truncated,
+		Messages:   make([]Record, 0, len(messages)),
+	}
+	if job.ChatType == 1 {
+		doc.OwnerId, doc.PeerId = job.OwnerId, job.PeerId
+	}
+	if job.StartTime.Valid {
+		doc.StartTime = &job.StartTime.Time
+	}
+	if job.EndTime.Valid {
+		doc.EndTime = &job.EndTime.Time
+	}
+	for _, msg := range messages {
+		doc.Messages = append(doc.Messages, newRecord(msg, names[msg.FromUserId], now))
+	}
+
+	content, contentType, err := render(job.Format, doc)
+	if err != nil {
+		logx.Errorf("[Export] 渲染导出文件失败: id=%d, err=%v", job.Id, err)
+		return errors.New("生成导出文件失败")
+	}
+
+	objectName := fmt.Sprintf("%d/%s", job.UserId, FileName(job))
+	size, err := w.svcCtx.ExportStorage.Put(ctx, objectName, contentType, content)
+	if err != nil {
+		logx.Errorf("[Export] 上传导出文件失败: id=%d, err=%v", job.Id, err)
+		return errors.New("保存导出文件失败")
+	}
+
+	job.ObjectName = objectName
+	job.FileSize = size
+	job.MessageCount = int64(len(doc.Messages))
+	return nil
+}
+
+// collect 按ID倒序分页读取时间范围内的消息，返回按时间正序排列的结果
+// 超过单次导出上限时只保留最近的消息，truncated 为 true
+func (w *Worker) collect(ctx context.Context, job *model.ImExportJob) ([]*model.ImMessage, bool, error) {
+	maxMessages := w.svcCtx.Config.Export.MaxMessages
+	var (
+		collected []*model.ImMessage
+		lastMsgId int64
+	)
+
+	for {
+		var (
+			page []*model.ImMessage
+			err  error
+		)
+		if job.ChatType == 2 {
+			page, err = w.svcCtx.ImMessageModel.FindGroupMessageList(ctx, job.GroupId, lastMsgId, pageSize)
+		} else {
+			page, err = w.svcCtx.ImMessageModel.FindPrivateMessageList(ctx, int64(job.OwnerId), int64(job.PeerId), lastMsgId, pageSize)
+		}
+		if err != nil {
+			logx.Errorf("[Export] 分页读取消息失败: id=%d, lastMsgId=%d, err=%v", job.Id, lastMsgId, err)
+			return nil, false, err
+		}
+
+		for _, msg := range page {
+			if job.StartTime.Valid && msg.CreatedAt.Before(job.StartTime.Time) {
+				return reverse(collected), false, nil
+			}
+			if job.EndTime.Valid && !msg.CreatedAt.Before(job.EndTime.Time) {
+				continue
+			}
+			// 已删除的消息不导出
+			if msg.Status == 3 {
+				continue
+			}
+			if len(collected) >= maxMessages {
+				return reverse(collected), true, nil
+			}
+			collected = append(collected, msg)
+		}
+
+		if len(page) < pageSize {
+			return reverse(collected), false, nil
+		}
+		lastMsgId = int64(page[len(page)-1].Id)
+	}
+}
+
+// resolveNames 批量查询用户昵称，查询失败或无昵称时使用用户名/用户ID
+func (w *Worker) resolveNames(ctx context.Context, ids []uint64) map[uint64]string {
+	names := make(map[uint64]string)
+	var pending []int64
+	for _, id := range ids {
+		if _, ok := names[id]; ok {
+			continue
+		}
+		names[id] = fmt.Sprintf("用户%d", id)
+		pending = append(pending, int64(id))
+	}
+
+	for start := 0; start < len(pending); start += userBatchSize {
+		end := start + userBatchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		resp, err := w.svcCtx.UserRpc.BatchGetUsers(ctx, &userClient.BatchGetUsersRequest{Ids: pending[start:end]})
+		if err != nil {
+			logx.Errorf("[Export] 批量查询用户失败: %v", err)
+			continue
+		}
+		for _, u := range resp.Users {
+			switch {
+			case u.Nickname != "":
+				names[uint64(u.Id)] = u.Nickname
+			case u.Username != "":
+				names[uint64(u.Id)] = u.Username
+			}
+		}
+	}
+	return names
+}
+
+// title 导出文件标题
+func (w *Worker) title(ctx context.Context, job *model.ImExportJob, names map[uint64]string) string {
+	if job.ChatType == 1 {
+		return fmt.Sprintf("%s 与 %s 的聊天记录", names[job.OwnerId], names[job.PeerId])
+	}
+
+	name := job.GroupId
+	resp, err := w.svcCtx.GroupRpc.GetGroupInfo(ctx, &groupclient.GetGroupInfoReq{GroupId: job.GroupId, UserId: int64(job.UserId)})
+	if err != nil {
+		logx.Errorf("[Export] 查询群组信息失败: groupId=%s, err=%v", job.GroupId, err)
+	} else if resp.Group != nil && resp.Group.Name != "" {
+		name = resp.Group.Name
+	}
+	return fmt.Sprintf("群聊「%s」的聊天记录", name)
+}
+
+func reverse(messages []*model.ImMessage) []*model.ImMessage {
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	return messages
+}
@@ -0,0 +1,144 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AddFavoriteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddFavoriteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddFavoriteLogic {
+	return &AddFavoriteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 收藏消息（保存内容快照，重复收藏返回已有的收藏）
+func (l *AddFavoriteLogic) AddFavorite(in *message.AddFavoriteReq) (*message.AddFavoriteResp, error) {
+	if in.UserId == 0 || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	tags, err := encodeFavoriteTags(in.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFavoriteNote(in.Note); err != nil {
+		return nil, err
+	}
+
+	// 重复收藏返回已有的收藏
+	existing, err := l.svcCtx.ImFavoriteModel.FindOneByUserIdMsgId(l.ctx, uint64(in.UserId), in.MsgId)
+	if err == nil {
+		return &message.AddFavoriteResp{Info: toFavoriteInfo(existing)}, nil
+	}
+	if err != model.ErrNotFound {
+		l.Logger.Errorf("查询收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	msg, err := l.svcCtx.ImMessageModel.FindOneByMsgId(l.ctx, in.MsgId)
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "消息不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	fav := &model.ImFavorite{
+		UserId:       uint64(in.UserId),
+		MsgId:        msg.MsgId,
+		MessageId:    msg.Id,
+		ChatType:     msg.ChatType,
+		Seq:          msg.Seq,
+		FromUserId:   msg.FromUserId,
+		ContentType:  msg.ContentType,
+		Content:      msg.Content,
+		Tags:         tags,
+		Note:         in.Note,
+		MsgCreatedAt: msg.CreatedAt,
+	}
+	if err := l.checkVisible(in.UserId, msg, fav); err != nil {
+		return nil, err
+	}
+	if err := checkSnapshotable(msg, "收藏"); err != nil {
+		return nil, err
+	}
+
+	count, err := l.svcCtx.ImFavoriteModel.CountByUser(l.ctx, fav.UserId)
+	if err != nil {
+		l.Logger.Errorf("统计收藏数量失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if count >= maxFavorites {
+		return nil, status.Error(codes.ResourceExhausted, "收藏数量已达上限")
+	}
+
+	result, err := l.svcCtx.ImFavoriteModel.Insert(l.ctx, fav)
+	if err != nil {
+		// 并发重复收藏时唯一索引冲突，返回已写入的收藏
+		if existing, findErr := l.svcCtx.ImFavoriteModel.FindOneByUserIdMsgId(l.ctx, fav.UserId, fav.MsgId); findErr == nil {
+			return &message.AddFavoriteResp{Info: toFavoriteInfo(existing)}, nil
+		}
+		l.Logger.Errorf("保存收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "收藏失败")
+	}
+	id, _ := result.LastInsertId()
+
+	inserted, err := l.svcCtx.ImFavoriteModel.FindOne(l.ctx, uint64(id))
+	if err != nil {
+		l.Logger.Errorf("查询收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	info := toFavoriteInfo(inserted)
+	pushFavoriteSync(l.ctx, l.svcCtx, in.UserId, "add", info, nil)
+
+	return &message.AddFavoriteResp{Info: info}, nil
+}
+
+// checkVisible 只能收藏自己能看到的消息：私聊的收发双方，群聊中入群之后的消息
+func (l *AddFavoriteLogic) checkVisible(userId int64, msg *model.ImMessage, fav *model.ImFavorite) error {
+	switch msg.ChatType {
+	case 1:
+		switch uint64(userId) {
+		case msg.FromUserId:
+			fav.PeerId = msg.ToUserId
+		case msg.ToUserId:
+			fav.PeerId = msg.FromUserId
+		default:
+			return status.Error(codes.PermissionDenied, "无权收藏该消息")
+		}
+	case 2:
+		fav.GroupId = msg.GroupId.String
+		checkResp, err := l.svcCtx.GroupRpc.CheckMembership(l.ctx, &group.CheckMembershipReq{
+			GroupId: fav.GroupId,
+			UserId:  userId,
+		})
+		if err != nil {
+			l.Logger.Errorf("检查成员资格失败: %v", err)
+			return status.Error(codes.Internal, "检查成员失败")
+		}
+		if !checkResp.IsMember || msg.CreatedAt.Before(time.Unix(checkResp.Member.JoinedAt, 0)) {
+			return status.Error(codes.PermissionDenied, "无权收藏该消息")
+		}
+	default:
+		return status.Error(codes.PermissionDenied, "无权收藏该消息")
+	}
+	return nil
+}
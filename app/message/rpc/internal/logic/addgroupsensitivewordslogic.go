@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/moderation"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AddGroupSensitiveWordsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddGroupSensitiveWordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddGroupSensitiveWordsLogic {
+	return &AddGroupSensitiveWordsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 添加群自定义敏感词（需要内容审核权限，已存在时更新处理动作）
+func (l *AddGroupSensitiveWordsLogic) AddGroupSensitiveWords(in *message.GroupSensitiveWordsReq) (*message.GroupSensitiveWordsResp, error) {
+	if in.UserId == 0 || in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	action := moderation.Action(in.Action)
+	if action == moderation.ActionPass {
+		action = moderation.ActionMask
+	}
+	if action < moderation.ActionMask || action > moderation.ActionBlock {
+		return nil, status.Error(codes.InvalidArgument, "处理动作无效")
+	}
+	words, err := normalizeSensitiveWords(in.Words)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGroupPermission(l.ctx, l.svcCtx, in.GroupId, in.UserId, groupperm.Moderate); err != nil {
+		return nil, err
+	}
+
+	count, err := l.svcCtx.ImGroupSensitiveWordModel.CountByGroup(l.ctx, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("统计群敏感词失败: %v", err)
+		return nil, status.Error(codes.Internal, "添加敏感词失败")
+	}
+
+	for _, word := range words {
+		existing, err := l.svcCtx.ImGroupSensitiveWordModel.FindOneByGroupIdWord(l.ctx, in.GroupId, word)
+		switch err {
+		case nil:
+			// 已存在时更新处理动作
+			if existing.Action != int64(action) {
+				existing.Action = int64(action)
+				if err := l.svcCtx.ImGroupSensitiveWordModel.Update(l.ctx, existing); err != nil {
+					l.Logger.Errorf("更新群敏感词失败: %v", err)
+					return nil, status.Error(codes.Internal, "添加敏感词失败")
+				}
+			}
+			continue
+		case model.ErrNotFound:
+		default:
+			l.Logger.Errorf("查询群敏感词失败: %v", err)
+			return nil, status.Error(codes.Internal, "添加敏感词失败")
+		}
+
+		if count >= int64(l.svcCtx.Config.Moderation.MaxGroupWords) {
+			return nil, status.Errorf(codes.FailedPrecondition, "每个群最多添加%d个敏感词", l.svcCtx.Config.Moderation.MaxGroupWords)
+		}
+		if _, err := l.svcCtx.ImGroupSensitiveWordModel.Insert(l.ctx, &model.ImGroupSensitiveWord{
+			GroupId:   in.GroupId,
+			Word:      word,
+			Action:    int64(action),
+			CreatedBy: uint64(in.UserId),
+		}); err != nil && !isDuplicateKeyErr(err) {
+			l.Logger.Errorf("添加群敏感词失败: %v", err)
+			return nil, status.Error(codes.Internal, "添加敏感词失败")
+		}
+		count++
+	}
+
+	l.svcCtx.WordFilter.InvalidateGroup(in.GroupId)
+	return listGroupSensitiveWords(l.ctx, l.svcCtx, in.GroupId)
+}
@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CancelScheduledMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelScheduledMessageLogic {
+	return &CancelScheduledMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 取消待发送的定时消息
+func (l *CancelScheduledMessageLogic) CancelScheduledMessage(in *message.CancelScheduledMessageReq) (*message.CancelScheduledMessageResp, error) {
+	if in.Id == 0 || in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	data, err := l.svcCtx.ImScheduledMessageModel.FindOne(l.ctx, uint64(in.Id))
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "定时消息不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if data.FromUserId != uint64(in.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "无权取消该定时消息")
+	}
+
+	canceled, err := l.svcCtx.ImScheduledMessageModel.CancelPending(l.ctx, data)
+	if err != nil {
+		l.Logger.Errorf("取消定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "取消定时消息失败")
+	}
+	if !canceled {
+		return nil, status.Error(codes.FailedPrecondition, "定时消息已发送或已取消")
+	}
+
+	return &message.CancelScheduledMessageResp{}, nil
+}
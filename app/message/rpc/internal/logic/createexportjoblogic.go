@@ -0,0 +1,125 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/export"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateExportJobLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateExportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateExportJobLogic {
+	return &CreateExportJobLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建聊天记录导出任务
+func (l *CreateExportJobLogic) CreateExportJob(in *message.CreateExportJobReq) (*message.CreateExportJobResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if !export.ValidFormat(in.Format) {
+		return nil, status.Error(codes.InvalidArgument, "不支持的导出格式")
+	}
+	if in.StartTime < 0 || in.EndTime < 0 || (in.StartTime > 0 && in.EndTime > 0 && in.StartTime >= in.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "时间范围错误")
+	}
+
+	auditor := isExportAuditor(l.svcCtx, in.UserId)
+	job := &model.ImExportJob{
+		UserId:   uint64(in.UserId),
+		OwnerId:  uint64(in.UserId),
+		ChatType: int64(in.ChatType),
+		Format:   in.Format,
+		Status:   model.ExportStatusPending,
+	}
+	if in.StartTime > 0 {
+		job.StartTime = sql.NullTime{Time: time.Unix(in.StartTime, 0), Valid: true}
+	}
+	if in.EndTime > 0 {
+		job.EndTime = sql.NullTime{Time: time.Unix(in.EndTime, 0), Valid: true}
+	}
+
+	switch in.ChatType {
+	case 1:
+		if in.PeerId == 0 {
+			return nil, status.Error(codes.InvalidArgument, "私聊对象不能为空")
+		}
+		// 导出他人的私聊记录需要审计权限
+		if in.OwnerId > 0 && in.OwnerId != in.UserId {
+			if !auditor {
+				return nil, status.Error(codes.PermissionDenied, "无权导出该会话")
+			}
+			job.OwnerId = uint64(in.OwnerId)
+		}
+		job.PeerId = uint64(in.PeerId)
+	case 2:
+		if in.GroupId == "" {
+			return nil, status.Error(codes.InvalidArgument, "群组ID不能为空")
+		}
+		job.GroupId = in.GroupId
+		if !auditor {
+			checkResp, err := l.svcCtx.GroupRpc.CheckMembership(l.ctx, &group.CheckMembershipReq{
+				GroupId: in.GroupId,
+				UserId:  in.UserId,
+			})
+			if err != nil {
+				l.Logger.Errorf("检查成员资格失败: %v", err)
+				return nil, status.Error(codes.Internal, "检查成员失败")
+			}
+			if !checkResp.IsMember {
+				return nil, status.Error(codes.PermissionDenied, "您不是群成员")
+			}
+			// 普通成员只能导出入群之后的消息
+			joinedAt := time.Unix(checkResp.Member.JoinedAt, 0)
+			if !job.StartTime.Valid || job.StartTime.Time.Before(joinedAt) {
+				job.StartTime = sql.NullTime{Time: joinedAt, Valid: true}
+			}
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "聊天类型错误")
+	}
+
+	unfinished, err := l.svcCtx.ImExportJobModel.CountUnfinishedByUser(l.ctx, job.UserId)
+	if err != nil {
+		l.Logger.Errorf("统计导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if unfinished >= maxUnfinishedExports {
+		return nil, status.Error(codes.ResourceExhausted, "导出任务过多，请等待当前任务完成")
+	}
+
+	result, err := l.svcCtx.ImExportJobModel.Insert(l.ctx, job)
+	if err != nil {
+		l.Logger.Errorf("创建导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建导出任务失败")
+	}
+	id, _ := result.LastInsertId()
+
+	inserted, err := l.svcCtx.ImExportJobModel.FindOne(l.ctx, uint64(id))
+	if err != nil {
+		l.Logger.Errorf("查询导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	return &message.CreateExportJobResp{
+		Info: toExportJobInfo(l.ctx, l.svcCtx, inserted),
+	}, nil
+}
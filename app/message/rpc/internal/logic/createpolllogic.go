@@ -0,0 +1,164 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/poll"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreatePollLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreatePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePollLogic {
+	return &CreatePollLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 发起群投票（写入一条投票消息）
+func (l *CreatePollLogic) CreatePoll(in *message.CreatePollReq) (*message.CreatePollResp, error) {
+	if in.UserId == 0 || in.GroupId == "" || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	question, options, err := checkPollDefinition(in.Question, in.Options, in.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	content, err := (&payload.Poll{
+		Question:  question,
+		Options:   options,
+		Multiple:  in.Multiple,
+		Anonymous: in.Anonymous,
+		Deadline:  in.Deadline,
+	}).Encode()
+	if err != nil {
+		l.Logger.Errorf("序列化投票消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	// 投票消息与普通群消息走相同的发送流程（成员、禁言校验，Seq、未读数）
+	sendResp, err := NewSendGroupMessageLogic(l.ctx, l.svcCtx).send(&message.SendGroupMessageReq{
+		MsgId:       in.MsgId,
+		FromUserId:  in.UserId,
+		GroupId:     in.GroupId,
+		Content:     content,
+		ContentType: payload.TypePoll,
+	}, payload.TypePoll)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重试请求：msg_id 必须对应一条投票消息
+	if sendResp.Duplicate {
+		existing, err := findExistingMessage(l.ctx, l.svcCtx, model.GroupConversationKey(in.GroupId), in.MsgId)
+		if err != nil || existing == nil {
+			l.Logger.Errorf("查询投票消息失败: %v", err)
+			return nil, status.Error(codes.Internal, "系统错误")
+		}
+		if existing.ContentType != payload.TypePoll {
+			return nil, errMsgIdConflict
+		}
+	}
+
+	p, created, err := l.savePoll(in, question, options)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := poll.BuildInfo(l.ctx, l.svcCtx, p, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("汇总投票结果失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	// 投票记录写入后再推送消息，群成员收到消息时即可投票
+	if created {
+		if err := l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "group_chat", map[string]interface{}{
+			"msgId":       in.MsgId,
+			"fromUserId":  in.UserId,
+			"groupId":     in.GroupId,
+			"content":     content,
+			"contentType": payload.TypePoll,
+			"createdAt":   sendResp.CreatedAt,
+			"seq":         sendResp.Seq,
+			"payload":     payload.Parse(payload.TypePoll, content),
+		}); err != nil {
+			l.Logger.Errorf("推送投票消息失败: msgId=%s, err=%v", in.MsgId, err)
+		}
+	}
+
+	return &message.CreatePollResp{
+		Id:        sendResp.Id,
+		MsgId:     sendResp.MsgId,
+		CreatedAt: sendResp.CreatedAt,
+		Seq:       sendResp.Seq,
+		Duplicate: sendResp.Duplicate,
+		Poll:      info,
+	}, nil
+}
+
+// savePoll 写入投票记录，重试时返回已有的投票；created 表示本次是否新写入
+func (l *CreatePollLogic) savePoll(in *message.CreatePollReq, question string, options []string) (*model.ImPoll, bool, error) {
+	existing, err := l.svcCtx.ImPollModel.FindOneByMsgId(l.ctx, in.MsgId)
+	if err == nil {
+		return existing, false, nil
+	}
+	if err != model.ErrNotFound {
+		l.Logger.Errorf("查询投票失败: %v", err)
+		return nil, false, status.Error(codes.Internal, "系统错误")
+	}
+
+	optionsJSON, _ := json.Marshal(options)
+	data := &model.ImPoll{
+		MsgId:     in.MsgId,
+		GroupId:   in.GroupId,
+		CreatorId: uint64(in.UserId),
+		Question:  question,
+		Options:   string(optionsJSON),
+		Status:    model.PollStatusOpen,
+	}
+	if in.Multiple {
+		data.Multiple = 1
+	}
+	if in.Anonymous {
+		data.Anonymous = 1
+	}
+	if in.Deadline > 0 {
+		data.Deadline = sql.NullTime{Time: time.Unix(in.Deadline, 0), Valid: true}
+	}
+
+	if _, err := l.svcCtx.ImPollModel.Insert(l.ctx, data); err != nil {
+		// 并发重试：另一个请求已先写入
+		if isDuplicateKeyErr(err) {
+			if existing, findErr := l.svcCtx.ImPollModel.FindOneByMsgId(l.ctx, in.MsgId); findErr == nil {
+				return existing, false, nil
+			}
+		}
+		l.Logger.Errorf("保存投票失败: %v", err)
+		return nil, false, status.Error(codes.Internal, "发起投票失败")
+	}
+
+	p, err := l.svcCtx.ImPollModel.FindOneByMsgId(l.ctx, in.MsgId)
+	if err != nil {
+		l.Logger.Errorf("查询投票失败: %v", err)
+		return nil, false, status.Error(codes.Internal, "系统错误")
+	}
+	return p, true, nil
+}
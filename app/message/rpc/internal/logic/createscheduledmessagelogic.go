@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateScheduledMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateScheduledMessageLogic {
+	return &CreateScheduledMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建定时消息
+func (l *CreateScheduledMessageLogic) CreateScheduledMessage(in *message.CreateScheduledMessageReq) (*message.CreateScheduledMessageResp, error) {
+	if in.FromUserId == 0 || in.SendAt == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	switch in.ChatType {
+	case 1:
+		if in.ToUserId == 0 {
+			return nil, status.Error(codes.InvalidArgument, "接收者不能为空")
+		}
+	case 2:
+		if in.GroupId == "" {
+			return nil, status.Error(codes.InvalidArgument, "群组ID不能为空")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "聊天类型错误")
+	}
+
+	contentType := in.ContentType
+	if contentType == 0 {
+		contentType = payload.TypeText
+	}
+	if err := validatePayload(contentType, in.Content); err != nil {
+		return nil, err
+	}
+
+	sendAt, err := checkSendAt(in.SendAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 创建时先校验发送权限，投递时还会按正常发送流程再次校验（成员、禁言、好友关系）
+	if in.ChatType == 2 {
+		checkResp, err := l.svcCtx.GroupRpc.CheckMembership(l.ctx, &group.CheckMembershipReq{
+			GroupId: in.GroupId,
+			UserId:  in.FromUserId,
+		})
+		if err != nil {
+			l.Logger.Errorf("检查成员资格失败: %v", err)
+			return nil, status.Error(codes.Internal, "检查成员失败")
+		}
+		if !checkResp.IsMember {
+			return nil, status.Error(codes.PermissionDenied, "您不是群成员")
+		}
+		if containsAtAll(in.AtUserIds) && !groupperm.Has(checkResp.Permissions, groupperm.AtAll) {
+			return nil, status.Error(codes.PermissionDenied, "您没有@全体成员的权限")
+		}
+	} else {
+		if err := l.svcCtx.PrivatePolicy.CheckSend(l.ctx, in.FromUserId, in.ToUserId); err != nil {
+			return nil, err
+		}
+	}
+
+	pending, err := l.svcCtx.ImScheduledMessageModel.CountByUser(l.ctx, uint64(in.FromUserId), model.ScheduledStatusPending)
+	if err != nil {
+		l.Logger.Errorf("统计定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if pending >= maxPendingScheduled {
+		return nil, status.Error(codes.ResourceExhausted, "待发送的定时消息过多")
+	}
+
+	var atUserIds sql.NullString
+	if in.ChatType == 2 && len(in.AtUserIds) > 0 {
+		if b, err := json.Marshal(in.AtUserIds); err == nil {
+			atUserIds = sql.NullString{String: string(b), Valid: true}
+		}
+	}
+
+	data := &model.ImScheduledMessage{
+		MsgId:       uuid.New().String(),
+		FromUserId:  uint64(in.FromUserId),
+		ChatType:    int64(in.ChatType),
+		Content:     in.Content,
+		ContentType: int64(contentType),
+		AtUserIds:   atUserIds,
+		SendAt:      sendAt,
+		Status:      model.ScheduledStatusPending,
+	}
+	if in.ChatType == 2 {
+		data.GroupId = in.GroupId
+	} else {
+		data.ToUserId = uint64(in.ToUserId)
+	}
+
+	result, err := l.svcCtx.ImScheduledMessageModel.Insert(l.ctx, data)
+	if err != nil {
+		l.Logger.Errorf("创建定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建定时消息失败")
+	}
+	id, _ := result.LastInsertId()
+
+	inserted, err := l.svcCtx.ImScheduledMessageModel.FindOne(l.ctx, uint64(id))
+	if err != nil {
+		l.Logger.Errorf("查询定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	return &message.CreateScheduledMessageResp{
+		Info: toScheduledMessageInfo(inserted),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteFavoriteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteFavoriteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteFavoriteLogic {
+	return &DeleteFavoriteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除收藏
+func (l *DeleteFavoriteLogic) DeleteFavorite(in *message.DeleteFavoriteReq) (*message.DeleteFavoriteResp, error) {
+	if in.UserId == 0 || len(in.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if len(in.Ids) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "一次最多删除100条收藏")
+	}
+
+	ids := make([]uint64, 0, len(in.Ids))
+	for _, id := range in.Ids {
+		if id > 0 {
+			ids = append(ids, uint64(id))
+		}
+	}
+
+	deleted, err := l.svcCtx.ImFavoriteModel.DeleteByIds(l.ctx, uint64(in.UserId), ids)
+	if err != nil {
+		l.Logger.Errorf("删除收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "删除收藏失败")
+	}
+
+	if len(deleted) > 0 {
+		deletedIds := make([]int64, 0, len(deleted))
+		for _, fav := range deleted {
+			deletedIds = append(deletedIds, int64(fav.Id))
+		}
+		pushFavoriteSync(l.ctx, l.svcCtx, in.UserId, "delete", nil, deletedIds)
+	}
+
+	return &message.DeleteFavoriteResp{Deleted: int64(len(deleted))}, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"database/sql"
+	"time"
+
+	"SkyeIM/common/rpcerr"
+
+	"google.golang.org/grpc/codes"
+)
+
+// 阅后即焚计时方式（与 im_message.expire_mode 一致）
+const (
+	expireModeNone      = 0
+	expireModeAfterSend = 1 // 发送后计时
+	expireModeAfterRead = 2 // 阅读后计时（仅私聊）
+
+	// maxExpireTtl 阅后即焚最长时长：7天
+	maxExpireTtl = 7 * 24 * 3600
+)
+
+// messageStatusBurned 消息已销毁（阅后即焚到期）
+const messageStatusBurned = 4
+
+// resolveExpiry 校验阅后即焚参数
+// 返回存储用的 ttl、mode，以及发送后计时消息的过期时间
+func resolveExpiry(ttl, mode int32, allowAfterRead bool) (uint64, int64, sql.NullTime, error) {
+	if ttl == 0 {
+		return 0, expireModeNone, sql.NullTime{}, nil
+	}
+	if ttl < 0 || ttl > maxExpireTtl {
+		return 0, 0, sql.NullTime{}, rpcerr.New(codes.InvalidArgument, "invalid_expire", "阅后即焚时长无效")
+	}
+
+	switch mode {
+	case expireModeNone, expireModeAfterSend:
+		expireAt := sql.NullTime{Time: time.Now().Add(time.Duration(ttl) * time.Second), Valid: true}
+		return uint64(ttl), expireModeAfterSend, expireAt, nil
+	case expireModeAfterRead:
+		if !allowAfterRead {
+			return 0, 0, sql.NullTime{}, rpcerr.New(codes.InvalidArgument, "invalid_expire", "群聊仅支持发送后计时")
+		}
+		// 阅读后计时：已读时再设置过期时间
+		return uint64(ttl), expireModeAfterRead, sql.NullTime{}, nil
+	default:
+		return 0, 0, sql.NullTime{}, rpcerr.New(codes.InvalidArgument, "invalid_expire", "过期计时方式无效")
+	}
+}
+
+// expireAtUnix 过期时间戳，未设置时为0
+func expireAtUnix(t sql.NullTime) int64 {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Unix()
+}
+
+// isExpired 消息是否已销毁或已到期（到期但尚未被过期任务处理的消息同样不能返回内容）
+func isExpired(status int64, expireAt sql.NullTime) bool {
+	return status == messageStatusBurned || (expireAt.Valid && !expireAt.Time.After(time.Now()))
+}
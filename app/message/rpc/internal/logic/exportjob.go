@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/export"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxUnfinishedExports 每个用户最多同时排队/进行中的导出任务数
+const maxUnfinishedExports = 3
+
+// isExportAuditor 是否为合规审计人员（可导出任意会话）
+func isExportAuditor(svcCtx *svc.ServiceContext, userId int64) bool {
+	for _, id := range svcCtx.Config.Export.AuditorIds {
+		if id == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// toExportJobInfo 导出任务转换为 RPC 返回结构，已完成的任务生成新的下载链接
+func toExportJobInfo(ctx context.Context, svcCtx *svc.ServiceContext, job *model.ImExportJob) *message.ExportJobInfo {
+	info := &message.ExportJobInfo{
+		Id:           int64(job.Id),
+		UserId:       int64(job.UserId),
+		OwnerId:      int64(job.OwnerId),
+		ChatType:     int32(job.ChatType),
+		PeerId:       int64(job.PeerId),
+		GroupId:      job.GroupId,
+		Format:       job.Format,
+		Status:       int32(job.Status),
+		FileSize:     job.FileSize,
+		MessageCount: job.MessageCount,
+		FailReason:   job.FailReason,
+		CreatedAt:    job.CreatedAt.Unix(),
+		UpdatedAt:    job.UpdatedAt.Unix(),
+	}
+	if job.StartTime.Valid {
+		info.StartTime = job.StartTime.Time.Unix()
+	}
+	if job.EndTime.Valid {
+		info.EndTime = job.EndTime.Time.Unix()
+	}
+
+	if job.Status == model.ExportStatusDone && job.ObjectName != "" {
+		url, expireAt, err := svcCtx.ExportStorage.DownloadURL(ctx, job.ObjectName, export.FileName(job))
+		if err != nil {
+			logx.WithContext(ctx).Errorf("生成导出文件下载链接失败: id=%d, err=%v", job.Id, err)
+		} else {
+			info.DownloadUrl = url
+			info.UrlExpireAt = expireAt.Unix()
+		}
+	}
+	return info
+}
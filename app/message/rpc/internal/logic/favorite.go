@@ -0,0 +1,135 @@
+package logic
+
+// favorite.go - 消息收藏的公共逻辑（标签校验、结构转换、多端同步）
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxFavorites 每个用户最多收藏的条数
+	maxFavorites = 5000
+	// maxFavoriteTags 每条收藏最多的标签数
+	maxFavoriteTags = 10
+	// maxFavoriteTagLen 标签最大长度（字符）
+	maxFavoriteTagLen = 20
+	// maxFavoriteNoteLen 备注最大长度（字符）
+	maxFavoriteNoteLen = 200
+
+	// eventFavoriteSync 收藏变更同步到用户的所有在线会话
+	eventFavoriteSync = "favorite_sync"
+)
+
+// encodeFavoriteTags 校验并序列化标签（去除首尾空格、去重，保持原有顺序）
+func encodeFavoriteTags(tags []string) (string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxFavoriteTagLen {
+			return "", status.Error(codes.InvalidArgument, "标签不能超过20个字符")
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	if len(result) > maxFavoriteTags {
+		return "", status.Error(codes.InvalidArgument, "标签不能超过10个")
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// decodeFavoriteTags 解析标签
+func decodeFavoriteTags(data string) []string {
+	var tags []string
+	if data != "" {
+		if err := json.Unmarshal([]byte(data), &tags); err != nil {
+			logx.Errorf("解析收藏标签失败: %s, err=%v", data, err)
+		}
+	}
+	return tags
+}
+
+// checkFavoriteNote 校验备注
+func checkFavoriteNote(note string) error {
+	if utf8.RuneCountInString(note) > maxFavoriteNoteLen {
+		return status.Error(codes.InvalidArgument, "备注不能超过200个字符")
+	}
+	return nil
+}
+
+// toFavoriteInfo 收藏转换为 RPC 返回结构
+func toFavoriteInfo(fav *model.ImFavorite) *message.FavoriteInfo {
+	return &message.FavoriteInfo{
+		Id:           int64(fav.Id),
+		MsgId:        fav.MsgId,
+		MessageId:    int64(fav.MessageId),
+		ChatType:     int32(fav.ChatType),
+		PeerId:       int64(fav.PeerId),
+		GroupId:      fav.GroupId,
+		Seq:          fav.Seq,
+		FromUserId:   int64(fav.FromUserId),
+		ContentType:  int32(fav.ContentType),
+		Content:      fav.Content,
+		Payload:      payload.Parse(int32(fav.ContentType), fav.Content),
+		Tags:         decodeFavoriteTags(fav.Tags),
+		Note:         fav.Note,
+		MsgCreatedAt: fav.MsgCreatedAt.Unix(),
+		CreatedAt:    fav.CreatedAt.Unix(),
+		UpdatedAt:    fav.UpdatedAt.Unix(),
+	}
+}
+
+// pushFavoriteSync 将收藏变更推送到用户的所有在线会话，失败只记录日志（客户端重连后重新拉取列表）
+// action: add/update 携带完整收藏，delete 携带被删除的收藏ID
+func pushFavoriteSync(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, action string, info *message.FavoriteInfo, ids []int64) {
+	data := map[string]interface{}{
+		"action": action,
+	}
+	if info != nil {
+		data["favorite"] = map[string]interface{}{
+			"id":           info.Id,
+			"msgId":        info.MsgId,
+			"messageId":    info.MessageId,
+			"chatType":     info.ChatType,
+			"peerId":       info.PeerId,
+			"groupId":      info.GroupId,
+			"seq":          info.Seq,
+			"fromUserId":   info.FromUserId,
+			"contentType":  info.ContentType,
+			"content":      info.Content,
+			"payload":      info.Payload,
+			"tags":         info.Tags,
+			"note":         info.Note,
+			"msgCreatedAt": info.MsgCreatedAt,
+			"createdAt":    info.CreatedAt,
+			"updatedAt":    info.UpdatedAt,
+		}
+	}
+	if ids != nil {
+		data["ids"] = ids
+	}
+
+	if err := svcCtx.WsPushClient.PushToUser(userId, eventFavoriteSync, data); err != nil {
+		logx.WithContext(ctx).Errorf("推送收藏同步失败: userId=%d, action=%s, err=%v", userId, action, err)
+	}
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FollowThreadLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFollowThreadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FollowThreadLogic {
+	return &FollowThreadLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 关注话题（有新回复时收到通知）
+func (l *FollowThreadLogic) FollowThread(in *message.FollowThreadReq) (*message.FollowThreadResp, error) {
+	if in.UserId == 0 || in.RootMsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	root, err := findThreadRoot(l.ctx, l.svcCtx, in.RootMsgId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkThreadRoot(root); err != nil {
+		return nil, err
+	}
+	if _, err := checkGroupMember(l.ctx, l.svcCtx, root.GroupId.String, in.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := followThread(l.ctx, l.svcCtx, root, in.UserId); err != nil {
+		l.Logger.Errorf("关注话题失败: %v", err)
+		return nil, status.Error(codes.Internal, "关注话题失败")
+	}
+
+	return &message.FollowThreadResp{Following: true}, nil
+}
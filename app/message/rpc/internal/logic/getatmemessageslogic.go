@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"encoding/json"
 
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
@@ -53,27 +52,9 @@ func (l *GetAtMeMessagesLogic) GetAtMeMessages(in *message.GetAtMeMessagesReq) (
 	// 转换为proto格式
 	list := make([]*message.MessageInfo, 0, len(messages))
 	for _, msg := range messages {
-		// 解析at_user_ids JSON
-		var atUserIds []int64
-		if msg.AtUserIds.Valid && msg.AtUserIds.String != "" {
-			_ = json.Unmarshal([]byte(msg.AtUserIds.String), &atUserIds)
-		}
-
-		list = append(list, &message.MessageInfo{
-			Id:          int64(msg.Id),
-			MsgId:       msg.MsgId,
-			FromUserId:  int64(msg.FromUserId),
-			ToUserId:    int64(msg.ToUserId),
-			ChatType:    int32(msg.ChatType),
-			GroupId:     msg.GroupId.String,
-			Content:     msg.Content,
-			ContentType: int32(msg.ContentType),
-			Status:      int32(msg.Status),
-			CreatedAt:   msg.CreatedAt.Unix(),
-			Seq:         msg.Seq,
-			AtUserIds:   atUserIds,
-		})
+		list = append(list, toMessageInfo(msg))
 	}
+	attachLinkPreviews(l.ctx, l.svcCtx, list)
 
 	return &message.GetAtMeMessagesResp{
 		List:    list,
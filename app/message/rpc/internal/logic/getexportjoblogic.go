@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetExportJobLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetExportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetExportJobLogic {
+	return &GetExportJobLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取导出任务详情（已完成时返回下载链接）
+func (l *GetExportJobLogic) GetExportJob(in *message.GetExportJobReq) (*message.GetExportJobResp, error) {
+	if in.Id == 0 || in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	job, err := l.svcCtx.ImExportJobModel.FindOne(l.ctx, uint64(in.Id))
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "导出任务不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if job.UserId != uint64(in.UserId) {
+		return nil, status.Error(codes.NotFound, "导出任务不存在")
+	}
+
+	return &message.GetExportJobResp{
+		Info: toExportJobInfo(l.ctx, l.svcCtx, job),
+	}, nil
+}
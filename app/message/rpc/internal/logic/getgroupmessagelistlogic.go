@@ -2,9 +2,10 @@ package logic
 
 import (
 	"context"
-	"encoding/json"
+	"time"
 
 	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/model"
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
 
@@ -48,49 +49,105 @@ func (l *GetGroupMessageListLogic) GetGroupMessageList(in *message.GetGroupMessa
 		}
 	}
 
-	limit := in.Limit
-	if limit <= 0 || limit > 100 {
-		limit = 20
+	if err := checkHistoryDirection(in.Direction); err != nil {
+		return nil, err
 	}
+	limit := historyLimit(in.Limit)
 
-	messages, err := l.svcCtx.ImMessageModel.FindGroupMessageList(l.ctx, in.GroupId, in.LastMsgId, int64(limit)+1)
+	var (
+		messages              []*model.ImMessage
+		hasMore, hasMoreAfter bool
+		err                   error
+	)
+	if in.Direction == historyBackward && in.AnchorMsgId == 0 && in.AnchorSeq == 0 && in.AnchorTime == 0 {
+		// 按消息ID向前分页
+		messages, err = l.svcCtx.ImMessageModel.FindGroupMessageList(l.ctx, in.GroupId, in.LastMsgId, limit+1)
+		if err == nil && int64(len(messages)) > limit {
+			hasMore = true
+			messages = messages[:limit]
+		}
+	} else {
+		messages, hasMore, hasMoreAfter, err = l.findFromAnchor(in, limit)
+	}
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		l.Logger.Errorf("查询群聊消息失败: %v", err)
 		return nil, status.Error(codes.Internal, "查询消息失败")
 	}
 
-	hasMore := false
-	if int64(len(messages)) > int64(limit) {
-		hasMore = true
-		messages = messages[:limit]
-	}
-
 	var list []*message.MessageInfo
 	for _, msg := range messages {
-		// 解析at_user_ids JSON
-		var atUserIds []int64
-		if msg.AtUserIds.Valid && msg.AtUserIds.String != "" {
-			_ = json.Unmarshal([]byte(msg.AtUserIds.String), &atUserIds)
-		}
-
-		list = append(list, &message.MessageInfo{
-			Id:          int64(msg.Id),
-			MsgId:       msg.MsgId,
-			FromUserId:  int64(msg.FromUserId),
-			ToUserId:    int64(msg.ToUserId),
-			ChatType:    int32(msg.ChatType),
-			GroupId:     msg.GroupId.String,
-			Content:     msg.Content,
-			ContentType: int32(msg.ContentType),
-			Status:      int32(msg.Status),
-			CreatedAt:   msg.CreatedAt.Unix(),
-			Seq:         msg.Seq,
-			AtUserIds:   atUserIds,
-		})
+		list = append(list, toMessageInfo(msg))
 	}
+	attachThreadSummaries(l.ctx, l.svcCtx, list)
+	attachLinkPreviews(l.ctx, l.svcCtx, list)
 
 	return &message.GetGroupMessageListResp{
-		List:    list,
-		HasMore: hasMore,
+		List:         list,
+		HasMore:      hasMore,
+		HasMoreAfter: hasMoreAfter,
 	}, nil
 }
+
+// findFromAnchor 从定位消息、定位Seq、定位时间或分页游标开始读取（走 idx_group_seq）
+func (l *GetGroupMessageListLogic) findFromAnchor(in *message.GetGroupMessageListReq, limit int64) ([]*model.ImMessage, bool, bool, error) {
+	var older, newer model.HistoryCursor
+	switch {
+	case in.AnchorMsgId > 0 || in.AnchorSeq > 0:
+		seq := in.AnchorSeq
+		if in.AnchorMsgId > 0 {
+			anchor, err := l.findAnchor(in.GroupId, in.AnchorMsgId)
+			if err != nil {
+				return nil, false, false, err
+			}
+			seq = anchor.Seq
+			older.CreatedAt, newer.CreatedAt = anchor.CreatedAt, anchor.CreatedAt
+		}
+		// 结果包含定位消息：只向前读取时归入更早的一侧，否则归入更新的一侧
+		older.Seq, newer.Seq = seq, seq-1
+		if in.Direction == historyBackward {
+			older.Seq++
+		}
+	case in.AnchorTime > 0:
+		// 该时间的消息归入更新的一侧
+		t := time.Unix(in.AnchorTime, 0)
+		seq, err := l.svcCtx.ImMessageModel.FindGroupSeqByTime(l.ctx, in.GroupId, t)
+		if err != nil {
+			return nil, false, false, err
+		}
+		older = model.HistoryCursor{Seq: seq, CreatedAt: t}
+		newer = model.HistoryCursor{Seq: seq - 1, CreatedAt: t}
+	case in.LastMsgId > 0 && in.Direction == historyForward:
+		last, err := l.findAnchor(in.GroupId, in.LastMsgId)
+		if err != nil {
+			return nil, false, false, err
+		}
+		newer = model.HistoryCursor{Seq: last.Seq, CreatedAt: last.CreatedAt}
+	default:
+		return nil, false, false, status.Error(codes.InvalidArgument, "请指定定位消息或时间")
+	}
+
+	read := func(cursor model.HistoryCursor, forward bool) func(limit int64) ([]*model.ImMessage, error) {
+		return func(limit int64) ([]*model.ImMessage, error) {
+			return l.svcCtx.ImMessageModel.FindGroupMessagesBySeq(l.ctx, in.GroupId, cursor, forward, limit)
+		}
+	}
+	return historyPage(in.Direction, limit, read(older, false), read(newer, true))
+}
+
+// findAnchor 查询定位消息，只能定位到当前群中未归档的消息
+func (l *GetGroupMessageListLogic) findAnchor(groupId string, id int64) (*model.ImMessage, error) {
+	msg, err := l.svcCtx.ImMessageModel.FindOne(l.ctx, uint64(id))
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "定位消息不存在")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if msg.ChatType != 2 || msg.GroupId.String != groupId {
+		return nil, status.Error(codes.NotFound, "定位消息不存在")
+	}
+	return msg, nil
+}
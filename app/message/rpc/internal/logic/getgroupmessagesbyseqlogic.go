@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"encoding/json"
 
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
@@ -26,44 +25,33 @@ func NewGetGroupMessagesBySeqLogic(ctx context.Context, svcCtx *svc.ServiceConte
 
 // 获取大于指定Seq的群聊消息 (用于消息同步)
 func (l *GetGroupMessagesBySeqLogic) GetGroupMessagesBySeq(in *message.GetGroupMessagesBySeqReq) (*message.GetGroupMessagesBySeqResp, error) {
-	// 1. 查询消息
-	messages, err := l.svcCtx.ImMessageModel.FindGroupMessagesAfterSeq(l.ctx, in.GroupId, in.Seq)
+	// 1. 查询消息（多查一条判断是否还有更多）
+	limit := syncLimit(in.Limit)
+	messages, err := l.svcCtx.ImMessageModel.FindGroupMessagesAfterSeq(l.ctx, in.GroupId, in.Seq, limit+1)
 	if err != nil {
 		l.Logger.Errorf("同步群消息失败: %v", err)
 		return nil, err // 修复：返回错误而不是 nil
 	}
+	hasMore := int64(len(messages)) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
 
 	var list []*message.MessageInfo
 	for _, msg := range messages {
-		// 解析 @用户列表
-		var atUserIds []int64
-		if msg.AtUserIds.Valid && msg.AtUserIds.String != "" {
-			// 从 JSON 解析到 []int64
-			var ids []int64
-			if err := json.Unmarshal([]byte(msg.AtUserIds.String), &ids); err == nil {
-				atUserIds = ids
-			} else {
-				l.Logger.Errorf("解析 AtUserIds 失败，msg_id=%s: %v", msg.MsgId, err)
-			}
-		}
+		list = append(list, toMessageInfo(msg))
+	}
+	attachThreadSummaries(l.ctx, l.svcCtx, list)
+	attachLinkPreviews(l.ctx, l.svcCtx, list)
 
-		list = append(list, &message.MessageInfo{
-			Id:          int64(msg.Id),
-			MsgId:       msg.MsgId,
-			FromUserId:  int64(msg.FromUserId),
-			ToUserId:    int64(msg.ToUserId),
-			ChatType:    int32(msg.ChatType),
-			GroupId:     msg.GroupId.String,
-			Content:     msg.Content,
-			ContentType: int32(msg.ContentType),
-			Status:      int32(msg.Status),
-			CreatedAt:   msg.CreatedAt.Unix(),
-			Seq:         msg.Seq,
-			AtUserIds:   atUserIds, // 修复：添加 AtUserIds 字段
-		})
+	var skipped []uint64
+	if len(messages) > 0 {
+		skipped = skippedSeqs(l.ctx, l.svcCtx, groupSeqKey(in.GroupId), in.Seq, messages[len(messages)-1].Seq)
 	}
 
 	return &message.GetGroupMessagesBySeqResp{
-		List: list,
+		List:        list,
+		SkippedSeqs: skipped,
+		HasMore:     hasMore,
 	}, nil
 }
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetGroupRetentionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetGroupRetentionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupRetentionLogic {
+	return &GetGroupRetentionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群消息保留期限
+func (l *GetGroupRetentionLogic) GetGroupRetention(in *message.GetGroupRetentionReq) (*message.GetGroupRetentionResp, error) {
+	if in.GroupId == "" || in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	checkResp, err := l.svcCtx.GroupRpc.CheckMembership(l.ctx, &group.CheckMembershipReq{
+		GroupId: in.GroupId,
+		UserId:  in.UserId,
+	})
+	if err != nil {
+		l.Logger.Errorf("检查成员资格失败: %v", err)
+		return nil, status.Error(codes.Internal, "检查成员失败")
+	}
+	if !checkResp.IsMember {
+		return nil, status.Error(codes.PermissionDenied, "您不是群成员")
+	}
+
+	info, err := loadGroupRetention(l.ctx, l.svcCtx, in.GroupId)
+	if err != nil {
+		l.Logger.Errorf("查询群消息保留期限失败: groupId=%s, err=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	return &message.GetGroupRetentionResp{Info: info}, nil
+}
@@ -2,11 +2,15 @@ package logic
 
 import (
 	"context"
+	"time"
 
+	"SkyeIM/app/message/model"
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GetMessageListLogic struct {
@@ -25,48 +29,105 @@ func NewGetMessageListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 
 // 获取私聊历史消息列表
 func (l *GetMessageListLogic) GetMessageList(in *message.GetMessageListReq) (*message.GetMessageListResp, error) {
-	limit := in.Limit
-	if limit <= 0 || limit > 100 {
-		limit = 20
+	if err := checkHistoryDirection(in.Direction); err != nil {
+		return nil, err
 	}
+	limit := historyLimit(in.Limit)
 
-	// 调用Model层方法
-	messages, err := l.svcCtx.ImMessageModel.FindPrivateMessageList(
-		l.ctx,
-		in.UserId,
-		in.PeerId,
-		in.LastMsgId,
-		int64(limit)+1,
+	var (
+		messages              []*model.ImMessage
+		hasMore, hasMoreAfter bool
+		err                   error
 	)
+	if in.Direction == historyBackward && in.AnchorMsgId == 0 && in.AnchorTime == 0 {
+		// 按消息ID向前分页
+		messages, err = l.svcCtx.ImMessageModel.FindPrivateMessageList(
+			l.ctx,
+			in.UserId,
+			in.PeerId,
+			in.LastMsgId,
+			limit+1,
+		)
+		if err == nil && int64(len(messages)) > limit {
+			hasMore = true
+			messages = messages[:limit]
+		}
+	} else {
+		messages, hasMore, hasMoreAfter, err = l.findFromAnchor(in, limit)
+	}
 	if err != nil {
-		l.Logger.Errorf("查询私聊消息失败: %v", err)
+		if _, ok := status.FromError(err); !ok {
+			l.Logger.Errorf("查询私聊消息失败: %v", err)
+		}
 		return nil, err
 	}
 
-	hasMore := false
-	if int64(len(messages)) > int64(limit) {
-		hasMore = true
-		messages = messages[:limit]
-	}
-
 	var list []*message.MessageInfo
 	for _, msg := range messages {
-		list = append(list, &message.MessageInfo{
-			Id:          int64(msg.Id),
-			MsgId:       msg.MsgId,
-			FromUserId:  int64(msg.FromUserId),
-			ToUserId:    int64(msg.ToUserId),
-			ChatType:    int32(msg.ChatType),
-			GroupId:     msg.GroupId.String,
-			Content:     msg.Content,
-			ContentType: int32(msg.ContentType),
-			Status:      int32(msg.Status),
-			CreatedAt:   msg.CreatedAt.Unix(),
-		})
+		list = append(list, toMessageInfo(msg))
 	}
+	attachLinkPreviews(l.ctx, l.svcCtx, list)
 
 	return &message.GetMessageListResp{
-		List:    list,
-		HasMore: hasMore,
+		List:         list,
+		HasMore:      hasMore,
+		HasMoreAfter: hasMoreAfter,
 	}, nil
 }
+
+// findFromAnchor 从定位消息、定位时间或分页游标开始读取（走 idx_conversation）
+func (l *GetMessageListLogic) findFromAnchor(in *message.GetMessageListReq, limit int64) ([]*model.ImMessage, bool, bool, error) {
+	var older, newer model.HistoryCursor
+	switch {
+	case in.AnchorMsgId > 0:
+		anchor, err := l.findAnchor(in, in.AnchorMsgId)
+		if err != nil {
+			return nil, false, false, err
+		}
+		// 结果包含定位消息：只向前读取时归入更早的一侧，否则归入更新的一侧
+		older = model.HistoryCursor{CreatedAt: anchor.CreatedAt, Id: anchor.Id}
+		if in.Direction == historyBackward {
+			older.Id++
+		}
+		newer = model.HistoryCursor{CreatedAt: anchor.CreatedAt, Id: anchor.Id - 1}
+	case in.AnchorTime > 0:
+		// 该时间的消息归入更新的一侧
+		t := time.Unix(in.AnchorTime, 0)
+		older = model.HistoryCursor{CreatedAt: t}
+		newer = model.HistoryCursor{CreatedAt: t}
+	case in.LastMsgId > 0 && in.Direction == historyForward:
+		last, err := l.findAnchor(in, in.LastMsgId)
+		if err != nil {
+			return nil, false, false, err
+		}
+		newer = model.HistoryCursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	default:
+		return nil, false, false, status.Error(codes.InvalidArgument, "请指定定位消息或时间")
+	}
+
+	read := func(cursor model.HistoryCursor, forward bool) func(limit int64) ([]*model.ImMessage, error) {
+		return func(limit int64) ([]*model.ImMessage, error) {
+			return l.svcCtx.ImMessageModel.FindPrivateMessagesByTime(l.ctx, in.UserId, in.PeerId, cursor, forward, limit)
+		}
+	}
+	return historyPage(in.Direction, limit, read(older, false), read(newer, true))
+}
+
+// findAnchor 查询定位消息，只能定位到当前会话中未归档的消息
+func (l *GetMessageListLogic) findAnchor(in *message.GetMessageListReq, id int64) (*model.ImMessage, error) {
+	msg, err := l.svcCtx.ImMessageModel.FindOne(l.ctx, uint64(id))
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "定位消息不存在")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userId, peerId := uint64(in.UserId), uint64(in.PeerId)
+	inConversation := (msg.FromUserId == userId && msg.ToUserId == peerId) ||
+		(msg.FromUserId == peerId && msg.ToUserId == userId)
+	if msg.ChatType != 1 || !inConversation {
+		return nil, status.Error(codes.NotFound, "定位消息不存在")
+	}
+	return msg, nil
+}
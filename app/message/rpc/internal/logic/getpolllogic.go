@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/poll"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetPollLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPollLogic {
+	return &GetPollLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取投票详情和实时计票结果
+func (l *GetPollLogic) GetPoll(in *message.GetPollReq) (*message.GetPollResp, error) {
+	if in.UserId == 0 || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	p, err := findPoll(l.ctx, l.svcCtx, in.MsgId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkGroupMember(l.ctx, l.svcCtx, p.GroupId, in.UserId); err != nil {
+		return nil, err
+	}
+
+	info, err := poll.BuildInfo(l.ctx, l.svcCtx, p, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("汇总投票结果失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	return &message.GetPollResp{Info: info}, nil
+}
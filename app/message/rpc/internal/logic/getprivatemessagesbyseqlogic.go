@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetPrivateMessagesBySeqLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPrivateMessagesBySeqLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivateMessagesBySeqLogic {
+	return &GetPrivateMessagesBySeqLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取大于指定Seq的私聊消息 (用于消息同步)
+func (l *GetPrivateMessagesBySeqLogic) GetPrivateMessagesBySeq(in *message.GetPrivateMessagesBySeqReq) (*message.GetPrivateMessagesBySeqResp, error) {
+	if in.UserId == 0 || in.PeerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	// 多查一条判断是否还有更多
+	limit := syncLimit(in.Limit)
+	messages, err := l.svcCtx.ImMessageModel.FindPrivateMessagesAfterSeq(l.ctx, in.UserId, in.PeerId, in.Seq, limit+1)
+	if err != nil {
+		l.Logger.Errorf("同步私聊消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询消息失败")
+	}
+	hasMore := int64(len(messages)) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	list := make([]*message.MessageInfo, 0, len(messages))
+	for _, msg := range messages {
+		list = append(list, toMessageInfo(msg))
+	}
+	attachLinkPreviews(l.ctx, l.svcCtx, list)
+
+	var skipped []uint64
+	if len(messages) > 0 {
+		skipped = skippedSeqs(l.ctx, l.svcCtx, privateSeqKey(in.UserId, in.PeerId), in.Seq, messages[len(messages)-1].Seq)
+	}
+
+	return &message.GetPrivateMessagesBySeqResp{
+		List:        list,
+		SkippedSeqs: skipped,
+		HasMore:     hasMore,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetThreadRepliesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetThreadRepliesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetThreadRepliesLogic {
+	return &GetThreadRepliesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取话题的回复列表（按话题内 Seq 升序）
+func (l *GetThreadRepliesLogic) GetThreadReplies(in *message.GetThreadRepliesReq) (*message.GetThreadRepliesResp, error) {
+	if in.UserId == 0 || in.RootMsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	root, err := findThreadRoot(l.ctx, l.svcCtx, in.RootMsgId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkGroupMember(l.ctx, l.svcCtx, root.GroupId.String, in.UserId); err != nil {
+		return nil, err
+	}
+
+	limit := historyLimit(in.Limit)
+	replies, err := l.svcCtx.ImThreadReplyModel.FindByRoot(l.ctx, root.MsgId, in.AfterSeq, limit+1)
+	if err != nil {
+		l.Logger.Errorf("查询话题回复失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询话题回复失败")
+	}
+	hasMore := int64(len(replies)) > limit
+	if hasMore {
+		replies = replies[:limit]
+	}
+
+	summary, err := findThreadSummary(l.ctx, l.svcCtx, root.MsgId)
+	if err != nil {
+		l.Logger.Errorf("查询话题汇总失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	_, err = l.svcCtx.ImThreadFollowerModel.FindOneByRootMsgIdUserId(l.ctx, root.MsgId, uint64(in.UserId))
+	following := err == nil
+
+	list := make([]*message.ThreadReplyInfo, 0, len(replies))
+	for _, reply := range replies {
+		list = append(list, toThreadReplyInfo(reply))
+	}
+
+	rootInfo := toMessageInfo(root)
+	rootInfo.Thread = summary
+	attachLinkPreviews(l.ctx, l.svcCtx, []*message.MessageInfo{rootInfo})
+
+	var skipped []uint64
+	if len(replies) > 0 {
+		skipped = skippedSeqs(l.ctx, l.svcCtx, threadSeqKey(root.MsgId), in.AfterSeq, replies[len(replies)-1].Seq)
+	}
+
+	return &message.GetThreadRepliesResp{
+		Root:        rootInfo,
+		List:        list,
+		HasMore:     hasMore,
+		Thread:      summary,
+		Following:   following,
+		SkippedSeqs: skipped,
+	}, nil
+}
@@ -25,16 +25,35 @@ func NewGetUnreadCountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 
 // 获取私聊未读消息数量
 func (l *GetUnreadCountLogic) GetUnreadCount(in *message.GetUnreadCountReq) (*message.GetUnreadCountResp, error) {
-	// 调用Model层方法
-	count, err := l.svcCtx.ImMessageModel.CountUnreadMessages(
-		l.ctx,
-		in.UserId,
-		in.PeerId,
-	)
+	fields, writable := loadUnreadFields(l.ctx, l.svcCtx, in.UserId)
+	if !writable {
+		// Redis 不可用，直接查询数据库
+		count, err := l.svcCtx.ImMessageModel.CountUnreadMessages(l.ctx, in.UserId, in.PeerId)
+		if err != nil {
+			l.Logger.Errorf("查询未读消息数失败: %v", err)
+			return nil, err
+		}
+		return &message.GetUnreadCountResp{Count: count}, nil
+	}
+
+	fill := make(map[string]int64)
+	counts, err := privateUnread(l.ctx, l.svcCtx, in.UserId, fields, fill)
 	if err != nil {
 		l.Logger.Errorf("查询未读消息数失败: %v", err)
 		return nil, err
 	}
+	if err := l.svcCtx.UnreadCounter.Fill(l.ctx, in.UserId, fill); err != nil {
+		l.Logger.Errorf("回写未读计数失败: userId=%d, err=%v", in.UserId, err)
+	}
+
+	var count int64
+	if in.PeerId > 0 {
+		count = counts[in.PeerId]
+	} else {
+		for _, n := range counts {
+			count += n
+		}
+	}
 
 	return &message.GetUnreadCountResp{
 		Count: count,
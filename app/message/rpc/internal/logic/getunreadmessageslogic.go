@@ -44,19 +44,9 @@ func (l *GetUnreadMessagesLogic) GetUnreadMessages(in *message.GetUnreadMessages
 
 	var list []*message.MessageInfo
 	for _, msg := range messages {
-		list = append(list, &message.MessageInfo{
-			Id:          int64(msg.Id),
-			MsgId:       msg.MsgId,
-			FromUserId:  int64(msg.FromUserId),
-			ToUserId:    int64(msg.ToUserId),
-			ChatType:    int32(msg.ChatType),
-			GroupId:     msg.GroupId.String,
-			Content:     msg.Content,
-			ContentType: int32(msg.ContentType),
-			Status:      int32(msg.Status),
-			CreatedAt:   msg.CreatedAt.Unix(),
-		})
+		list = append(list, toMessageInfo(msg))
 	}
+	attachLinkPreviews(l.ctx, l.svcCtx, list)
 
 	return &message.GetUnreadMessagesResp{
 		List: list,
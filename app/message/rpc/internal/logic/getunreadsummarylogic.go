@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/unread"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetUnreadSummaryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUnreadSummaryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUnreadSummaryLogic {
+	return &GetUnreadSummaryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取所有会话的未读数汇总（私聊、群聊及群聊@我）
+func (l *GetUnreadSummaryLogic) GetUnreadSummary(in *message.GetUnreadSummaryReq) (*message.GetUnreadSummaryResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	fields, writable := loadUnreadFields(l.ctx, l.svcCtx, in.UserId)
+	fill := make(map[string]int64)
+	resp := &message.GetUnreadSummaryResp{}
+
+	// 1. 私聊
+	privateCounts, err := privateUnread(l.ctx, l.svcCtx, in.UserId, fields, fill)
+	if err != nil {
+		l.Logger.Errorf("统计私聊未读数失败: userId=%d, err=%v", in.UserId, err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	for _, peerId := range sortedPeers(privateCounts) {
+		n := privateCounts[peerId]
+		resp.List = append(resp.List, &message.ConversationUnread{ChatType: 1, PeerId: peerId, Unread: n})
+		resp.PrivateTotal += n
+	}
+
+	// 2. 群聊：只统计当前加入的群，计数未知的群按已读 Seq 从数据库重建
+	joined, err := l.svcCtx.GroupRpc.GetJoinedGroups(l.ctx, &group.GetJoinedGroupsReq{UserId: in.UserId})
+	if err != nil {
+		l.Logger.Errorf("查询已加入的群组失败: userId=%d, err=%v", in.UserId, err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	joinedIds := make(map[string]bool, len(joined.List))
+	for _, member := range joined.List {
+		joinedIds[member.GroupId] = true
+		groupField, atField := unread.GroupField(member.GroupId), unread.AtField(member.GroupId)
+
+		n, ok := fieldCount(fields, groupField)
+		atMe, _ := fieldCount(fields, atField)
+		if !ok {
+			counts, err := l.svcCtx.ImMessageModel.CountGroupUnread(l.ctx, member.GroupId, in.UserId, member.ReadSeq, time.Unix(member.JoinedAt, 0))
+			if err != nil {
+				l.Logger.Errorf("统计群聊未读数失败: groupId=%s, userId=%d, err=%v", member.GroupId, in.UserId, err)
+				return nil, status.Error(codes.Internal, "系统错误")
+			}
+			n, atMe = counts.Unread, counts.AtMe
+			fill[groupField], fill[atField] = n, atMe
+		}
+
+		if n > 0 || atMe > 0 {
+			resp.List = append(resp.List, &message.ConversationUnread{ChatType: 2, GroupId: member.GroupId, Unread: n, AtMe: atMe})
+			resp.GroupTotal += n
+			resp.AtMeTotal += atMe
+		}
+	}
+	resp.Total = resp.PrivateTotal + resp.GroupTotal
+
+	if writable {
+		if err := l.svcCtx.UnreadCounter.Fill(l.ctx, in.UserId, fill); err != nil {
+			l.Logger.Errorf("回写未读计数失败: userId=%d, err=%v", in.UserId, err)
+		}
+		// 清理已退出或已解散的群的计数
+		if err := l.svcCtx.UnreadCounter.Remove(l.ctx, in.UserId, staleGroupFields(fields, joinedIds)...); err != nil {
+			l.Logger.Errorf("清理未读计数失败: userId=%d, err=%v", in.UserId, err)
+		}
+	}
+
+	return resp, nil
+}
+
+// staleGroupFields 不在已加入群组中的群计数字段
+func staleGroupFields(fields map[string]string, joinedIds map[string]bool) []string {
+	var stale []string
+	for field := range fields {
+		groupId, ok := strings.CutPrefix(field, "g:")
+		if !ok {
+			groupId, ok = strings.CutPrefix(field, "a:")
+		}
+		if ok && !joinedIds[groupId] {
+			stale = append(stale, field)
+		}
+	}
+	return stale
+}
@@ -0,0 +1,83 @@
+package logic
+
+// history.go - 历史消息的定位读取（跳转到消息、Seq、日期，以及向后加载）
+
+import (
+	"SkyeIM/app/message/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 历史消息读取方向
+const (
+	historyBackward = 0 // 向前读取更早的消息
+	historyForward  = 1 // 向后读取更新的消息
+	historyAround   = 2 // 定位点前后各读取一半
+)
+
+// historyLimit 每次读取条数，默认 20，最多 100
+func historyLimit(limit int32) int64 {
+	if limit <= 0 || limit > 100 {
+		return 20
+	}
+	return int64(limit)
+}
+
+// checkHistoryDirection 校验读取方向
+func checkHistoryDirection(direction int32) error {
+	switch direction {
+	case historyBackward, historyForward, historyAround:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, "不支持的读取方向")
+	}
+}
+
+// historyPage 按读取方向组装一页历史消息，结果按时间倒序
+// older、newer 分别从定位点向前、向后读取（结果均按时间倒序），定位消息本身属于哪一侧由调用方决定
+func historyPage(direction int32, limit int64, older, newer func(limit int64) ([]*model.ImMessage, error)) (list []*model.ImMessage, hasMore, hasMoreAfter bool, err error) {
+	switch direction {
+	case historyForward:
+		list, hasMoreAfter, err = readNewer(newer, limit)
+		return list, false, hasMoreAfter, err
+	case historyAround:
+		before := limit / 2
+		newerList, hasMoreAfter, err := readNewer(newer, limit-before)
+		if err != nil {
+			return nil, false, false, err
+		}
+		olderList, hasMore, err := readOlder(older, before)
+		if err != nil {
+			return nil, false, false, err
+		}
+		return append(newerList, olderList...), hasMore, hasMoreAfter, nil
+	default:
+		list, hasMore, err = readOlder(older, limit)
+		return list, hasMore, false, err
+	}
+}
+
+// readOlder 读取定位点之前最近的 limit 条消息
+func readOlder(older func(limit int64) ([]*model.ImMessage, error), limit int64) ([]*model.ImMessage, bool, error) {
+	list, err := older(limit + 1)
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(list)) > limit {
+		return list[:limit], true, nil
+	}
+	return list, false, nil
+}
+
+// readNewer 读取定位点之后最近的 limit 条消息（倒序结果中位于末尾）
+func readNewer(newer func(limit int64) ([]*model.ImMessage, error), limit int64) ([]*model.ImMessage, bool, error) {
+	list, err := newer(limit + 1)
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(list)) > limit {
+		return list[int64(len(list))-limit:], true, nil
+	}
+	return list, false, nil
+}
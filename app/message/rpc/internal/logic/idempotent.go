@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/common/rpcerr"
+
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+)
+
+// mysqlErrDuplicateEntry 唯一索引冲突
+const mysqlErrDuplicateEntry = 1062
+
+// errMsgIdConflict msg_id 已被其他发送者或其他会话占用
+var errMsgIdConflict = rpcerr.New(codes.AlreadyExists, "msg_id_conflict", "消息ID已被占用")
+
+// findExistingMessage 按 msg_id 在会话中查找已存储的消息（客户端丢失ACK后重试会复用 msg_id）
+// conversationKey 为消息所属会话（见 model.ConversationKey），不存在时返回 nil, nil
+func findExistingMessage(ctx context.Context, svcCtx *svc.ServiceContext, conversationKey, msgId string) (*model.ImMessage, error) {
+	if msgId == "" {
+		return nil, nil
+	}
+
+	existing, err := svcCtx.ImMessageModel.FindOneByMsgIdInConversation(ctx, conversationKey, msgId)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// isDuplicateKeyErr 判断是否为唯一索引冲突（并发重试时两个请求同时插入同一 msg_id）
+func isDuplicateKeyErr(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// isSamePrivateMessage 校验重试请求与原消息的发送者、会话是否一致
+func isSamePrivateMessage(existing *model.ImMessage, fromUserId, toUserId int64) bool {
+	return existing.ChatType == 1 &&
+		existing.FromUserId == uint64(fromUserId) &&
+		existing.ToUserId == uint64(toUserId)
+}
+
+// isSameGroupMessage 校验重试请求与原消息的发送者、群组是否一致
+func isSameGroupMessage(existing *model.ImMessage, fromUserId int64, groupId string) bool {
+	return existing.ChatType == 2 &&
+		existing.FromUserId == uint64(fromUserId) &&
+		existing.GroupId.String == groupId
+}
@@ -0,0 +1,89 @@
+package logic
+
+// linkpreview.go - 链接预览（发送时登记待抓取链接，查询消息时附加已完成的预览）
+//
+// 抓取由 unfurl.Worker 异步完成，发送消息不等待抓取结果
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/internal/unfurl"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// enqueueLinkPreview 文字消息包含链接时登记待抓取，失败只记录日志（消息已正常发送）
+// 阅后即焚消息不生成预览，避免消息销毁后预览仍保留内容
+func enqueueLinkPreview(ctx context.Context, svcCtx *svc.ServiceContext, msg *model.ImMessage) {
+	if !svcCtx.Config.Unfurl.Enabled || msg.ContentType != payload.TypeText || msg.ExpireTtl > 0 {
+		return
+	}
+	link := unfurl.FindURL(msg.Content)
+	if link == "" {
+		return
+	}
+
+	_, err := svcCtx.ImLinkPreviewModel.Insert(ctx, &model.ImLinkPreview{
+		MsgId:      msg.MsgId,
+		ChatType:   msg.ChatType,
+		FromUserId: msg.FromUserId,
+		ToUserId:   msg.ToUserId,
+		GroupId:    msg.GroupId.String,
+		Url:        link,
+		Status:     model.LinkPreviewStatusPending,
+	})
+	if err != nil && !isDuplicateKeyErr(err) {
+		logx.WithContext(ctx).Errorf("登记链接预览失败: msgId=%s, err=%v", msg.MsgId, err)
+	}
+}
+
+// attachLinkPreviews 为消息列表附加已完成的链接预览
+// 已撤回、删除、销毁或清理的消息不返回预览；查询失败只记录日志，不影响消息列表本身
+func attachLinkPreviews(ctx context.Context, svcCtx *svc.ServiceContext, list []*message.MessageInfo) {
+	msgIds := make([]string, 0, len(list))
+	for _, info := range list {
+		if hasLinkPreview(info) {
+			msgIds = append(msgIds, info.MsgId)
+		}
+	}
+	if len(msgIds) == 0 {
+		return
+	}
+
+	rows, err := svcCtx.ImLinkPreviewModel.FindDoneByMsgIds(ctx, msgIds)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询链接预览失败: %v", err)
+		return
+	}
+	byMsgId := make(map[string]*model.ImLinkPreview, len(rows))
+	for _, row := range rows {
+		byMsgId[row.MsgId] = row
+	}
+	for _, info := range list {
+		if row, ok := byMsgId[info.MsgId]; ok && hasLinkPreview(info) {
+			info.LinkPreview = &message.LinkPreview{
+				Url:         row.Url,
+				Title:       row.Title,
+				Description: row.Description,
+				Image:       row.Image,
+				SiteName:    row.SiteName,
+			}
+		}
+	}
+}
+
+// hasLinkPreview 消息是否可能带有链接预览（内容仍可见的文字消息）
+func hasLinkPreview(info *message.MessageInfo) bool {
+	if info.ContentType != payload.TypeText || info.Content == "" {
+		return false
+	}
+	switch info.Status {
+	case messageStatusRecalled, messageStatusDeleted, messageStatusBurned, messageStatusPurged:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListExportJobsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListExportJobsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListExportJobsLogic {
+	return &ListExportJobsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取导出任务列表
+func (l *ListExportJobsLogic) ListExportJobs(in *message.ListExportJobsReq) (*message.ListExportJobsResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 50 {
+		pageSize = 20
+	}
+
+	total, err := l.svcCtx.ImExportJobModel.CountByUser(l.ctx, uint64(in.UserId))
+	if err != nil {
+		l.Logger.Errorf("统计导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询导出任务失败")
+	}
+
+	jobs, err := l.svcCtx.ImExportJobModel.FindByUser(l.ctx, uint64(in.UserId), (page-1)*pageSize, pageSize)
+	if err != nil {
+		l.Logger.Errorf("查询导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询导出任务失败")
+	}
+
+	list := make([]*message.ExportJobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		list = append(list, toExportJobInfo(l.ctx, l.svcCtx, job))
+	}
+
+	return &message.ListExportJobsResp{
+		List:  list,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListFavoritesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFavoritesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFavoritesLogic {
+	return &ListFavoritesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询收藏列表（支持关键词、标签、消息类型筛选）
+func (l *ListFavoritesLogic) ListFavorites(in *message.ListFavoritesReq) (*message.ListFavoritesResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	favorites, err := l.svcCtx.ImFavoriteModel.FindByUser(l.ctx, &model.FavoriteQuery{
+		UserId:      uint64(in.UserId),
+		Keyword:     strings.TrimSpace(in.Keyword),
+		Tag:         strings.TrimSpace(in.Tag),
+		ContentType: int64(in.ContentType),
+		Cursor:      uint64(in.Cursor),
+		Limit:       limit + 1,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询收藏失败")
+	}
+
+	var nextCursor int64
+	if int64(len(favorites)) > limit {
+		favorites = favorites[:limit]
+		nextCursor = int64(favorites[len(favorites)-1].Id)
+	}
+
+	list := make([]*message.FavoriteInfo, 0, len(favorites))
+	for _, fav := range favorites {
+		list = append(list, toFavoriteInfo(fav))
+	}
+
+	return &message.ListFavoritesResp{
+		List:       list,
+		NextCursor: nextCursor,
+	}, nil
+}
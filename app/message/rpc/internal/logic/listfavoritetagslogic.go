@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"sort"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListFavoriteTagsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFavoriteTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFavoriteTagsLogic {
+	return &ListFavoriteTagsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取收藏使用过的标签
+func (l *ListFavoriteTagsLogic) ListFavoriteTags(in *message.ListFavoriteTagsReq) (*message.ListFavoriteTagsResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	rows, err := l.svcCtx.ImFavoriteModel.FindTagsByUser(l.ctx, uint64(in.UserId))
+	if err != nil {
+		l.Logger.Errorf("查询收藏标签失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询收藏标签失败")
+	}
+
+	counts := make(map[string]int64)
+	for _, row := range rows {
+		for _, tag := range decodeFavoriteTags(row) {
+			counts[tag]++
+		}
+	}
+
+	list := make([]*message.FavoriteTag, 0, len(counts))
+	for name, count := range counts {
+		list = append(list, &message.FavoriteTag{Name: name, Count: count})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].Name < list[j].Name
+	})
+
+	return &message.ListFavoriteTagsResp{List: list}, nil
+}
@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListGroupSensitiveWordsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListGroupSensitiveWordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListGroupSensitiveWordsLogic {
+	return &ListGroupSensitiveWordsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取群自定义敏感词（需要内容审核权限）
+func (l *ListGroupSensitiveWordsLogic) ListGroupSensitiveWords(in *message.ListGroupSensitiveWordsReq) (*message.GroupSensitiveWordsResp, error) {
+	if in.UserId == 0 || in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if err := checkGroupPermission(l.ctx, l.svcCtx, in.GroupId, in.UserId, groupperm.Moderate); err != nil {
+		return nil, err
+	}
+
+	return listGroupSensitiveWords(l.ctx, l.svcCtx, in.GroupId)
+}
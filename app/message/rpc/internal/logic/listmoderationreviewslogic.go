@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListModerationReviewsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListModerationReviewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListModerationReviewsLogic {
+	return &ListModerationReviewsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+func (l *ListModerationReviewsLogic) ListModerationReviews(in *message.ListModerationReviewsReq) (*message.ListModerationReviewsResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	if in.Status < model.ReviewStatusPending || in.Status > model.ReviewStatusRemoved {
+		return nil, status.Error(codes.InvalidArgument, "审核状态无效")
+	}
+	if err := checkReviewPermission(l.ctx, l.svcCtx, in.UserId, in.GroupId); err != nil {
+		return nil, err
+	}
+
+	limit := historyLimit(in.Limit)
+	reviews, err := l.svcCtx.ImModerationReviewModel.FindPage(l.ctx, in.GroupId, int64(in.Status), uint64(in.LastId), limit+1)
+	if err != nil {
+		l.Logger.Errorf("查询审核记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询审核记录失败")
+	}
+
+	hasMore := int64(len(reviews)) > limit
+	if hasMore {
+		reviews = reviews[:limit]
+	}
+
+	list := make([]*message.ModerationReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		list = append(list, toModerationReviewInfo(review))
+	}
+
+	return &message.ListModerationReviewsResp{
+		List:    list,
+		HasMore: hasMore,
+	}, nil
+}
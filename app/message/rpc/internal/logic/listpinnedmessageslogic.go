@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListPinnedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListPinnedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPinnedMessagesLogic {
+	return &ListPinnedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取会话的置顶消息列表
+func (l *ListPinnedMessagesLogic) ListPinnedMessages(in *message.ListPinnedMessagesReq) (*message.ListPinnedMessagesResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	conversationKey, err := pinConversation(l.ctx, l.svcCtx, in.UserId, in.ChatType, in.PeerId, in.GroupId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pins, err := l.svcCtx.ImPinnedMessageModel.FindByConversation(l.ctx, conversationKey)
+	if err != nil {
+		l.Logger.Errorf("查询置顶消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询置顶消息失败")
+	}
+
+	list := make([]*message.PinnedMessageInfo, 0, len(pins))
+	for _, pin := range pins {
+		list = append(list, toPinnedMessageInfo(pin))
+	}
+
+	return &message.ListPinnedMessagesResp{List: list}, nil
+}
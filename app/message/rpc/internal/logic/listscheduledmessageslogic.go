@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListScheduledMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListScheduledMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListScheduledMessagesLogic {
+	return &ListScheduledMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取定时消息列表
+func (l *ListScheduledMessagesLogic) ListScheduledMessages(in *message.ListScheduledMessagesReq) (*message.ListScheduledMessagesResp, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	page := in.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := in.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	total, err := l.svcCtx.ImScheduledMessageModel.CountByUser(l.ctx, uint64(in.UserId), int64(in.Status))
+	if err != nil {
+		l.Logger.Errorf("统计定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询定时消息失败")
+	}
+
+	rows, err := l.svcCtx.ImScheduledMessageModel.FindByUser(l.ctx, uint64(in.UserId), int64(in.Status), (page-1)*pageSize, pageSize)
+	if err != nil {
+		l.Logger.Errorf("查询定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询定时消息失败")
+	}
+
+	list := make([]*message.ScheduledMessageInfo, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, toScheduledMessageInfo(row))
+	}
+
+	return &message.ListScheduledMessagesResp{
+		List:  list,
+		Total: total,
+	}, nil
+}
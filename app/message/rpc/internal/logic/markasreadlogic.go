@@ -37,6 +37,19 @@ func (l *MarkAsReadLogic) MarkAsRead(in *message.MarkAsReadReq) (*message.MarkAs
 		return nil, err
 	}
 
+	// 阅后即焚：阅读后计时的消息从现在开始倒计时
+	if _, err := l.svcCtx.ImMessageModel.StartReadExpiry(l.ctx, in.UserId, in.PeerId, in.MsgIds); err != nil {
+		l.Logger.Errorf("设置阅后即焚过期时间失败: %v", err)
+	}
+
+	// 按数据库的准确值回写会话未读数（部分标记已读时仍可能有剩余未读）
+	unreadCount, err := l.svcCtx.ImMessageModel.CountUnreadMessages(l.ctx, in.UserId, in.PeerId)
+	if err != nil {
+		l.Logger.Errorf("查询会话未读数失败: %v", err)
+	} else if err := l.svcCtx.UnreadCounter.SetPrivate(l.ctx, in.UserId, in.PeerId, unreadCount); err != nil {
+		l.Logger.Errorf("更新私聊未读数失败: %v", err)
+	}
+
 	return &message.MarkAsReadResp{
 		Count: count,
 	}, nil
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"encoding/json"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/message"
+
+	"SkyeIM/common/rpcerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toMessageInfo 数据库消息转换为 RPC 返回结构（解析@列表和结构化内容）
+func toMessageInfo(msg *model.ImMessage) *message.MessageInfo {
+	var atUserIds []int64
+	if msg.AtUserIds.Valid && msg.AtUserIds.String != "" {
+		if err := json.Unmarshal([]byte(msg.AtUserIds.String), &atUserIds); err != nil {
+			logx.Errorf("解析 AtUserIds 失败，msg_id=%s: %v", msg.MsgId, err)
+		}
+	}
+
+	info := &message.MessageInfo{
+		Id:          int64(msg.Id),
+		MsgId:       msg.MsgId,
+		FromUserId:  int64(msg.FromUserId),
+		ToUserId:    int64(msg.ToUserId),
+		ChatType:    int32(msg.ChatType),
+		GroupId:     msg.GroupId.String,
+		Content:     msg.Content,
+		ContentType: int32(msg.ContentType),
+		Status:      int32(msg.Status),
+		CreatedAt:   msg.CreatedAt.Unix(),
+		Seq:         msg.Seq,
+		AtUserIds:   atUserIds,
+		Payload:     payload.Parse(int32(msg.ContentType), msg.Content),
+		ExpireTtl:   int32(msg.ExpireTtl),
+		ExpireMode:  int32(msg.ExpireMode),
+		ExpireAt:    expireAtUnix(msg.ExpireAt),
+	}
+
+	// 超过保留期限已清理的消息只保留占位
+	if msg.Status == messageStatusPurged {
+		info.Payload = nil
+		info.AtUserIds = nil
+		return info
+	}
+
+	// 阅后即焚：已销毁或已到期的消息不返回内容
+	if isExpired(msg.Status, msg.ExpireAt) {
+		info.Content = ""
+		info.Payload = nil
+		info.AtUserIds = nil
+		info.Status = messageStatusBurned
+	}
+	return info
+}
+
+// validatePayload 校验消息内容是否符合 content_type 对应的结构
+func validatePayload(contentType int32, content string) error {
+	if err := payload.Validate(contentType, content); err != nil {
+		return rpcerr.New(codes.InvalidArgument, "invalid_payload", err.Error())
+	}
+	return nil
+}
+
+// 撤回、删除的消息状态
+const (
+	messageStatusRecalled = 2
+	messageStatusDeleted  = 3
+)
+
+// checkSnapshotable 保存消息内容快照（收藏、置顶）前的检查
+// 撤回、删除、已清理的消息没有可保存的内容，阅后即焚消息不允许通过快照留存
+func checkSnapshotable(msg *model.ImMessage, action string) error {
+	switch {
+	case msg.ContentType == payload.TypeSystem:
+		return status.Error(codes.FailedPrecondition, "系统消息不能"+action)
+	case msg.ExpireMode != 0:
+		return status.Error(codes.FailedPrecondition, "阅后即焚消息不能"+action)
+	case msg.Status == messageStatusRecalled:
+		return status.Error(codes.FailedPrecondition, "消息已撤回")
+	case msg.Status == messageStatusDeleted:
+		return status.Error(codes.FailedPrecondition, "消息已删除")
+	case msg.Status == messageStatusPurged:
+		return status.Error(codes.FailedPrecondition, "消息已超过保留期限")
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package logic
+
+// moderation.go - 内容审核的公共逻辑（发送前审核、送审入队、审核权限、删除违规消息）
+//
+// 命中送审词的消息正常发送，同时写入审核队列（im_moderation_review）；
+// 审核人处理时可以通过（保留消息）或删除，删除后清空消息内容并实时通知会话成员
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/moderation"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
+	"SkyeIM/common/rpcerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// eventMessageRemoved 违规消息被审核删除
+	eventMessageRemoved = "message_removed"
+
+	// maxReviewHitWords 审核记录中最多保存的命中词数量（hit_words 字段长度有限）
+	maxReviewHitWords = 20
+
+	// maxSensitiveWordsPerReq 单次添加/删除的敏感词数量上限
+	maxSensitiveWordsPerReq = 100
+	// maxSensitiveWordLen 单个敏感词的最大长度（字符数）
+	maxSensitiveWordLen = 32
+)
+
+// errSensitiveContent 消息命中拦截词，ws 层据此返回 ACK 失败原因
+var errSensitiveContent = rpcerr.New(codes.InvalidArgument, "sensitive_content", "消息包含敏感内容，发送失败")
+
+// moderateContent 发送前执行内容审核，命中拦截词时拒绝发送，否则返回实际保存的内容
+func moderateContent(ctx context.Context, svcCtx *svc.ServiceContext, in *moderation.Input) (*moderation.Result, error) {
+	res := svcCtx.Moderator.Moderate(ctx, in)
+	if res.Action == moderation.ActionBlock {
+		logx.WithContext(ctx).Infof("消息命中拦截词: from=%d, groupId=%s, hits=%v", in.FromUserId, in.GroupId, res.Hits)
+		return nil, errSensitiveContent
+	}
+	return res, nil
+}
+
+// enqueueReview 命中送审词的消息写入审核队列，失败只记录日志（消息已正常发送）
+func enqueueReview(ctx context.Context, svcCtx *svc.ServiceContext, res *moderation.Result, review *model.ImModerationReview) {
+	if res.Action != moderation.ActionReview {
+		return
+	}
+
+	hits := res.Hits
+	if len(hits) > maxReviewHitWords {
+		hits = hits[:maxReviewHitWords]
+	}
+	hitWords, _ := json.Marshal(hits)
+	review.HitWords = string(hitWords)
+	review.Status = model.ReviewStatusPending
+
+	if _, err := svcCtx.ImModerationReviewModel.Insert(ctx, review); err != nil && !isDuplicateKeyErr(err) {
+		logx.WithContext(ctx).Errorf("写入审核队列失败: msgId=%s, err=%v", review.MsgId, err)
+	}
+}
+
+// normalizeSensitiveWords 去除首尾空白并去重，校验数量和长度
+func normalizeSensitiveWords(words []string) ([]string, error) {
+	if len(words) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "敏感词不能为空")
+	}
+	if len(words) > maxSensitiveWordsPerReq {
+		return nil, status.Errorf(codes.InvalidArgument, "单次最多操作%d个敏感词", maxSensitiveWordsPerReq)
+	}
+
+	seen := make(map[string]bool, len(words))
+	result := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			return nil, status.Error(codes.InvalidArgument, "敏感词不能为空")
+		}
+		if utf8.RuneCountInString(word) > maxSensitiveWordLen {
+			return nil, status.Errorf(codes.InvalidArgument, "敏感词最多%d个字符", maxSensitiveWordLen)
+		}
+		if !seen[word] {
+			seen[word] = true
+			result = append(result, word)
+		}
+	}
+	return result, nil
+}
+
+// listGroupSensitiveWords 查询群的全部自定义敏感词
+func listGroupSensitiveWords(ctx context.Context, svcCtx *svc.ServiceContext, groupId string) (*message.GroupSensitiveWordsResp, error) {
+	rows, err := svcCtx.ImGroupSensitiveWordModel.FindByGroup(ctx, groupId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询群敏感词失败: groupId=%s, err=%v", groupId, err)
+		return nil, status.Error(codes.Internal, "查询敏感词失败")
+	}
+
+	list := make([]*message.SensitiveWordInfo, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, &message.SensitiveWordInfo{
+			Word:      row.Word,
+			Action:    int32(row.Action),
+			CreatedBy: int64(row.CreatedBy),
+			CreatedAt: row.CreatedAt.Unix(),
+		})
+	}
+	return &message.GroupSensitiveWordsResp{List: list}, nil
+}
+
+// isPlatformReviewer 是否为配置的平台审核人员
+func isPlatformReviewer(svcCtx *svc.ServiceContext, userId int64) bool {
+	for _, id := range svcCtx.Config.Moderation.ReviewerIds {
+		if id == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReviewPermission 平台审核人员可以处理全部送审消息，群聊送审消息还可以由该群有内容审核权限的成员处理
+func checkReviewPermission(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, groupId string) error {
+	if isPlatformReviewer(svcCtx, userId) {
+		return nil
+	}
+	if groupId == "" {
+		return status.Error(codes.PermissionDenied, "仅平台审核人员可以处理私聊送审消息")
+	}
+	return checkGroupPermission(ctx, svcCtx, groupId, userId, groupperm.Moderate)
+}
+
+// toModerationReviewInfo 审核记录转换为 RPC 返回结构
+func toModerationReviewInfo(review *model.ImModerationReview) *message.ModerationReviewInfo {
+	var hitWords []string
+	if review.HitWords != "" {
+		_ = json.Unmarshal([]byte(review.HitWords), &hitWords)
+	}
+
+	info := &message.ModerationReviewInfo{
+		Id:          int64(review.Id),
+		MsgId:       review.MsgId,
+		RootMsgId:   review.RootMsgId,
+		ChatType:    int32(review.ChatType),
+		FromUserId:  int64(review.FromUserId),
+		ToUserId:    int64(review.ToUserId),
+		GroupId:     review.GroupId,
+		Content:     review.Content,
+		ContentType: int32(review.ContentType),
+		Payload:     payload.Parse(int32(review.ContentType), review.Content),
+		HitWords:    hitWords,
+		Status:      int32(review.Status),
+		ReviewerId:  int64(review.ReviewerId),
+		CreatedAt:   review.CreatedAt.Unix(),
+	}
+	if review.ReviewedAt.Valid {
+		info.ReviewedAt = review.ReviewedAt.Time.Unix()
+	}
+	return info
+}
+
+// removeReviewedMessage 删除审核未通过的消息并通知会话成员
+// 主时间线消息清空内容并标记为已删除，同时取消置顶；话题回复直接删除并推送最新的话题汇总
+func removeReviewedMessage(ctx context.Context, svcCtx *svc.ServiceContext, review *model.ImModerationReview, operatorId int64) error {
+	data := map[string]interface{}{
+		"msgId":      review.MsgId,
+		"chatType":   review.ChatType,
+		"fromUserId": review.FromUserId,
+		"toUserId":   review.ToUserId,
+		"groupId":    review.GroupId,
+		"rootMsgId":  review.RootMsgId,
+		"reason":     "moderation",
+		"removedAt":  time.Now().Unix(),
+	}
+
+	if review.RootMsgId != "" {
+		reply, err := svcCtx.ImThreadReplyModel.FindOneByMsgId(ctx, review.MsgId)
+		if err == model.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := svcCtx.ImThreadReplyModel.Delete(ctx, reply.Id); err != nil {
+			return err
+		}
+		data["seq"] = reply.Seq
+		pushMessageRemoved(ctx, svcCtx, review, data)
+
+		summary, err := findThreadSummary(ctx, svcCtx, review.RootMsgId)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("查询话题汇总失败: rootMsgId=%s, err=%v", review.RootMsgId, err)
+			return nil
+		}
+		pushThreadUpdate(ctx, svcCtx, review.RootMsgId, review.GroupId, summary)
+		return nil
+	}
+
+	msg, err := findExistingMessage(ctx, svcCtx, reviewConversationKey(review), review.MsgId)
+	if err != nil || msg == nil {
+		return err
+	}
+	removed, err := svcCtx.ImMessageModel.RemoveMessage(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		// 消息已被撤回、删除或销毁
+		return nil
+	}
+	data["id"] = msg.Id
+	data["seq"] = msg.Seq
+	pushMessageRemoved(ctx, svcCtx, review, data)
+	unpinRemovedMessage(ctx, svcCtx, review, operatorId)
+	return nil
+}
+
+// unpinRemovedMessage 删除消息的置顶记录并推送取消置顶通知，失败只记录日志（置顶列表中的消息内容已随消息删除）
+func unpinRemovedMessage(ctx context.Context, svcCtx *svc.ServiceContext, review *model.ImModerationReview, operatorId int64) {
+	conversationKey := model.GroupConversationKey(review.GroupId)
+	if review.ChatType == 1 {
+		conversationKey = model.PrivateConversationKey(int64(review.FromUserId), int64(review.ToUserId))
+	}
+
+	pin, err := svcCtx.ImPinnedMessageModel.FindOneByConversationKeyMsgId(ctx, conversationKey, review.MsgId)
+	if err == model.ErrNotFound {
+		return
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询置顶消息失败: msgId=%s, err=%v", review.MsgId, err)
+		return
+	}
+	if err := svcCtx.ImPinnedMessageModel.Delete(ctx, pin.Id); err != nil {
+		logx.WithContext(ctx).Errorf("删除置顶消息失败: msgId=%s, err=%v", review.MsgId, err)
+		return
+	}
+
+	// 私聊审核人不是会话成员，通知发送给会话双方
+	pushPinEventTo(ctx, svcCtx, "unpin", pin, operatorId, int64(review.FromUserId), int64(review.ToUserId))
+}
+
+// pushMessageRemoved 推送消息删除事件，失败只记录日志（客户端拉取历史时消息已是删除状态）
+func pushMessageRemoved(ctx context.Context, svcCtx *svc.ServiceContext, review *model.ImModerationReview, data map[string]interface{}) {
+	if review.ChatType == 2 {
+		if err := svcCtx.WsPushClient.PushGroupEvent(review.GroupId, eventMessageRemoved, data); err != nil {
+			logx.WithContext(ctx).Errorf("推送消息删除事件失败: groupId=%s, msgId=%s, err=%v", review.GroupId, review.MsgId, err)
+		}
+		return
+	}
+
+	for _, userId := range []uint64{review.FromUserId, review.ToUserId} {
+		if err := svcCtx.WsPushClient.PushToUser(int64(userId), eventMessageRemoved, data); err != nil {
+			logx.WithContext(ctx).Errorf("推送消息删除事件失败: userId=%d, msgId=%s, err=%v", userId, review.MsgId, err)
+		}
+	}
+}
+
+// reviewConversationKey 送审消息所属会话标识
+func reviewConversationKey(review *model.ImModerationReview) string {
+	if review.ChatType == 2 {
+		return model.GroupConversationKey(review.GroupId)
+	}
+	return model.PrivateConversationKey(int64(review.FromUserId), int64(review.ToUserId))
+}
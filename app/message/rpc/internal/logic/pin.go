@@ -0,0 +1,129 @@
+package logic
+
+// pin.go - 置顶消息的公共逻辑（会话权限、结构转换、实时通知）
+
+import (
+	"context"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPinnedMessages 每个会话最多置顶的消息数
+	maxPinnedMessages = 10
+
+	// eventMessagePin 置顶/取消置顶通知
+	eventMessagePin = "message_pin"
+)
+
+// pinConversation 校验用户对会话置顶消息的权限，返回会话标识
+// 私聊双方均可置顶；群聊查看置顶列表要求是群成员，置顶和取消置顶（manage）要求有置顶消息权限
+func pinConversation(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, chatType int32, peerId int64, groupId string, manage bool) (string, error) {
+	switch chatType {
+	case 1:
+		if peerId == 0 {
+			return "", status.Error(codes.InvalidArgument, "私聊对象不能为空")
+		}
+		return model.PrivateConversationKey(userId, peerId), nil
+	case 2:
+		if groupId == "" {
+			return "", status.Error(codes.InvalidArgument, "群组ID不能为空")
+		}
+		checkResp, err := svcCtx.GroupRpc.CheckMembership(ctx, &group.CheckMembershipReq{
+			GroupId: groupId,
+			UserId:  userId,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("检查成员资格失败: %v", err)
+			return "", status.Error(codes.Internal, "检查成员失败")
+		}
+		if !checkResp.IsMember {
+			return "", status.Error(codes.PermissionDenied, "您不是群成员")
+		}
+		if manage && !groupperm.Has(checkResp.Permissions, groupperm.Pin) {
+			return "", status.Error(codes.PermissionDenied, "您没有置顶消息的权限")
+		}
+		return model.GroupConversationKey(groupId), nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "聊天类型错误")
+	}
+}
+
+// toPinnedMessageInfo 置顶记录转换为 RPC 返回结构
+func toPinnedMessageInfo(pin *model.ImPinnedMessage) *message.PinnedMessageInfo {
+	return &message.PinnedMessageInfo{
+		Id:           int64(pin.Id),
+		ChatType:     int32(pin.ChatType),
+		GroupId:      pin.GroupId,
+		MsgId:        pin.MsgId,
+		MessageId:    int64(pin.MessageId),
+		Seq:          pin.Seq,
+		FromUserId:   int64(pin.FromUserId),
+		ContentType:  int32(pin.ContentType),
+		Content:      pin.Content,
+		Payload:      payload.Parse(int32(pin.ContentType), pin.Content),
+		MsgCreatedAt: pin.MsgCreatedAt.Unix(),
+		PinnedBy:     int64(pin.PinnedBy),
+		PinnedAt:     pin.CreatedAt.Unix(),
+	}
+}
+
+// pushPinEvent 通过 WebSocket 服务实时通知会话成员，失败只记录日志（客户端可重新拉取置顶列表）
+// action: pin 携带置顶消息，unpin 只携带 msgId；私聊时 peerId 为操作者的对方，推送给双方时换算为各自的对方
+func pushPinEvent(ctx context.Context, svcCtx *svc.ServiceContext, action string, pin *model.ImPinnedMessage, operatorId, peerId int64) {
+	pushPinEventTo(ctx, svcCtx, action, pin, operatorId, operatorId, peerId)
+}
+
+// pushPinEventTo 同 pushPinEvent，私聊时推送给 userId、peerId 双方（操作者不一定是会话成员）
+func pushPinEventTo(ctx context.Context, svcCtx *svc.ServiceContext, action string, pin *model.ImPinnedMessage, operatorId, userId, peerId int64) {
+	data := map[string]interface{}{
+		"action":     action,
+		"chatType":   pin.ChatType,
+		"msgId":      pin.MsgId,
+		"operatorId": operatorId,
+	}
+	if action == "pin" {
+		info := toPinnedMessageInfo(pin)
+		data["pinned"] = map[string]interface{}{
+			"id":           info.Id,
+			"msgId":        info.MsgId,
+			"messageId":    info.MessageId,
+			"seq":          info.Seq,
+			"fromUserId":   info.FromUserId,
+			"contentType":  info.ContentType,
+			"content":      info.Content,
+			"payload":      info.Payload,
+			"msgCreatedAt": info.MsgCreatedAt,
+			"pinnedBy":     info.PinnedBy,
+			"pinnedAt":     info.PinnedAt,
+		}
+	}
+
+	if pin.ChatType == 2 {
+		data["groupId"] = pin.GroupId
+		if err := svcCtx.WsPushClient.PushGroupEvent(pin.GroupId, eventMessagePin, data); err != nil {
+			logx.WithContext(ctx).Errorf("推送置顶通知失败: groupId=%s, msgId=%s, err=%v", pin.GroupId, pin.MsgId, err)
+		}
+		return
+	}
+
+	for _, pair := range [][2]int64{{userId, peerId}, {peerId, userId}} {
+		userData := make(map[string]interface{}, len(data)+1)
+		for k, v := range data {
+			userData[k] = v
+		}
+		userData["peerId"] = pair[1]
+		if err := svcCtx.WsPushClient.PushToUser(pair[0], eventMessagePin, userData); err != nil {
+			logx.WithContext(ctx).Errorf("推送置顶通知失败: userId=%d, msgId=%s, err=%v", pair[0], pin.MsgId, err)
+		}
+	}
+}
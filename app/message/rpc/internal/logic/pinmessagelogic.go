@@ -0,0 +1,116 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PinMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPinMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PinMessageLogic {
+	return &PinMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 置顶消息（群聊需要置顶消息权限，私聊双方均可）
+func (l *PinMessageLogic) PinMessage(in *message.PinMessageReq) (*message.PinMessageResp, error) {
+	if in.UserId == 0 || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	msg, err := l.svcCtx.ImMessageModel.FindOneByMsgId(l.ctx, in.MsgId)
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "消息不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	// 私聊只能置顶自己参与的会话中的消息
+	var peerId int64
+	if msg.ChatType == 1 {
+		switch uint64(in.UserId) {
+		case msg.FromUserId:
+			peerId = int64(msg.ToUserId)
+		case msg.ToUserId:
+			peerId = int64(msg.FromUserId)
+		default:
+			return nil, status.Error(codes.PermissionDenied, "无权置顶该消息")
+		}
+	}
+	conversationKey, err := pinConversation(l.ctx, l.svcCtx, in.UserId, int32(msg.ChatType), peerId, msg.GroupId.String, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSnapshotable(msg, "置顶"); err != nil {
+		return nil, err
+	}
+
+	// 已置顶时直接返回
+	existing, err := l.svcCtx.ImPinnedMessageModel.FindOneByConversationKeyMsgId(l.ctx, conversationKey, msg.MsgId)
+	if err == nil {
+		return &message.PinMessageResp{Info: toPinnedMessageInfo(existing)}, nil
+	}
+	if err != model.ErrNotFound {
+		l.Logger.Errorf("查询置顶消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	count, err := l.svcCtx.ImPinnedMessageModel.CountByConversation(l.ctx, conversationKey)
+	if err != nil {
+		l.Logger.Errorf("统计置顶消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if count >= maxPinnedMessages {
+		return nil, status.Error(codes.FailedPrecondition, "置顶消息已达上限，请先取消其他置顶")
+	}
+
+	pin := &model.ImPinnedMessage{
+		ConversationKey: conversationKey,
+		ChatType:        msg.ChatType,
+		GroupId:         msg.GroupId.String,
+		MsgId:           msg.MsgId,
+		MessageId:       msg.Id,
+		Seq:             msg.Seq,
+		FromUserId:      msg.FromUserId,
+		ContentType:     msg.ContentType,
+		Content:         msg.Content,
+		MsgCreatedAt:    msg.CreatedAt,
+		PinnedBy:        uint64(in.UserId),
+	}
+	result, err := l.svcCtx.ImPinnedMessageModel.Insert(l.ctx, pin)
+	if err != nil {
+		// 并发置顶同一条消息时唯一索引冲突，返回已写入的记录
+		if existing, findErr := l.svcCtx.ImPinnedMessageModel.FindOneByConversationKeyMsgId(l.ctx, conversationKey, msg.MsgId); findErr == nil {
+			return &message.PinMessageResp{Info: toPinnedMessageInfo(existing)}, nil
+		}
+		l.Logger.Errorf("置顶消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "置顶消息失败")
+	}
+	id, _ := result.LastInsertId()
+
+	inserted, err := l.svcCtx.ImPinnedMessageModel.FindOne(l.ctx, uint64(id))
+	if err != nil {
+		l.Logger.Errorf("查询置顶消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	pushPinEvent(l.ctx, l.svcCtx, "pin", inserted, in.UserId, peerId)
+
+	return &message.PinMessageResp{Info: toPinnedMessageInfo(inserted)}, nil
+}
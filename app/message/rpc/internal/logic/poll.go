@@ -0,0 +1,111 @@
+package logic
+
+// poll.go - 群投票的公共逻辑（投票定义校验、选项校验）
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// minPollOptions、maxPollOptions 选项数量范围
+	minPollOptions = 2
+	maxPollOptions = 10
+	// maxPollQuestionLen 投票主题最大长度（字符）
+	maxPollQuestionLen = 100
+	// maxPollOptionLen 选项最大长度（字符）
+	maxPollOptionLen = 50
+	// maxPollDuration 截止时间最长为发起后 30 天
+	maxPollDuration = 30 * 24 * time.Hour
+)
+
+// checkPollDefinition 校验投票主题、选项和截止时间，返回去除首尾空格后的主题和选项
+func checkPollDefinition(question string, options []string, deadline int64) (string, []string, error) {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return "", nil, status.Error(codes.InvalidArgument, "投票主题不能为空")
+	}
+	if utf8.RuneCountInString(question) > maxPollQuestionLen {
+		return "", nil, status.Error(codes.InvalidArgument, "投票主题不能超过100个字符")
+	}
+
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return "", nil, status.Error(codes.InvalidArgument, "投票选项需要2-10个")
+	}
+	result := make([]string, 0, len(options))
+	seen := make(map[string]bool, len(options))
+	for _, opt := range options {
+		opt = strings.TrimSpace(opt)
+		if opt == "" {
+			return "", nil, status.Error(codes.InvalidArgument, "投票选项不能为空")
+		}
+		if utf8.RuneCountInString(opt) > maxPollOptionLen {
+			return "", nil, status.Error(codes.InvalidArgument, "投票选项不能超过50个字符")
+		}
+		if seen[opt] {
+			return "", nil, status.Error(codes.InvalidArgument, "投票选项不能重复")
+		}
+		seen[opt] = true
+		result = append(result, opt)
+	}
+
+	if deadline > 0 {
+		now := time.Now()
+		t := time.Unix(deadline, 0)
+		if !t.After(now) {
+			return "", nil, status.Error(codes.InvalidArgument, "截止时间必须晚于当前时间")
+		}
+		if t.After(now.Add(maxPollDuration)) {
+			return "", nil, status.Error(codes.InvalidArgument, "截止时间不能超过30天")
+		}
+	}
+	return question, result, nil
+}
+
+// findPoll 按投票消息ID查询投票
+func findPoll(ctx context.Context, svcCtx *svc.ServiceContext, msgId string) (*model.ImPoll, error) {
+	p, err := svcCtx.ImPollModel.FindOneByMsgId(ctx, msgId)
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "投票不存在")
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询投票失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return p, nil
+}
+
+// normalizePollVotes 校验所选选项（去重、升序），单选时只能选择一个
+func normalizePollVotes(indexes []int32, optionCount int, multiple bool) ([]int32, error) {
+	seen := make(map[int32]bool, len(indexes))
+	result := make([]int32, 0, len(indexes))
+	for _, idx := range indexes {
+		if idx < 0 || int(idx) >= optionCount {
+			return nil, status.Error(codes.InvalidArgument, "投票选项不存在")
+		}
+		if seen[idx] {
+			continue
+		}
+		seen[idx] = true
+		result = append(result, idx)
+	}
+
+	if len(result) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "请选择投票选项")
+	}
+	if !multiple && len(result) > 1 {
+		return nil, status.Error(codes.InvalidArgument, "单选投票只能选择一个选项")
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RemoveGroupSensitiveWordsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveGroupSensitiveWordsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveGroupSensitiveWordsLogic {
+	return &RemoveGroupSensitiveWordsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除群自定义敏感词（需要内容审核权限）
+func (l *RemoveGroupSensitiveWordsLogic) RemoveGroupSensitiveWords(in *message.GroupSensitiveWordsReq) (*message.GroupSensitiveWordsResp, error) {
+	if in.UserId == 0 || in.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	words, err := normalizeSensitiveWords(in.Words)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGroupPermission(l.ctx, l.svcCtx, in.GroupId, in.UserId, groupperm.Moderate); err != nil {
+		return nil, err
+	}
+
+	for _, word := range words {
+		existing, err := l.svcCtx.ImGroupSensitiveWordModel.FindOneByGroupIdWord(l.ctx, in.GroupId, word)
+		if err == model.ErrNotFound {
+			continue
+		}
+		if err == nil {
+			err = l.svcCtx.ImGroupSensitiveWordModel.Delete(l.ctx, existing.Id)
+		}
+		if err != nil {
+			l.Logger.Errorf("删除群敏感词失败: %v", err)
+			return nil, status.Error(codes.Internal, "删除敏感词失败")
+		}
+	}
+
+	l.svcCtx.WordFilter.InvalidateGroup(in.GroupId)
+	return listGroupSensitiveWords(l.ctx, l.svcCtx, in.GroupId)
+}
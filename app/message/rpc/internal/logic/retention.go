@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+)
+
+// messageStatusPurged 消息已清理（超过保留期限，仅保留占位）
+const messageStatusPurged = 5
+
+// loadGroupRetention 查询群生效的保留策略，未单独设置时返回全局默认值
+func loadGroupRetention(ctx context.Context, svcCtx *svc.ServiceContext, groupId string) (*message.GroupRetentionInfo, error) {
+	c := svcCtx.Config.Retention
+	info := &message.GroupRetentionInfo{
+		GroupId:          groupId,
+		RetentionDays:    int32(c.DefaultDays),
+		IsDefault:        true,
+		DefaultDays:      int32(c.DefaultDays),
+		ArchiveAfterDays: int32(c.ArchiveAfterDays),
+	}
+
+	retention, err := svcCtx.ImGroupRetentionModel.FindOne(ctx, groupId)
+	if errors.Is(err, model.ErrNotFound) {
+		return info, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	info.RetentionDays = int32(retention.RetentionDays)
+	info.IsDefault = false
+	info.UpdatedBy = int64(retention.UpdatedBy)
+	info.UpdatedAt = retention.UpdatedAt.Unix()
+	return info, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/poll"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RetractPollVoteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRetractPollVoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RetractPollVoteLogic {
+	return &RetractPollVoteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 撤回自己的投票
+func (l *RetractPollVoteLogic) RetractPollVote(in *message.RetractPollVoteReq) (*message.RetractPollVoteResp, error) {
+	if in.UserId == 0 || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	p, err := findPoll(l.ctx, l.svcCtx, in.MsgId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkGroupMember(l.ctx, l.svcCtx, p.GroupId, in.UserId); err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(p, time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "投票已结束")
+	}
+
+	vote, err := l.svcCtx.ImPollVoteModel.FindOneByPollIdUserId(l.ctx, p.Id, uint64(in.UserId))
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.FailedPrecondition, "您还没有投票")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询投票记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	if err := l.svcCtx.ImPollVoteModel.Delete(l.ctx, vote.Id); err != nil {
+		l.Logger.Errorf("撤回投票失败: %v", err)
+		return nil, status.Error(codes.Internal, "撤回投票失败")
+	}
+
+	info, err := poll.BuildInfo(l.ctx, l.svcCtx, p, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("汇总投票结果失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	poll.PushUpdate(l.ctx, l.svcCtx, info)
+
+	return &message.RetractPollVoteResp{Info: info}, nil
+}
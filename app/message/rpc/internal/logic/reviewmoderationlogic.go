@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReviewModerationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewModerationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewModerationLogic {
+	return &ReviewModerationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 处理送审消息：通过或删除消息
+func (l *ReviewModerationLogic) ReviewModeration(in *message.ReviewModerationReq) (*message.ReviewModerationResp, error) {
+	if in.UserId == 0 || in.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	review, err := l.svcCtx.ImModerationReviewModel.FindOne(l.ctx, uint64(in.Id))
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "审核记录不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询审核记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if err := checkReviewPermission(l.ctx, l.svcCtx, in.UserId, review.GroupId); err != nil {
+		return nil, err
+	}
+	if review.Status != model.ReviewStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "该消息已处理")
+	}
+
+	newStatus := int64(model.ReviewStatusApproved)
+	if in.Remove {
+		newStatus = model.ReviewStatusRemoved
+	}
+	now := time.Now()
+	resolved, err := l.svcCtx.ImModerationReviewModel.Resolve(l.ctx, review, newStatus, uint64(in.UserId), now)
+	if err != nil {
+		l.Logger.Errorf("处理审核记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "处理失败")
+	}
+	if !resolved {
+		// 其他审核人已先处理
+		return nil, status.Error(codes.FailedPrecondition, "该消息已处理")
+	}
+
+	if in.Remove {
+		if err := removeReviewedMessage(l.ctx, l.svcCtx, review, in.UserId); err != nil {
+			l.Logger.Errorf("删除违规消息失败: msgId=%s, err=%v", review.MsgId, err)
+			return nil, status.Error(codes.Internal, "删除消息失败")
+		}
+	}
+
+	review.Status = newStatus
+	review.ReviewerId = uint64(in.UserId)
+	review.ReviewedAt.Time, review.ReviewedAt.Valid = now, true
+	return &message.ReviewModerationResp{Review: toModerationReviewInfo(review)}, nil
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"encoding/json"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/message"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxScheduleAhead 定时消息最多提前 30 天创建
+	maxScheduleAhead = 30 * 24 * time.Hour
+	// maxPendingScheduled 每个用户最多同时存在的待发送定时消息数
+	maxPendingScheduled = 100
+)
+
+// checkSendAt 校验计划发送时间：必须晚于当前时间且不超过最大提前量
+func checkSendAt(sendAt int64) (time.Time, error) {
+	t := time.Unix(sendAt, 0)
+	now := time.Now()
+	if !t.After(now) {
+		return time.Time{}, status.Error(codes.InvalidArgument, "发送时间必须晚于当前时间")
+	}
+	if t.Sub(now) > maxScheduleAhead {
+		return time.Time{}, status.Error(codes.InvalidArgument, "发送时间不能超过30天")
+	}
+	return t, nil
+}
+
+// toScheduledMessageInfo 定时消息转换为 RPC 返回结构
+func toScheduledMessageInfo(data *model.ImScheduledMessage) *message.ScheduledMessageInfo {
+	var atUserIds []int64
+	if data.AtUserIds.Valid && data.AtUserIds.String != "" {
+		_ = json.Unmarshal([]byte(data.AtUserIds.String), &atUserIds)
+	}
+
+	return &message.ScheduledMessageInfo{
+		Id:          int64(data.Id),
+		MsgId:       data.MsgId,
+		FromUserId:  int64(data.FromUserId),
+		ToUserId:    int64(data.ToUserId),
+		ChatType:    int32(data.ChatType),
+		GroupId:     data.GroupId,
+		Content:     data.Content,
+		ContentType: int32(data.ContentType),
+		AtUserIds:   atUserIds,
+		SendAt:      data.SendAt.Unix(),
+		Status:      int32(data.Status),
+		MessageId:   int64(data.MessageId),
+		FailReason:  data.FailReason,
+		CreatedAt:   data.CreatedAt.Unix(),
+	}
+}
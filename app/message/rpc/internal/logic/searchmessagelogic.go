@@ -2,8 +2,8 @@ package logic
 
 import (
 	"context"
-	"encoding/json"
 
+	"SkyeIM/app/message/rpc/internal/search"
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
 
@@ -24,39 +24,35 @@ func NewSearchMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Sea
 	}
 }
 
-// 模糊搜索消息内容
+// 搜索聊天记录（全文索引，仅限用户可见的会话）
 func (l *SearchMessageLogic) SearchMessage(in *message.SearchMessageReq) (*message.SearchMessageResp, error) {
-	messages, err := l.svcCtx.ImMessageModel.SearchByKeyword(l.ctx, in.UserId, in.Keyword)
+	result, err := l.svcCtx.Searcher.Search(l.ctx, &search.Params{
+		UserId:      in.UserId,
+		Keyword:     in.Keyword,
+		PeerId:      in.PeerId,
+		GroupId:     in.GroupId,
+		SenderId:    in.SenderId,
+		StartTime:   in.StartTime,
+		EndTime:     in.EndTime,
+		ContentType: in.ContentType,
+		Cursor:      in.Cursor,
+		Limit:       in.Limit,
+	})
 	if err != nil {
-		l.Logger.Errorf("RPC 搜索消息失败: %v", err)
 		return nil, err
 	}
 
-	var list []*message.MessageInfo
-	for _, v := range messages {
-		// 解析at_user_ids JSON
-		var atUserIds []int64
-		if v.AtUserIds.Valid && v.AtUserIds.String != "" {
-			_ = json.Unmarshal([]byte(v.AtUserIds.String), &atUserIds)
-		}
-
-		list = append(list, &message.MessageInfo{
-			Id:          int64(v.Id),
-			MsgId:       v.MsgId,
-			FromUserId:  int64(v.FromUserId),
-			ToUserId:    int64(v.ToUserId),
-			ChatType:    int32(v.ChatType),
-			GroupId:     v.GroupId.String,
-			Content:     v.Content,
-			ContentType: int32(v.ContentType),
-			Status:      int32(v.Status),
-			CreatedAt:   v.CreatedAt.Unix(),
-			Seq:         v.Seq,
-			AtUserIds:   atUserIds,
+	hits := make([]*message.SearchHit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		hits = append(hits, &message.SearchHit{
+			Message: toMessageInfo(hit.Message),
+			Snippet: hit.Snippet,
 		})
 	}
 
 	return &message.SearchMessageResp{
-		List: list,
+		Hits:       hits,
+		NextCursor: result.NextCursor,
+		HasMore:    result.HasMore,
 	}, nil
 }
@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
-	"fmt"
 
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/moderation"
+	"SkyeIM/app/message/rpc/internal/payload"
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/groupperm"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -36,6 +38,62 @@ func (l *SendGroupMessageLogic) SendGroupMessage(in *message.SendGroupMessageReq
 		return nil, status.Error(codes.InvalidArgument, "参数错误")
 	}
 
+	// 校验消息内容格式
+	contentType := in.ContentType
+	if contentType == 0 {
+		contentType = payload.TypeText
+	}
+	if err := validatePayload(contentType, in.Content); err != nil {
+		return nil, err
+	}
+
+	// 内容审核（全局词库和群自定义词库）：拦截时拒绝发送，替换时保存替换后的内容
+	verdict, err := moderateContent(l.ctx, l.svcCtx, &moderation.Input{
+		ChatType:    2,
+		GroupId:     in.GroupId,
+		FromUserId:  in.FromUserId,
+		ContentType: contentType,
+		Content:     in.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	in.Content = verdict.Content
+
+	resp, err := l.send(in, contentType)
+	if err != nil || resp.Duplicate {
+		return resp, err
+	}
+
+	enqueueReview(l.ctx, l.svcCtx, verdict, &model.ImModerationReview{
+		MsgId:       resp.MsgId,
+		ChatType:    2,
+		FromUserId:  uint64(in.FromUserId),
+		GroupId:     in.GroupId,
+		Content:     resp.Content,
+		ContentType: int64(contentType),
+	})
+	return resp, nil
+}
+
+// send 写入已校验内容格式的群消息（投票等由服务端生成内容的消息也走这里）
+func (l *SendGroupMessageLogic) send(in *message.SendGroupMessageReq, contentType int32) (*message.SendGroupMessageResp, error) {
+	// 幂等：msg_id 已存在说明是客户端重试，直接返回原消息
+	existing, err := findExistingMessage(l.ctx, l.svcCtx, model.GroupConversationKey(in.GroupId), in.MsgId)
+	if err != nil {
+		l.Logger.Errorf("查询消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if existing != nil {
+		return l.duplicateResp(existing, in)
+	}
+
+	// 校验阅后即焚参数（群聊没有逐条已读，仅支持发送后计时）
+	expireTtl, expireMode, expireAt, err := resolveExpiry(in.ExpireTtl, in.ExpireMode, false)
+	if err != nil {
+		return nil, err
+	}
+
 	checkResp, err := l.svcCtx.GroupRpc.CheckMembership(l.ctx, &group.CheckMembershipReq{
 		GroupId: in.GroupId,
 		UserId:  in.FromUserId,
@@ -49,68 +107,17 @@ func (l *SendGroupMessageLogic) SendGroupMessage(in *message.SendGroupMessageReq
 		return nil, status.Error(codes.PermissionDenied, "您不是群成员")
 	}
 
-	if checkResp.Member.Mute == 1 {
-		return nil, status.Error(codes.PermissionDenied, "您已被禁言")
+	if checkResp.Muted {
+		return nil, mutedError(checkResp)
 	}
 
-	// 验证@权限：如果包含@全体(-1)，只有群主和管理员可以使用
+	// 验证@权限：如果包含@全体(-1)，需要有@全体成员权限（按群权限配置）
 	if containsAtAll(in.AtUserIds) {
-		if checkResp.Member.Role != 1 && checkResp.Member.Role != 2 {
-			return nil, status.Error(codes.PermissionDenied, "只有群主和管理员可以@全体成员")
+		if !groupperm.Has(checkResp.Permissions, groupperm.AtAll) {
+			return nil, status.Error(codes.PermissionDenied, "您没有@全体成员的权限")
 		}
 	}
 
-	// 1. 生成 Seq（优先 Redis，降级到数据库）
-	seqKey := fmt.Sprintf("group:seq:%s", in.GroupId)
-	seq, err := l.svcCtx.Redis.Incr(seqKey)
-	if err != nil {
-		// Redis 挂了，降级到数据库生成 Seq
-		l.Logger.Warnf("Redis 生成 Seq 失败，降级到数据库: %v", err)
-
-		maxSeq, dbErr := l.svcCtx.ImMessageModel.FindGroupMaxSeq(l.ctx, in.GroupId)
-		if dbErr != nil {
-			l.Logger.Errorf("数据库查询最大 Seq 失败: %v", dbErr)
-			return nil, status.Error(codes.Internal, "系统错误")
-		}
-
-		seq = int64(maxSeq) + 1
-		l.Logger.Infof("群 %s Seq 降级生成: DB=%d -> New=%d", in.GroupId, maxSeq, seq)
-
-		// 尝试回写 Redis（异步，失败不影响主流程）
-		go func() {
-			if setErr := l.svcCtx.Redis.Set(seqKey, fmt.Sprintf("%d", seq)); setErr != nil {
-				l.Logger.Warnf("回写 Redis Seq 失败: %v", setErr)
-			}
-		}()
-	} else {
-		// 惰性恢复逻辑：如果Seq为1，检查数据库中是否已有消息（Redis 数据丢失场景）
-		if seq == 1 {
-			maxSeq, err := l.svcCtx.ImMessageModel.FindGroupMaxSeq(l.ctx, in.GroupId)
-			if err != nil {
-				// 如果数据库查询失败，为了数据一致性，建议报错（防止产生重复Seq）
-				l.Logger.Errorf("查询群最大Seq失败: %v", err)
-				return nil, status.Error(codes.Internal, "系统错误")
-			}
-
-			if maxSeq > 0 {
-				// Redis数据丢失，需要恢复
-				// 将Redis设置为已有的最大Seq + 1 (即本次消息应有的Seq)
-				// 注意：这里存在极低概率的并发竞态，但在故障恢复场景下可接受
-				newSeq := int64(maxSeq) + 1
-				// 将Redis设置为已有的最大Seq + 1
-				l.svcCtx.Redis.Set(seqKey, fmt.Sprintf("%d", newSeq))
-
-				seq = newSeq
-				l.Logger.Infof("群 %s Seq 恢复: Redis=1 -> DB=%d -> New=%d", in.GroupId, maxSeq, seq)
-			}
-		}
-	}
-
-	contentType := in.ContentType
-	if contentType == 0 {
-		contentType = 1
-	}
-
 	// 将at_user_ids转换为JSON字符串存储
 	var atUserIdsJSON sql.NullString
 	if len(in.AtUserIds) > 0 {
@@ -125,27 +132,65 @@ func (l *SendGroupMessageLogic) SendGroupMessage(in *message.SendGroupMessageReq
 		FromUserId:  uint64(in.FromUserId),
 		ChatType:    2,
 		GroupId:     sql.NullString{String: in.GroupId, Valid: true},
-		Seq:         uint64(seq),
 		Content:     in.Content,
 		ContentType: int64(contentType),
 		Status:      0,
 		AtUserIds:   atUserIdsJSON,
+		ExpireTtl:   expireTtl,
+		ExpireMode:  expireMode,
+		ExpireAt:    expireAt,
 	}
 
-	result, err := l.svcCtx.ImMessageModel.Insert(l.ctx, msgData)
+	// 1. 生成 Seq（优先 Redis，降级到数据库）并插入数据库，插入失败时释放 Seq
+	_, result, err := insertWithSeq(l.ctx, l.svcCtx, groupSeqKey(in.GroupId), func(ctx context.Context) (int64, error) {
+		return l.svcCtx.ImMessageModel.FindGroupMaxSeq(ctx, in.GroupId)
+	}, func(seq int64) (sql.Result, error) {
+		msgData.Seq = uint64(seq)
+		return l.svcCtx.ImMessageModel.Insert(l.ctx, msgData)
+	})
 	if err != nil {
+		// 并发重试：另一个请求已先插入同一 msg_id
+		if isDuplicateKeyErr(err) {
+			existing, findErr := findExistingMessage(l.ctx, l.svcCtx, model.GroupConversationKey(in.GroupId), in.MsgId)
+			if findErr == nil && existing != nil {
+				return l.duplicateResp(existing, in)
+			}
+		}
 		l.Logger.Errorf("插入群聊消息失败: %v", err)
 		return nil, status.Error(codes.Internal, "发送消息失败")
 	}
 
+	incrGroupUnread(l.svcCtx, in.GroupId, in.FromUserId, in.AtUserIds)
+
 	msgId, _ := result.LastInsertId()
-	inserted, _ := l.svcCtx.ImMessageModel.FindOne(l.ctx, uint64(msgId))
+	inserted, _ := l.svcCtx.ImMessageModel.FindOneInConversation(l.ctx, model.GroupConversationKey(in.GroupId), uint64(msgId))
+	enqueueLinkPreview(l.ctx, l.svcCtx, inserted)
 
 	return &message.SendGroupMessageResp{
 		Id:        msgId,
 		MsgId:     in.MsgId,
 		CreatedAt: inserted.CreatedAt.Unix(),
 		Seq:       inserted.Seq,
+		ExpireAt:  expireAtUnix(inserted.ExpireAt),
+		Content:   inserted.Content,
+	}, nil
+}
+
+// duplicateResp 校验重试请求与原消息一致后，返回原消息的 id、seq、created_at
+func (l *SendGroupMessageLogic) duplicateResp(existing *model.ImMessage, in *message.SendGroupMessageReq) (*message.SendGroupMessageResp, error) {
+	if !isSameGroupMessage(existing, in.FromUserId, in.GroupId) {
+		l.Logger.Errorf("群消息ID冲突: msgId=%s, from=%d, group=%s", in.MsgId, in.FromUserId, in.GroupId)
+		return nil, errMsgIdConflict
+	}
+
+	return &message.SendGroupMessageResp{
+		Id:        int64(existing.Id),
+		MsgId:     existing.MsgId,
+		CreatedAt: existing.CreatedAt.Unix(),
+		Seq:       existing.Seq,
+		Duplicate: true,
+		ExpireAt:  expireAtUnix(existing.ExpireAt),
+		Content:   existing.Content,
 	}, nil
 }
 
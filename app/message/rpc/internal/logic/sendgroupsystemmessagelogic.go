@@ -0,0 +1,184 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/app/user/rpc/userClient"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// systemNameBatchSize BatchGetUsers 单次最多查询的用户数
+const systemNameBatchSize = 100
+
+type SendGroupSystemMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendGroupSystemMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendGroupSystemMessageLogic {
+	return &SendGroupSystemMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 写入群系统消息（成员变动等事件，供内部服务调用）
+func (l *SendGroupSystemMessageLogic) SendGroupSystemMessage(in *message.SendGroupSystemMessageReq) (*message.SendGroupMessageResp, error) {
+	if in.GroupId == "" || !payload.ValidEvent(in.Event) {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	msgId := in.MsgId
+	if msgId == "" {
+		msgId = "sys_" + uuid.New().String()
+	}
+
+	// 幂等：调用方重试时复用 msg_id，直接返回原消息
+	existing, err := findExistingMessage(l.ctx, l.svcCtx, model.GroupConversationKey(in.GroupId), msgId)
+	if err != nil {
+		l.Logger.Errorf("查询消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if existing != nil {
+		return l.duplicateResp(existing, in)
+	}
+
+	content, err := l.buildContent(in)
+	if err != nil {
+		l.Logger.Errorf("序列化群系统消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	// 系统消息与普通群消息共用群 Seq，离线同步时按顺序下发
+	// 发送者记为操作者，操作者自己不产生未读
+	msgData := &model.ImMessage{
+		MsgId:       msgId,
+		FromUserId:  uint64(in.OperatorId),
+		ChatType:    2,
+		GroupId:     sql.NullString{String: in.GroupId, Valid: true},
+		Content:     content,
+		ContentType: payload.TypeSystem,
+		Status:      0,
+	}
+
+	_, result, err := insertWithSeq(l.ctx, l.svcCtx, groupSeqKey(in.GroupId), func(ctx context.Context) (int64, error) {
+		return l.svcCtx.ImMessageModel.FindGroupMaxSeq(ctx, in.GroupId)
+	}, func(seq int64) (sql.Result, error) {
+		msgData.Seq = uint64(seq)
+		return l.svcCtx.ImMessageModel.Insert(l.ctx, msgData)
+	})
+	if err != nil {
+		if isDuplicateKeyErr(err) {
+			existing, findErr := findExistingMessage(l.ctx, l.svcCtx, model.GroupConversationKey(in.GroupId), msgId)
+			if findErr == nil && existing != nil {
+				return l.duplicateResp(existing, in)
+			}
+		}
+		l.Logger.Errorf("插入群系统消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "写入系统消息失败")
+	}
+
+	id, _ := result.LastInsertId()
+	inserted, err := l.svcCtx.ImMessageModel.FindOneInConversation(l.ctx, model.ConversationKey(msgData), uint64(id))
+	if err != nil {
+		l.Logger.Errorf("查询群系统消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	// 推送格式与 WebSocket 实时群聊消息一致，额外携带渲染后的展示文本
+	info := toMessageInfo(inserted)
+	if err := l.svcCtx.WsPushClient.PushGroupEvent(in.GroupId, "group_chat", map[string]interface{}{
+		"msgId":       msgId,
+		"fromUserId":  in.OperatorId,
+		"groupId":     in.GroupId,
+		"content":     content,
+		"contentType": payload.TypeSystem,
+		"createdAt":   inserted.CreatedAt.Unix(),
+		"seq":         inserted.Seq,
+		"payload":     info.Payload,
+	}); err != nil {
+		l.Logger.Errorf("推送群系统消息失败: msgId=%s, err=%v", msgId, err)
+	}
+
+	return &message.SendGroupMessageResp{
+		Id:        id,
+		MsgId:     msgId,
+		CreatedAt: inserted.CreatedAt.Unix(),
+		Seq:       inserted.Seq,
+	}, nil
+}
+
+// duplicateResp 校验重试请求与原消息一致后，返回原消息的 id、seq、created_at
+func (l *SendGroupSystemMessageLogic) duplicateResp(existing *model.ImMessage, in *message.SendGroupSystemMessageReq) (*message.SendGroupMessageResp, error) {
+	if existing.ContentType != payload.TypeSystem || !isSameGroupMessage(existing, in.OperatorId, in.GroupId) {
+		l.Logger.Errorf("群系统消息ID冲突: msgId=%s, group=%s", existing.MsgId, in.GroupId)
+		return nil, errMsgIdConflict
+	}
+
+	return &message.SendGroupMessageResp{
+		Id:        int64(existing.Id),
+		MsgId:     existing.MsgId,
+		CreatedAt: existing.CreatedAt.Unix(),
+		Seq:       existing.Seq,
+		Duplicate: true,
+	}, nil
+}
+
+// buildContent 生成系统消息内容，记录写入时的用户昵称
+func (l *SendGroupSystemMessageLogic) buildContent(in *message.SendGroupSystemMessageReq) (string, error) {
+	names := l.resolveNames(append([]int64{in.OperatorId}, in.TargetIds...))
+
+	sys := &payload.System{
+		Event:  in.Event,
+		Params: in.Params,
+	}
+	if in.OperatorId > 0 {
+		sys.Operator = &payload.SystemUser{UserId: in.OperatorId, Name: names[in.OperatorId]}
+	}
+	for _, uid := range in.TargetIds {
+		sys.Targets = append(sys.Targets, payload.SystemUser{UserId: uid, Name: names[uid]})
+	}
+	return sys.Encode()
+}
+
+// resolveNames 批量查询用户昵称，查询失败时名称为空（渲染时显示为用户ID）
+func (l *SendGroupSystemMessageLogic) resolveNames(ids []int64) map[int64]string {
+	names := make(map[int64]string)
+	var pending []int64
+	for _, id := range ids {
+		if _, ok := names[id]; ok || id <= 0 {
+			continue
+		}
+		names[id] = ""
+		pending = append(pending, id)
+	}
+
+	for start := 0; start < len(pending); start += systemNameBatchSize {
+		end := min(start+systemNameBatchSize, len(pending))
+		resp, err := l.svcCtx.UserRpc.BatchGetUsers(l.ctx, &userClient.BatchGetUsersRequest{Ids: pending[start:end]})
+		if err != nil {
+			l.Logger.Errorf("批量查询用户失败: %v", err)
+			continue
+		}
+		for _, u := range resp.Users {
+			switch {
+			case u.Nickname != "":
+				names[u.Id] = u.Nickname
+			case u.Username != "":
+				names[u.Id] = u.Username
+			}
+		}
+	}
+	return names
+}
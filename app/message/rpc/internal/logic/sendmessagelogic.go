@@ -2,12 +2,17 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 
 	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/moderation"
+	"SkyeIM/app/message/rpc/internal/payload"
 	"SkyeIM/app/message/rpc/internal/svc"
 	"SkyeIM/app/message/rpc/message"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SendMessageLogic struct {
@@ -26,20 +31,76 @@ func NewSendMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendM
 
 // 发送消息（存储到数据库）
 func (l *SendMessageLogic) SendMessage(in *message.SendMessageReq) (*message.SendMessageResp, error) {
+	// 幂等：msg_id 已存在说明是客户端重试，直接返回原消息
+	existing, err := findExistingMessage(l.ctx, l.svcCtx, model.PrivateConversationKey(in.FromUserId, in.ToUserId), in.MsgId)
+	if err != nil {
+		l.Logger.Errorf("SendMessage FindOneByMsgId failed: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if existing != nil {
+		return l.duplicateResp(existing, in)
+	}
+
+	// 校验消息内容格式
+	contentType := in.ContentType
+	if contentType == 0 {
+		contentType = payload.TypeText
+	}
+	if err := validatePayload(contentType, in.Content); err != nil {
+		return nil, err
+	}
+
+	// 校验阅后即焚参数
+	expireTtl, expireMode, expireAt, err := resolveExpiry(in.ExpireTtl, in.ExpireMode, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验好友关系、黑名单、陌生人消息设置
+	if err := l.svcCtx.PrivatePolicy.CheckSend(l.ctx, in.FromUserId, in.ToUserId); err != nil {
+		return nil, err
+	}
+
+	// 内容审核：拦截时拒绝发送，替换时保存替换后的内容
+	verdict, err := moderateContent(l.ctx, l.svcCtx, &moderation.Input{
+		ChatType:    1,
+		FromUserId:  in.FromUserId,
+		ContentType: contentType,
+		Content:     in.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// 创建消息记录
 	msg := &model.ImMessage{
 		MsgId:       in.MsgId,
 		FromUserId:  uint64(in.FromUserId),
 		ToUserId:    uint64(in.ToUserId),
 		ChatType:    1,
-		Content:     in.Content,
-		ContentType: int64(in.ContentType),
+		Content:     verdict.Content,
+		ContentType: int64(contentType),
 		Status:      0, // 默认未读
+		ExpireTtl:   expireTtl,
+		ExpireMode:  expireMode,
+		ExpireAt:    expireAt,
 	}
 
-	// 插入数据库
-	result, err := l.svcCtx.ImMessageModel.Insert(l.ctx, msg)
+	// 生成会话内 Seq（优先 Redis，降级到数据库）并插入数据库，插入失败时释放 Seq
+	_, result, err := insertWithSeq(l.ctx, l.svcCtx, privateSeqKey(in.FromUserId, in.ToUserId), func(ctx context.Context) (int64, error) {
+		return l.svcCtx.ImMessageModel.FindPrivateMaxSeq(ctx, in.FromUserId, in.ToUserId)
+	}, func(seq int64) (sql.Result, error) {
+		msg.Seq = uint64(seq)
+		return l.svcCtx.ImMessageModel.Insert(l.ctx, msg)
+	})
 	if err != nil {
+		// 并发重试：另一个请求已先插入同一 msg_id
+		if isDuplicateKeyErr(err) {
+			existing, findErr := findExistingMessage(l.ctx, l.svcCtx, model.PrivateConversationKey(in.FromUserId, in.ToUserId), in.MsgId)
+			if findErr == nil && existing != nil {
+				return l.duplicateResp(existing, in)
+			}
+		}
 		l.Logger.Errorf("SendMessage Insert failed: %v", err)
 		return nil, err
 	}
@@ -51,16 +112,49 @@ func (l *SendMessageLogic) SendMessage(in *message.SendMessageReq) (*message.Sen
 		return nil, err
 	}
 
+	incrPrivateUnread(l.ctx, l.svcCtx, in.ToUserId, in.FromUserId)
+
 	// 获取消息详情（包含服务器时间戳）
-	inserted, err := l.svcCtx.ImMessageModel.FindOne(l.ctx, uint64(id))
+	inserted, err := l.svcCtx.ImMessageModel.FindOneInConversation(l.ctx, model.ConversationKey(msg), uint64(id))
 	if err != nil {
 		l.Logger.Errorf("SendMessage FindOne failed: %v", err)
 		return nil, err
 	}
 
+	enqueueReview(l.ctx, l.svcCtx, verdict, &model.ImModerationReview{
+		MsgId:       inserted.MsgId,
+		ChatType:    1,
+		FromUserId:  inserted.FromUserId,
+		ToUserId:    inserted.ToUserId,
+		Content:     inserted.Content,
+		ContentType: inserted.ContentType,
+	})
+	enqueueLinkPreview(l.ctx, l.svcCtx, inserted)
+
 	return &message.SendMessageResp{
 		Id:        id,
 		MsgId:     in.MsgId,
 		CreatedAt: inserted.CreatedAt.Unix(),
+		Seq:       inserted.Seq,
+		ExpireAt:  expireAtUnix(inserted.ExpireAt),
+		Content:   inserted.Content,
+	}, nil
+}
+
+// duplicateResp 校验重试请求与原消息一致后，返回原消息的 id、seq、created_at
+func (l *SendMessageLogic) duplicateResp(existing *model.ImMessage, in *message.SendMessageReq) (*message.SendMessageResp, error) {
+	if !isSamePrivateMessage(existing, in.FromUserId, in.ToUserId) {
+		l.Logger.Errorf("SendMessage msg_id conflict: msgId=%s, from=%d, to=%d", in.MsgId, in.FromUserId, in.ToUserId)
+		return nil, errMsgIdConflict
+	}
+
+	return &message.SendMessageResp{
+		Id:        int64(existing.Id),
+		MsgId:     existing.MsgId,
+		CreatedAt: existing.CreatedAt.Unix(),
+		Seq:       existing.Seq,
+		Duplicate: true,
+		ExpireAt:  expireAtUnix(existing.ExpireAt),
+		Content:   existing.Content,
 	}, nil
 }
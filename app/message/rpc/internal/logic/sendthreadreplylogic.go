@@ -0,0 +1,171 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/moderation"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SendThreadReplyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendThreadReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendThreadReplyLogic {
+	return &SendThreadReplyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 发送群话题回复（不进入群主时间线，不累加群未读数）
+func (l *SendThreadReplyLogic) SendThreadReply(in *message.SendThreadReplyReq) (*message.SendThreadReplyResp, error) {
+	if in.MsgId == "" || in.FromUserId == 0 || in.RootMsgId == "" || in.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	// 校验消息内容格式
+	contentType := in.ContentType
+	if contentType == 0 {
+		contentType = payload.TypeText
+	}
+	if err := validatePayload(contentType, in.Content); err != nil {
+		return nil, err
+	}
+
+	// 幂等：msg_id 已存在说明是客户端重试，直接返回原回复
+	existing, err := l.svcCtx.ImThreadReplyModel.FindOneByMsgId(l.ctx, in.MsgId)
+	if err == nil {
+		return l.duplicateResp(existing, in)
+	}
+	if err != model.ErrNotFound {
+		l.Logger.Errorf("查询话题回复失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	root, err := findThreadRoot(l.ctx, l.svcCtx, in.RootMsgId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkThreadRoot(root); err != nil {
+		return nil, err
+	}
+
+	if _, err := checkGroupSpeaker(l.ctx, l.svcCtx, root.GroupId.String, in.FromUserId); err != nil {
+		return nil, err
+	}
+
+	// 内容审核（全局词库和群自定义词库）
+	verdict, err := moderateContent(l.ctx, l.svcCtx, &moderation.Input{
+		ChatType:    2,
+		GroupId:     root.GroupId.String,
+		FromUserId:  in.FromUserId,
+		ContentType: contentType,
+		Content:     in.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 话题内独立的 Seq，不占用群 Seq
+	// 生成话题内 Seq 并插入回复，插入失败时释放 Seq
+	_, result, err := insertWithSeq(l.ctx, l.svcCtx, threadSeqKey(root.MsgId), func(ctx context.Context) (int64, error) {
+		return l.svcCtx.ImThreadReplyModel.FindMaxSeq(ctx, root.MsgId)
+	}, func(seq int64) (sql.Result, error) {
+		return l.svcCtx.ImThreadReplyModel.Insert(l.ctx, &model.ImThreadReply{
+			MsgId:       in.MsgId,
+			RootMsgId:   root.MsgId,
+			GroupId:     root.GroupId.String,
+			Seq:         uint64(seq),
+			FromUserId:  uint64(in.FromUserId),
+			Content:     verdict.Content,
+			ContentType: int64(contentType),
+		})
+	})
+	if err != nil {
+		// 并发重试：另一个请求已先插入同一 msg_id
+		if isDuplicateKeyErr(err) {
+			if existing, findErr := l.svcCtx.ImThreadReplyModel.FindOneByMsgId(l.ctx, in.MsgId); findErr == nil {
+				return l.duplicateResp(existing, in)
+			}
+		}
+		l.Logger.Errorf("插入话题回复失败: %v", err)
+		return nil, status.Error(codes.Internal, "发送回复失败")
+	}
+
+	id, _ := result.LastInsertId()
+	inserted, err := l.svcCtx.ImThreadReplyModel.FindOne(l.ctx, uint64(id))
+	if err != nil {
+		l.Logger.Errorf("查询话题回复失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	enqueueReview(l.ctx, l.svcCtx, verdict, &model.ImModerationReview{
+		MsgId:       inserted.MsgId,
+		RootMsgId:   inserted.RootMsgId,
+		ChatType:    2,
+		FromUserId:  inserted.FromUserId,
+		GroupId:     inserted.GroupId,
+		Content:     inserted.Content,
+		ContentType: inserted.ContentType,
+	})
+
+	// 回复者和根消息发送者自动关注话题
+	for _, userId := range []int64{in.FromUserId, int64(root.FromUserId)} {
+		if err := followThread(l.ctx, l.svcCtx, root, userId); err != nil {
+			l.Logger.Errorf("关注话题失败: rootMsgId=%s, userId=%d, err=%v", root.MsgId, userId, err)
+		}
+	}
+
+	summary, err := findThreadSummary(l.ctx, l.svcCtx, root.MsgId)
+	if err != nil {
+		l.Logger.Errorf("查询话题汇总失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	pushThreadReply(l.ctx, l.svcCtx, inserted, summary)
+
+	return &message.SendThreadReplyResp{
+		Id:        id,
+		MsgId:     inserted.MsgId,
+		Seq:       inserted.Seq,
+		CreatedAt: inserted.CreatedAt.Unix(),
+		Thread:    summary,
+		Content:   inserted.Content,
+	}, nil
+}
+
+// duplicateResp 校验重试请求与原回复一致后，返回原回复
+func (l *SendThreadReplyLogic) duplicateResp(existing *model.ImThreadReply, in *message.SendThreadReplyReq) (*message.SendThreadReplyResp, error) {
+	if int64(existing.FromUserId) != in.FromUserId || existing.RootMsgId != in.RootMsgId {
+		l.Logger.Errorf("话题回复ID冲突: msgId=%s, from=%d, root=%s", in.MsgId, in.FromUserId, in.RootMsgId)
+		return nil, errMsgIdConflict
+	}
+
+	summary, err := findThreadSummary(l.ctx, l.svcCtx, existing.RootMsgId)
+	if err != nil {
+		l.Logger.Errorf("查询话题汇总失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	return &message.SendThreadReplyResp{
+		Id:        int64(existing.Id),
+		MsgId:     existing.MsgId,
+		Seq:       existing.Seq,
+		CreatedAt: existing.CreatedAt.Unix(),
+		Duplicate: true,
+		Thread:    summary,
+		Content:   existing.Content,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// groupSeqKey 群聊 Seq 计数器
+func groupSeqKey(groupId string) string {
+	return fmt.Sprintf("group:seq:%s", groupId)
+}
+
+// privateSeqKey 私聊会话 Seq 计数器（双方共用一个计数器）
+func privateSeqKey(userId, peerId int64) string {
+	return fmt.Sprintf("private:seq:%s", model.PrivateConversationKey(userId, peerId))
+}
+
+// threadSeqKey 群话题内 Seq 计数器（每个话题独立递增）
+func threadSeqKey(rootMsgId string) string {
+	return fmt.Sprintf("thread:seq:%s", rootMsgId)
+}
+
+// skippedSeqTTL 跳过的 Seq 记录保留时间（秒），超过后离线过久的客户端按普通缺失处理
+const skippedSeqTTL = 30 * 86400
+
+// releaseSeqScript 计数器仍等于本次分配的 Seq（之后没有再分配）时回退计数器
+const releaseSeqScript = `if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('DECR', KEYS[1])
+	return 1
+end
+return 0`
+
+// skippedSeqKey 会话中被跳过（分配后写入失败）的 Seq 集合
+func skippedSeqKey(seqKey string) string {
+	return seqKey + ":skipped"
+}
+
+// insertWithSeq 分配会话 Seq 后执行 insert，写入失败时释放该 Seq：
+// 之后没有再分配时回退计数器，否则记录为跳过的 Seq，同步接口据此告知客户端该 Seq 不会有消息
+func insertWithSeq(ctx context.Context, svcCtx *svc.ServiceContext, seqKey string, loadMaxSeq func(ctx context.Context) (int64, error), insert func(seq int64) (sql.Result, error)) (int64, sql.Result, error) {
+	seq, err := nextSeq(ctx, svcCtx, seqKey, loadMaxSeq)
+	if err != nil {
+		return 0, nil, err
+	}
+	result, err := insert(seq)
+	if err != nil {
+		releaseSeq(ctx, svcCtx, seqKey, seq)
+		return 0, nil, err
+	}
+	return seq, result, nil
+}
+
+// releaseSeq 释放写入失败的 Seq
+func releaseSeq(ctx context.Context, svcCtx *svc.ServiceContext, seqKey string, seq int64) {
+	logger := logx.WithContext(ctx)
+
+	released, err := svcCtx.Redis.EvalCtx(ctx, releaseSeqScript, []string{seqKey}, strconv.FormatInt(seq, 10))
+	if err == nil && released == int64(1) {
+		return
+	}
+
+	key := skippedSeqKey(seqKey)
+	if _, err := svcCtx.Redis.ZaddCtx(ctx, key, seq, strconv.FormatInt(seq, 10)); err != nil {
+		logger.Errorf("记录跳过的 Seq 失败: key=%s, seq=%d, err=%v", seqKey, seq, err)
+		return
+	}
+	_ = svcCtx.Redis.ExpireCtx(ctx, key, skippedSeqTTL)
+	logger.Infof("%s Seq 写入失败已跳过: seq=%d", seqKey, seq)
+}
+
+// skippedSeqs 查询 (afterSeq, upToSeq] 范围内被跳过的 Seq
+func skippedSeqs(ctx context.Context, svcCtx *svc.ServiceContext, seqKey string, afterSeq, upToSeq uint64) []uint64 {
+	if upToSeq <= afterSeq {
+		return nil
+	}
+	pairs, err := svcCtx.Redis.ZrangebyscoreWithScoresCtx(ctx, skippedSeqKey(seqKey), int64(afterSeq)+1, int64(upToSeq))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询跳过的 Seq 失败: key=%s, err=%v", seqKey, err)
+		return nil
+	}
+	seqs := make([]uint64, 0, len(pairs))
+	for _, p := range pairs {
+		seqs = append(seqs, uint64(p.Score))
+	}
+	return seqs
+}
+
+// syncLimit 按 Seq 增量同步每次读取条数，默认且最多 200
+func syncLimit(limit int32) int64 {
+	if limit <= 0 || limit > 200 {
+		return 200
+	}
+	return int64(limit)
+}
+
+// nextSeq 生成会话内单调递增的 Seq（优先 Redis，降级到数据库）
+// loadMaxSeq 用于 Redis 不可用或 Redis 数据丢失时，从数据库读取会话当前最大 Seq
+func nextSeq(ctx context.Context, svcCtx *svc.ServiceContext, seqKey string, loadMaxSeq func(ctx context.Context) (int64, error)) (int64, error) {
+	logger := logx.WithContext(ctx)
+
+	seq, err := svcCtx.Redis.IncrCtx(ctx, seqKey)
+	if err != nil {
+		// Redis 挂了，降级到数据库生成 Seq
+		logger.Errorf("Redis 生成 Seq 失败，降级到数据库: key=%s, err=%v", seqKey, err)
+
+		maxSeq, dbErr := loadMaxSeq(ctx)
+		if dbErr != nil {
+			logger.Errorf("数据库查询最大 Seq 失败: %v", dbErr)
+			return 0, dbErr
+		}
+
+		seq = maxSeq + 1
+		logger.Infof("%s Seq 降级生成: DB=%d -> New=%d", seqKey, maxSeq, seq)
+
+		// 尝试回写 Redis（异步，失败不影响主流程）
+		go func() {
+			if setErr := svcCtx.Redis.Set(seqKey, fmt.Sprintf("%d", seq)); setErr != nil {
+				logx.Errorf("回写 Redis Seq 失败: %v", setErr)
+			}
+		}()
+		return seq, nil
+	}
+
+	// 惰性恢复逻辑：如果Seq为1，检查数据库中是否已有消息（Redis 数据丢失场景）
+	if seq == 1 {
+		maxSeq, err := loadMaxSeq(ctx)
+		if err != nil {
+			// 如果数据库查询失败，为了数据一致性，建议报错（防止产生重复Seq）
+			logger.Errorf("查询会话最大Seq失败: %v", err)
+			return 0, err
+		}
+
+		if maxSeq > 0 {
+			// Redis数据丢失，将Redis设置为已有的最大Seq + 1 (即本次消息应有的Seq)
+			// 注意：这里存在极低概率的并发竞态，但在故障恢复场景下可接受
+			seq = maxSeq + 1
+			if setErr := svcCtx.Redis.SetCtx(ctx, seqKey, fmt.Sprintf("%d", seq)); setErr != nil {
+				logger.Errorf("恢复 Redis Seq 失败: %v", setErr)
+			}
+			logger.Infof("%s Seq 恢复: Redis=1 -> DB=%d -> New=%d", seqKey, maxSeq, seq)
+		}
+	}
+
+	return seq, nil
+}
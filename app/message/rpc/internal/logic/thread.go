@@ -0,0 +1,189 @@
+package logic
+
+// thread.go - 群话题的公共逻辑（根消息校验、话题汇总、关注、实时通知）
+//
+// 话题回复存储在 im_thread_reply，使用话题内独立的 Seq，不进入群主时间线，
+// 因此不影响群历史、离线同步和群未读数；根消息上展示的回复数和最后回复时间按需汇总
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// eventThreadUpdate 话题汇总变化，推送给全部群成员（更新根消息上的回复数）
+	eventThreadUpdate = "thread_update"
+	// eventThreadReply 话题新回复，推送给关注者
+	eventThreadReply = "thread_reply"
+)
+
+// findThreadRoot 查询话题根消息，只有群消息可以作为话题根
+func findThreadRoot(ctx context.Context, svcCtx *svc.ServiceContext, rootMsgId string) (*model.ImMessage, error) {
+	root, err := svcCtx.ImMessageModel.FindOneByMsgId(ctx, rootMsgId)
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "话题根消息不存在")
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询话题根消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if root.ChatType != 2 {
+		return nil, status.Error(codes.InvalidArgument, "只有群消息可以发起话题")
+	}
+	return root, nil
+}
+
+// checkThreadRoot 发起回复、关注话题前校验根消息（系统消息、阅后即焚及已撤回/删除/清理的消息不能作为话题根）
+func checkThreadRoot(root *model.ImMessage) error {
+	return checkSnapshotable(root, "回复")
+}
+
+// toThreadSummary 话题汇总转换为 RPC 返回结构
+func toThreadSummary(sum *model.ThreadSummary) *message.ThreadSummary {
+	return &message.ThreadSummary{
+		ReplyCount:  sum.ReplyCount,
+		LastSeq:     sum.LastSeq,
+		LastReplyAt: sum.LastReplyAt.Unix(),
+	}
+}
+
+// findThreadSummary 查询单个话题的汇总，没有回复时返回零值
+func findThreadSummary(ctx context.Context, svcCtx *svc.ServiceContext, rootMsgId string) (*message.ThreadSummary, error) {
+	sums, err := svcCtx.ImThreadReplyModel.FindSummaries(ctx, []string{rootMsgId})
+	if err != nil {
+		return nil, err
+	}
+	if len(sums) == 0 {
+		return &message.ThreadSummary{}, nil
+	}
+	return toThreadSummary(sums[0]), nil
+}
+
+// attachThreadSummaries 为群消息列表中的话题根消息附加回复数和最后回复时间
+// 查询失败只记录日志，不影响消息列表本身
+func attachThreadSummaries(ctx context.Context, svcCtx *svc.ServiceContext, list []*message.MessageInfo) {
+	msgIds := make([]string, 0, len(list))
+	for _, info := range list {
+		if info.ChatType == 2 {
+			msgIds = append(msgIds, info.MsgId)
+		}
+	}
+	if len(msgIds) == 0 {
+		return
+	}
+
+	sums, err := svcCtx.ImThreadReplyModel.FindSummaries(ctx, msgIds)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询话题汇总失败: %v", err)
+		return
+	}
+	byRoot := make(map[string]*model.ThreadSummary, len(sums))
+	for _, sum := range sums {
+		byRoot[sum.RootMsgId] = sum
+	}
+	for _, info := range list {
+		if sum, ok := byRoot[info.MsgId]; ok {
+			info.Thread = toThreadSummary(sum)
+		}
+	}
+}
+
+// toThreadReplyInfo 话题回复转换为 RPC 返回结构
+func toThreadReplyInfo(reply *model.ImThreadReply) *message.ThreadReplyInfo {
+	return &message.ThreadReplyInfo{
+		Id:          int64(reply.Id),
+		MsgId:       reply.MsgId,
+		RootMsgId:   reply.RootMsgId,
+		GroupId:     reply.GroupId,
+		Seq:         reply.Seq,
+		FromUserId:  int64(reply.FromUserId),
+		Content:     reply.Content,
+		ContentType: int32(reply.ContentType),
+		Payload:     payload.Parse(int32(reply.ContentType), reply.Content),
+		CreatedAt:   reply.CreatedAt.Unix(),
+	}
+}
+
+// followThread 关注话题，已关注时忽略
+func followThread(ctx context.Context, svcCtx *svc.ServiceContext, root *model.ImMessage, userId int64) error {
+	_, err := svcCtx.ImThreadFollowerModel.Insert(ctx, &model.ImThreadFollower{
+		RootMsgId: root.MsgId,
+		GroupId:   root.GroupId.String,
+		UserId:    uint64(userId),
+	})
+	if err != nil && !isDuplicateKeyErr(err) {
+		return err
+	}
+	return nil
+}
+
+// pushThreadUpdate 推送话题汇总变化给全部群成员，失败只记录日志
+func pushThreadUpdate(ctx context.Context, svcCtx *svc.ServiceContext, rootMsgId, groupId string, summary *message.ThreadSummary) {
+	if err := svcCtx.WsPushClient.PushGroupEvent(groupId, eventThreadUpdate, map[string]interface{}{
+		"rootMsgId":   rootMsgId,
+		"groupId":     groupId,
+		"replyCount":  summary.ReplyCount,
+		"lastSeq":     summary.LastSeq,
+		"lastReplyAt": summary.LastReplyAt,
+	}); err != nil {
+		logx.WithContext(ctx).Errorf("推送话题汇总失败: rootMsgId=%s, err=%v", rootMsgId, err)
+	}
+}
+
+// pushThreadReply 推送话题新回复，失败只记录日志（客户端打开话题时重新拉取）
+// 全部群成员收到 thread_update 更新根消息的回复数；仍在群内的关注者收到 thread_reply
+func pushThreadReply(ctx context.Context, svcCtx *svc.ServiceContext, reply *model.ImThreadReply, summary *message.ThreadSummary) {
+	logger := logx.WithContext(ctx)
+
+	pushThreadUpdate(ctx, svcCtx, reply.RootMsgId, reply.GroupId, summary)
+
+	followers, err := svcCtx.ImThreadFollowerModel.FindUserIdsByRoot(ctx, reply.RootMsgId)
+	if err != nil {
+		logger.Errorf("查询话题关注者失败: rootMsgId=%s, err=%v", reply.RootMsgId, err)
+		return
+	}
+	if len(followers) == 0 {
+		return
+	}
+	memberIds, err := groupMemberIds(ctx, svcCtx, reply.GroupId)
+	if err != nil {
+		logger.Errorf("查询群成员失败: groupId=%s, err=%v", reply.GroupId, err)
+		return
+	}
+	members := make(map[int64]bool, len(memberIds))
+	for _, id := range memberIds {
+		members[id] = true
+	}
+
+	info := toThreadReplyInfo(reply)
+	data := map[string]interface{}{
+		"id":          info.Id,
+		"msgId":       info.MsgId,
+		"rootMsgId":   info.RootMsgId,
+		"groupId":     info.GroupId,
+		"seq":         info.Seq,
+		"fromUserId":  info.FromUserId,
+		"content":     info.Content,
+		"contentType": info.ContentType,
+		"payload":     info.Payload,
+		"createdAt":   info.CreatedAt,
+		"replyCount":  summary.ReplyCount,
+	}
+	for _, userId := range followers {
+		// 已退群的关注者不再通知
+		if !members[userId] {
+			continue
+		}
+		if err := svcCtx.WsPushClient.PushToUser(userId, eventThreadReply, data); err != nil {
+			logger.Errorf("推送话题回复失败: userId=%d, msgId=%s, err=%v", userId, reply.MsgId, err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnfollowThreadLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnfollowThreadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnfollowThreadLogic {
+	return &UnfollowThreadLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 取消关注话题
+func (l *UnfollowThreadLogic) UnfollowThread(in *message.FollowThreadReq) (*message.FollowThreadResp, error) {
+	if in.UserId == 0 || in.RootMsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	follower, err := l.svcCtx.ImThreadFollowerModel.FindOneByRootMsgIdUserId(l.ctx, in.RootMsgId, uint64(in.UserId))
+	if err == model.ErrNotFound {
+		return &message.FollowThreadResp{Following: false}, nil
+	}
+	if err != nil {
+		l.Logger.Errorf("查询话题关注失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	if err := l.svcCtx.ImThreadFollowerModel.Delete(l.ctx, follower.Id); err != nil {
+		l.Logger.Errorf("取消关注话题失败: %v", err)
+		return nil, status.Error(codes.Internal, "取消关注失败")
+	}
+
+	return &message.FollowThreadResp{Following: false}, nil
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnpinMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnpinMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnpinMessageLogic {
+	return &UnpinMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 取消置顶消息
+func (l *UnpinMessageLogic) UnpinMessage(in *message.UnpinMessageReq) (*message.UnpinMessageResp, error) {
+	if in.UserId == 0 || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	conversationKey, err := pinConversation(l.ctx, l.svcCtx, in.UserId, in.ChatType, in.PeerId, in.GroupId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := l.svcCtx.ImPinnedMessageModel.FindOneByConversationKeyMsgId(l.ctx, conversationKey, in.MsgId)
+	if err == model.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "该消息未置顶")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询置顶消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	if err := l.svcCtx.ImPinnedMessageModel.Delete(l.ctx, pin.Id); err != nil {
+		l.Logger.Errorf("取消置顶失败: %v", err)
+		return nil, status.Error(codes.Internal, "取消置顶失败")
+	}
+
+	pushPinEvent(l.ctx, l.svcCtx, "unpin", pin, in.UserId, in.PeerId)
+
+	return &message.UnpinMessageResp{Success: true}, nil
+}
@@ -0,0 +1,181 @@
+package logic
+
+// unread.go - 会话未读计数的维护与重建（存储结构见 common/unread）
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"SkyeIM/app/group/rpc/group"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/common/unread"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// groupMembersExpire 群成员缓存过期时间（秒），与 WebSocket 服务一致
+	groupMembersExpire = 10 * 60
+	// unreadFanoutTimeout 群消息累加成员未读数的最长执行时间
+	unreadFanoutTimeout = 10 * time.Second
+)
+
+// groupMembersKey 群成员ID缓存（与 WebSocket 服务共用，成员变动时由 group-rpc 删除）
+func groupMembersKey(groupId string) string {
+	return fmt.Sprintf("im:group:members:%s", groupId)
+}
+
+// incrPrivateUnread 私聊消息入库后累加接收者的未读数
+// 失败只记录日志，标记已读时会按数据库的准确值校正
+func incrPrivateUnread(ctx context.Context, svcCtx *svc.ServiceContext, toUserId, fromUserId int64) {
+	if err := svcCtx.UnreadCounter.IncrPrivate(ctx, toUserId, fromUserId); err != nil {
+		logx.WithContext(ctx).Errorf("累加私聊未读数失败: to=%d, from=%d, err=%v", toUserId, fromUserId, err)
+	}
+}
+
+// incrGroupUnread 群消息入库后累加其他成员的未读数，被@的成员（@全体时为所有成员）同时累加@我的未读数
+// 需要读取群成员列表，在后台执行，不影响发送耗时
+func incrGroupUnread(svcCtx *svc.ServiceContext, groupId string, fromUserId int64, atUserIds []int64) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), unreadFanoutTimeout)
+		defer cancel()
+
+		memberIds, err := groupMemberIds(ctx, svcCtx, groupId)
+		if err != nil {
+			logx.Errorf("查询群成员失败，跳过未读数累加: groupId=%s, err=%v", groupId, err)
+			return
+		}
+
+		atAll := containsAtAll(atUserIds)
+		mentioned := make(map[int64]bool, len(atUserIds))
+		for _, uid := range atUserIds {
+			mentioned[uid] = true
+		}
+
+		receivers := make([]int64, 0, len(memberIds))
+		var atReceivers []int64
+		for _, uid := range memberIds {
+			if uid == fromUserId {
+				continue
+			}
+			receivers = append(receivers, uid)
+			if atAll || mentioned[uid] {
+				atReceivers = append(atReceivers, uid)
+			}
+		}
+
+		if err := svcCtx.UnreadCounter.IncrGroup(ctx, groupId, receivers, atReceivers); err != nil {
+			logx.Errorf("累加群聊未读数失败: groupId=%s, err=%v", groupId, err)
+		}
+	}()
+}
+
+// groupMemberIds 查询群成员ID（优先 Redis 缓存，未命中时调用 Group RPC 并回写缓存）
+func groupMemberIds(ctx context.Context, svcCtx *svc.ServiceContext, groupId string) ([]int64, error) {
+	key := groupMembersKey(groupId)
+	members, err := svcCtx.Redis.SmembersCtx(ctx, key)
+	if err == nil && len(members) > 0 {
+		ids := make([]int64, 0, len(members))
+		for _, m := range members {
+			if uid, err := strconv.ParseInt(m, 10, 64); err == nil {
+				ids = append(ids, uid)
+			}
+		}
+		return ids, nil
+	}
+
+	resp, err := svcCtx.GroupRpc.GetMemberList(ctx, &group.GetMemberListReq{
+		GroupId:  groupId,
+		Page:     1,
+		PageSize: 10000, // 获取所有成员
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(resp.Members))
+	cached := make([]interface{}, 0, len(resp.Members))
+	for _, member := range resp.Members {
+		ids = append(ids, member.UserId)
+		cached = append(cached, member.UserId)
+	}
+	if len(cached) > 0 {
+		if _, err := svcCtx.Redis.SaddCtx(ctx, key, cached...); err != nil {
+			logx.Errorf("缓存群成员失败: groupId=%s, err=%v", groupId, err)
+		} else {
+			_ = svcCtx.Redis.ExpireCtx(ctx, key, groupMembersExpire)
+		}
+	}
+	return ids, nil
+}
+
+// loadUnreadFields 读取用户的未读计数字段
+// Redis 不可用时返回 ok=false，调用方降级为全部从数据库统计且不回写
+func loadUnreadFields(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) (map[string]string, bool) {
+	fields, err := svcCtx.UnreadCounter.Load(ctx, userId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("读取未读计数失败，降级到数据库: userId=%d, err=%v", userId, err)
+		return map[string]string{}, false
+	}
+	return fields, true
+}
+
+// privateUnread 返回各私聊会话的未读数（不含未读数为 0 的会话）
+// 私聊计数未重建时从数据库统计，需要回写的字段写入 fill
+func privateUnread(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, fields map[string]string, fill map[string]int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64)
+	for field, value := range fields {
+		peer, ok := strings.CutPrefix(field, "p:")
+		if !ok {
+			continue
+		}
+		peerId, err := strconv.ParseInt(peer, 10, 64)
+		n, _ := strconv.ParseInt(value, 10, 64)
+		if err == nil && n > 0 {
+			counts[peerId] = n
+		}
+	}
+	if _, ok := fields[unread.PrivateReadyField]; ok {
+		return counts, nil
+	}
+
+	rows, err := svcCtx.ImMessageModel.CountUnreadByPeer(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		field := unread.PrivateField(row.PeerId)
+		// 已存在的字段是标记已读时回写的准确值，或重建期间已被新消息累加
+		if _, ok := fields[field]; ok {
+			continue
+		}
+		counts[row.PeerId] = row.Count
+		fill[field] = row.Count
+	}
+	fill[unread.PrivateReadyField] = 1
+	return counts, nil
+}
+
+// sortedPeers 私聊会话按对方ID排序，保证返回顺序稳定
+func sortedPeers(counts map[int64]int64) []int64 {
+	peers := make([]int64, 0, len(counts))
+	for peerId := range counts {
+		peers = append(peers, peerId)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i] < peers[j] })
+	return peers
+}
+
+// fieldCount 读取计数字段，字段不存在时 ok 为 false
+func fieldCount(fields map[string]string, field string) (int64, bool) {
+	value, ok := fields[field]
+	if !ok {
+		return 0, false
+	}
+	n, _ := strconv.ParseInt(value, 10, 64)
+	return n, true
+}
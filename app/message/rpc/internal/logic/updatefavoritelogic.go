@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdateFavoriteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateFavoriteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateFavoriteLogic {
+	return &UpdateFavoriteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改收藏的标签和备注
+func (l *UpdateFavoriteLogic) UpdateFavorite(in *message.UpdateFavoriteReq) (*message.UpdateFavoriteResp, error) {
+	if in.UserId == 0 || in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	tags, err := encodeFavoriteTags(in.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFavoriteNote(in.Note); err != nil {
+		return nil, err
+	}
+
+	fav, err := l.svcCtx.ImFavoriteModel.FindOne(l.ctx, uint64(in.Id))
+	if err == model.ErrNotFound || (err == nil && fav.UserId != uint64(in.UserId)) {
+		return nil, status.Error(codes.NotFound, "收藏不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	fav.Tags = tags
+	fav.Note = in.Note
+	if err := l.svcCtx.ImFavoriteModel.Update(l.ctx, fav); err != nil {
+		l.Logger.Errorf("修改收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "修改收藏失败")
+	}
+
+	updated, err := l.svcCtx.ImFavoriteModel.FindOne(l.ctx, fav.Id)
+	if err != nil {
+		l.Logger.Errorf("查询收藏失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+
+	info := toFavoriteInfo(updated)
+	pushFavoriteSync(l.ctx, l.svcCtx, in.UserId, "update", info, nil)
+
+	return &message.UpdateFavoriteResp{Info: info}, nil
+}
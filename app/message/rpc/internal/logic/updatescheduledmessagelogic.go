@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdateScheduledMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateScheduledMessageLogic {
+	return &UpdateScheduledMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改待发送的定时消息
+func (l *UpdateScheduledMessageLogic) UpdateScheduledMessage(in *message.UpdateScheduledMessageReq) (*message.UpdateScheduledMessageResp, error) {
+	if in.Id == 0 || in.UserId == 0 || in.SendAt == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	data, err := l.svcCtx.ImScheduledMessageModel.FindOne(l.ctx, uint64(in.Id))
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "定时消息不存在")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	if data.FromUserId != uint64(in.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "无权修改该定时消息")
+	}
+	if data.Status != model.ScheduledStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "定时消息已发送或已取消")
+	}
+
+	contentType := in.ContentType
+	if contentType == 0 {
+		contentType = payload.TypeText
+	}
+	if err := validatePayload(contentType, in.Content); err != nil {
+		return nil, err
+	}
+
+	sendAt, err := checkSendAt(in.SendAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var atUserIds sql.NullString
+	if data.ChatType == 2 && len(in.AtUserIds) > 0 {
+		if b, err := json.Marshal(in.AtUserIds); err == nil {
+			atUserIds = sql.NullString{String: string(b), Valid: true}
+		}
+	}
+
+	data.Content = in.Content
+	data.ContentType = int64(contentType)
+	data.AtUserIds = atUserIds
+	data.SendAt = sendAt
+
+	// 条件更新：与调度任务并发时，已被领取的消息不能再修改
+	updated, err := l.svcCtx.ImScheduledMessageModel.UpdatePending(l.ctx, data)
+	if err != nil {
+		l.Logger.Errorf("修改定时消息失败: %v", err)
+		return nil, status.Error(codes.Internal, "修改定时消息失败")
+	}
+	if !updated {
+		return nil, status.Error(codes.FailedPrecondition, "定时消息已发送或已取消")
+	}
+
+	return &message.UpdateScheduledMessageResp{
+		Info: toScheduledMessageInfo(data),
+	}, nil
+}
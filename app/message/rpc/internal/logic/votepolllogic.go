@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"SkyeIM/app/message/rpc/internal/poll"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type VotePollLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVotePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VotePollLogic {
+	return &VotePollLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 投票（已投过票时覆盖原选择）
+func (l *VotePollLogic) VotePoll(in *message.VotePollReq) (*message.VotePollResp, error) {
+	if in.UserId == 0 || in.MsgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+
+	p, err := findPoll(l.ctx, l.svcCtx, in.MsgId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkGroupMember(l.ctx, l.svcCtx, p.GroupId, in.UserId); err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(p, time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "投票已结束")
+	}
+
+	selected, err := normalizePollVotes(in.OptionIndexes, len(poll.DecodeOptions(p.Options)), p.Multiple == 1)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(selected)
+
+	if err := l.svcCtx.ImPollVoteModel.Upsert(l.ctx, p.Id, uint64(in.UserId), string(data)); err != nil {
+		l.Logger.Errorf("保存投票失败: %v", err)
+		return nil, status.Error(codes.Internal, "投票失败")
+	}
+
+	info, err := poll.BuildInfo(l.ctx, l.svcCtx, p, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("汇总投票结果失败: %v", err)
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	poll.PushUpdate(l.ctx, l.svcCtx, info)
+
+	return &message.VotePollResp{Info: info}, nil
+}
@@ -0,0 +1,211 @@
+package moderation
+
+// filter.go - 敏感词过滤
+//
+// 词库分两级：
+// 1. 全局词库：从配置的词库文件加载，定时检查文件修改时间，变化后重新构建自动机（热更新，无需重启）
+// 2. 群自定义词库：有内容审核权限的成员维护（im_group_sensitive_word），只对该群消息生效
+//    按群缓存构建好的自动机，修改词库时清除本实例缓存，其他实例在缓存过期后生效
+//
+// 词库文件每行一个词，可用 "|" 指定动作（mask/review/block），未指定时使用 DefaultAction：
+//   # 注释
+//   敏感词
+//   违禁词|block
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// FilterOptions 敏感词过滤配置
+type FilterOptions struct {
+	WordFile       string        // 全局词库文件，为空时不启用全局词库
+	DefaultAction  Action        // 词库文件中未指定动作时使用
+	ReloadInterval time.Duration // 检查词库文件变化的间隔
+	GroupCacheTTL  time.Duration // 群词库本地缓存时间
+}
+
+// WordFilter 敏感词过滤审核器
+type WordFilter struct {
+	opts       FilterOptions
+	groupWords model.ImGroupSensitiveWordModel
+	global     atomic.Pointer[Matcher]
+	modTime    time.Time
+	groups     *collection.Cache
+	done       chan struct{}
+}
+
+// MustNewWordFilter 创建敏感词过滤器并加载全局词库，词库文件无法读取时启动失败
+func MustNewWordFilter(opts FilterOptions, groupWords model.ImGroupSensitiveWordModel) *WordFilter {
+	groups, err := collection.NewCache(opts.GroupCacheTTL, collection.WithName("group-sensitive-words"))
+	if err != nil {
+		logx.Must(err)
+	}
+
+	f := &WordFilter{
+		opts:       opts,
+		groupWords: groupWords,
+		groups:     groups,
+		done:       make(chan struct{}),
+	}
+	f.global.Store(NewMatcher(nil))
+	if opts.WordFile != "" {
+		logx.Must(f.Reload())
+	}
+	return f
+}
+
+func (f *WordFilter) Name() string {
+	return "word-filter"
+}
+
+// Check 检查文字消息，命中替换词时返回替换后的内容
+func (f *WordFilter) Check(ctx context.Context, in *Input) (*Result, error) {
+	if in.ContentType != payload.TypeText {
+		return nil, nil
+	}
+
+	hits := f.global.Load().Find(in.Content)
+	if in.GroupId != "" {
+		matcher, err := f.groupMatcher(ctx, in.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, matcher.Find(in.Content)...)
+	}
+	if len(hits) == 0 {
+		return nil, nil
+	}
+
+	res := &Result{Action: ActionPass, Content: in.Content}
+	var masked []Hit
+	for _, h := range hits {
+		res.Hits = append(res.Hits, h.Word)
+		if h.Action > res.Action {
+			res.Action = h.Action
+		}
+		if h.Action == ActionMask {
+			masked = append(masked, h)
+		}
+	}
+	if len(masked) > 0 {
+		res.Content = mask(in.Content, masked)
+	}
+	return res, nil
+}
+
+// InvalidateGroup 群词库变更后清除本实例的缓存
+func (f *WordFilter) InvalidateGroup(groupId string) {
+	f.groups.Del(groupId)
+}
+
+// groupMatcher 加载群自定义词库（带本地缓存）
+func (f *WordFilter) groupMatcher(ctx context.Context, groupId string) (*Matcher, error) {
+	val, err := f.groups.Take(groupId, func() (any, error) {
+		rows, err := f.groupWords.FindByGroup(ctx, groupId)
+		if err != nil {
+			return nil, err
+		}
+		words := make([]Word, 0, len(rows))
+		for _, row := range rows {
+			words = append(words, Word{Text: row.Word, Action: Action(row.Action)})
+		}
+		return NewMatcher(words), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*Matcher), nil
+}
+
+// Reload 词库文件修改后重新加载，文件未变化时直接返回
+func (f *WordFilter) Reload() error {
+	info, err := os.Stat(f.opts.WordFile)
+	if err != nil {
+		return fmt.Errorf("读取敏感词文件失败: %w", err)
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return nil
+	}
+
+	words, err := loadWordFile(f.opts.WordFile, f.opts.DefaultAction)
+	if err != nil {
+		return err
+	}
+	matcher := NewMatcher(words)
+	f.global.Store(matcher)
+	f.modTime = info.ModTime()
+	logx.Infof("[Moderation] 已加载敏感词库: file=%s, words=%d", f.opts.WordFile, matcher.Len())
+	return nil
+}
+
+// Start 定时检查词库文件变化（阻塞，由 ServiceGroup 管理）
+func (f *WordFilter) Start() {
+	if f.opts.WordFile == "" {
+		<-f.done
+		return
+	}
+
+	ticker := time.NewTicker(f.opts.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// 加载失败时继续使用旧词库
+			if err := f.Reload(); err != nil {
+				logx.Errorf("[Moderation] 重新加载敏感词库失败: %v", err)
+			}
+		case <-f.done:
+			return
+		}
+	}
+}
+
+// Stop 停止检查
+func (f *WordFilter) Stop() {
+	close(f.done)
+}
+
+// loadWordFile 解析词库文件，跳过空行和 # 开头的注释
+func loadWordFile(path string, defaultAction Action) ([]Word, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取敏感词文件失败: %w", err)
+	}
+	defer file.Close()
+
+	var words []Word
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		word := Word{Text: text, Action: defaultAction}
+		if i := strings.LastIndex(text, "|"); i >= 0 {
+			action, ok := ParseAction(text[i+1:])
+			if !ok {
+				return nil, fmt.Errorf("敏感词文件第 %d 行动作无效: %s", line, text)
+			}
+			word = Word{Text: strings.TrimSpace(text[:i]), Action: action}
+		}
+		words = append(words, word)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取敏感词文件失败: %w", err)
+	}
+	return words, nil
+}
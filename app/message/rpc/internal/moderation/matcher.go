@@ -0,0 +1,181 @@
+package moderation
+
+// matcher.go - Aho-Corasick 多模式匹配
+//
+// 一次扫描找出文本中命中的全部敏感词，耗时与词库大小无关
+// 匹配前统一转为小写并跳过空白、标点和符号，"敏 感-词" 同样能命中 "敏感词"；
+// 命中位置映射回原文，替换时连同夹在中间的分隔字符一起替换
+
+import (
+	"unicode"
+)
+
+// Word 词库中的一个敏感词
+type Word struct {
+	Text   string
+	Action Action
+}
+
+// Hit 一次命中，Start/End 为原文中的 rune 下标（左闭右开）
+type Hit struct {
+	Word   string
+	Action Action
+	Start  int
+	End    int
+}
+
+type acNode struct {
+	next  map[rune]int
+	fail  int
+	words []int // 以该节点结尾的词（含 fail 链上的后缀词）
+}
+
+// Matcher 由词库构建的只读自动机，可并发使用
+type Matcher struct {
+	nodes []acNode
+	words []Word
+	runes []int // 每个词归一化后的长度
+}
+
+// NewMatcher 构建自动机，归一化后为空的词和重复词被忽略（重复词保留处理最严格的一条）
+func NewMatcher(words []Word) *Matcher {
+	m := &Matcher{nodes: []acNode{{next: map[rune]int{}}}}
+
+	index := make(map[string]int, len(words))
+	for _, w := range words {
+		key := normalizeWord(w.Text)
+		if key == "" {
+			continue
+		}
+		if i, ok := index[key]; ok {
+			if w.Action > m.words[i].Action {
+				m.words[i].Action = w.Action
+			}
+			continue
+		}
+		index[key] = len(m.words)
+		m.words = append(m.words, w)
+		m.insert(key, len(m.words)-1)
+	}
+	m.build()
+	return m
+}
+
+// Len 词库中的有效词数量
+func (m *Matcher) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.words)
+}
+
+// insert 将归一化后的词加入字典树
+func (m *Matcher) insert(key string, wordIndex int) {
+	cur, length := 0, 0
+	for _, r := range key {
+		next, ok := m.nodes[cur].next[r]
+		if !ok {
+			next = len(m.nodes)
+			m.nodes = append(m.nodes, acNode{next: map[rune]int{}})
+			m.nodes[cur].next[r] = next
+		}
+		cur = next
+		length++
+	}
+	m.nodes[cur].words = append(m.nodes[cur].words, wordIndex)
+	m.runes = append(m.runes, length)
+}
+
+// build 按层序计算 fail 指针，并把 fail 节点的命中词合并到当前节点
+func (m *Matcher) build() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for fail != 0 {
+				if _, ok := m.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].next[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			m.nodes[child].words = append(m.nodes[child].words, m.nodes[m.nodes[child].fail].words...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Find 返回文本中的全部命中（按结束位置排列，可能重叠）
+func (m *Matcher) Find(text string) []Hit {
+	if m.Len() == 0 {
+		return nil
+	}
+
+	// positions[i] 为归一化后第 i 个字符在原文中的 rune 下标
+	var positions []int
+	var hits []Hit
+	cur, offset := 0, 0
+	for _, r := range text {
+		pos := offset
+		offset++
+		if isSeparator(r) {
+			continue
+		}
+		r = unicode.ToLower(r)
+		positions = append(positions, pos)
+
+		for cur != 0 {
+			if _, ok := m.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		cur = m.nodes[cur].next[r] // 根节点没有该字符时为 0，回到根节点
+
+		for _, wi := range m.nodes[cur].words {
+			end := len(positions) - 1
+			hits = append(hits, Hit{
+				Word:   m.words[wi].Text,
+				Action: m.words[wi].Action,
+				Start:  positions[end-m.runes[wi]+1],
+				End:    pos + 1,
+			})
+		}
+	}
+	return hits
+}
+
+// normalizeWord 词库中的词与文本使用相同的归一化规则
+func normalizeWord(word string) string {
+	runes := make([]rune, 0, len(word))
+	for _, r := range word {
+		if !isSeparator(r) {
+			runes = append(runes, unicode.ToLower(r))
+		}
+	}
+	return string(runes)
+}
+
+// isSeparator 匹配时忽略的分隔字符
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// mask 将命中范围内的字符替换为 *
+func mask(text string, hits []Hit) string {
+	runes := []rune(text)
+	for _, h := range hits {
+		for i := h.Start; i < h.End && i < len(runes); i++ {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
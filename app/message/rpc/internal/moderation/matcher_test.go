@@ -0,0 +1,155 @@
+package moderation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcherFind(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []Word
+		text  string
+		want  []Hit
+	}{
+		{
+			name:  "无命中",
+			words: []Word{{Text: "广告", Action: ActionMask}},
+			text:  "今天天气不错",
+			want:  nil,
+		},
+		{
+			name:  "重叠命中",
+			words: []Word{{Text: "abc", Action: ActionMask}, {Text: "bcd", Action: ActionReview}},
+			text:  "abcd",
+			want: []Hit{
+				{Word: "abc", Action: ActionMask, Start: 0, End: 3},
+				{Word: "bcd", Action: ActionReview, Start: 1, End: 4},
+			},
+		},
+		{
+			name: "后缀词通过 fail 链命中",
+			words: []Word{
+				{Text: "he", Action: ActionMask},
+				{Text: "she", Action: ActionMask},
+				{Text: "hers", Action: ActionBlock},
+			},
+			text: "ushers",
+			want: []Hit{
+				{Word: "she", Action: ActionMask, Start: 1, End: 4},
+				{Word: "he", Action: ActionMask, Start: 2, End: 4},
+				{Word: "hers", Action: ActionBlock, Start: 2, End: 6},
+			},
+		},
+		{
+			name:  "同一个词多次命中",
+			words: []Word{{Text: "aa", Action: ActionMask}},
+			text:  "aaa",
+			want: []Hit{
+				{Word: "aa", Action: ActionMask, Start: 0, End: 2},
+				{Word: "aa", Action: ActionMask, Start: 1, End: 3},
+			},
+		},
+		{
+			name:  "跳过夹在中间的空白和标点",
+			words: []Word{{Text: "敏感词", Action: ActionBlock}},
+			text:  "这是敏 感-词!",
+			want:  []Hit{{Word: "敏感词", Action: ActionBlock, Start: 2, End: 7}},
+		},
+		{
+			name:  "跳过表情符号",
+			words: []Word{{Text: "加微信", Action: ActionReview}},
+			text:  "加😀微😀信",
+			want:  []Hit{{Word: "加微信", Action: ActionReview, Start: 0, End: 5}},
+		},
+		{
+			name:  "词库中的分隔字符被忽略",
+			words: []Word{{Text: "加 微信", Action: ActionReview}},
+			text:  "请加微信",
+			want:  []Hit{{Word: "加 微信", Action: ActionReview, Start: 1, End: 4}},
+		},
+		{
+			name:  "忽略大小写",
+			words: []Word{{Text: "Spam", Action: ActionMask}},
+			text:  "BUY SPAM now",
+			want:  []Hit{{Word: "Spam", Action: ActionMask, Start: 4, End: 8}},
+		},
+		{
+			name:  "重复词保留最严格的动作",
+			words: []Word{{Text: "广告", Action: ActionMask}, {Text: "广 告", Action: ActionBlock}},
+			text:  "广告",
+			want:  []Hit{{Word: "广告", Action: ActionBlock, Start: 0, End: 2}},
+		},
+		{
+			name:  "归一化后为空的词被忽略",
+			words: []Word{{Text: "!!", Action: ActionBlock}},
+			text:  "!!",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMatcher(tt.words).Find(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Find(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []Word
+		text  string
+		want  string
+	}{
+		{
+			name:  "多字节字符按 rune 替换",
+			words: []Word{{Text: "敏感词", Action: ActionMask}},
+			text:  "这是敏感词啊",
+			want:  "这是***啊",
+		},
+		{
+			name:  "连同中间的分隔字符一起替换",
+			words: []Word{{Text: "敏感词", Action: ActionMask}},
+			text:  "这是敏 感-词!",
+			want:  "这是*****!",
+		},
+		{
+			name:  "表情符号前后的字符位置不偏移",
+			words: []Word{{Text: "bad", Action: ActionMask}},
+			text:  "hi😀bad😀",
+			want:  "hi😀***😀",
+		},
+		{
+			name:  "重叠命中合并替换",
+			words: []Word{{Text: "abc", Action: ActionMask}, {Text: "bcd", Action: ActionMask}},
+			text:  "xabcdx",
+			want:  "x****x",
+		},
+		{
+			name:  "无命中保持原文",
+			words: []Word{{Text: "广告", Action: ActionMask}},
+			text:  "正常消息",
+			want:  "正常消息",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits := NewMatcher(tt.words).Find(tt.text)
+			if got := mask(tt.text, hits); got != tt.want {
+				t.Errorf("mask(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaskIgnoresOutOfRangeHits(t *testing.T) {
+	got := mask("短文本", []Hit{{Start: 1, End: 10}})
+	if want := "短**"; got != want {
+		t.Errorf("mask = %q, want %q", got, want)
+	}
+}
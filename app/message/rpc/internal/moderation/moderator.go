@@ -0,0 +1,105 @@
+package moderation
+
+// moderator.go - 内容审核流水线
+//
+// 发送消息前依次执行已注册的审核器（Checker），每个审核器给出处理动作：
+//   放行 < 替换 < 送审 < 拦截，最终结果取最严格的动作
+// 替换后的内容传给下一个审核器继续检查；任一审核器拦截时立即结束
+// 审核器内部出错时记录日志并跳过（宁可放行也不影响正常收发消息）
+//
+// 目前只有敏感词过滤（WordFilter），图片审核等可实现 Checker 后加入流水线
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Action 命中后的处理动作，数值越大越严格（与 im_group_sensitive_word.action 一致）
+type Action int32
+
+const (
+	ActionPass   Action = 0 // 放行
+	ActionMask   Action = 1 // 替换为 *
+	ActionReview Action = 2 // 放行并送审
+	ActionBlock  Action = 3 // 拦截
+)
+
+// ParseAction 解析词库文件和配置中的动作名称
+func ParseAction(name string) (Action, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "mask":
+		return ActionMask, true
+	case "review":
+		return ActionReview, true
+	case "block":
+		return ActionBlock, true
+	}
+	return ActionPass, false
+}
+
+// Input 待审核的消息
+type Input struct {
+	ChatType    int32  // 1-私聊 2-群聊
+	GroupId     string // 群聊时有效，用于加载群自定义词库
+	FromUserId  int64
+	ContentType int32
+	Content     string
+}
+
+// Result 审核结果
+type Result struct {
+	Action  Action
+	Content string   // 实际保存的内容（替换后）
+	Hits    []string // 命中的敏感词（去重）
+}
+
+// Checker 审核器
+type Checker interface {
+	Name() string
+	Check(ctx context.Context, in *Input) (*Result, error)
+}
+
+// Moderator 按注册顺序执行审核器
+type Moderator struct {
+	checkers []Checker
+}
+
+func NewModerator(checkers ...Checker) *Moderator {
+	return &Moderator{checkers: checkers}
+}
+
+// Moderate 执行审核流水线
+func (m *Moderator) Moderate(ctx context.Context, in *Input) *Result {
+	final := &Result{Action: ActionPass, Content: in.Content}
+	seen := make(map[string]bool)
+
+	for _, checker := range m.checkers {
+		step := *in
+		step.Content = final.Content
+		res, err := checker.Check(ctx, &step)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("[Moderation] %s 审核失败，跳过: from=%d, err=%v", checker.Name(), in.FromUserId, err)
+			continue
+		}
+		if res == nil {
+			continue
+		}
+
+		for _, word := range res.Hits {
+			if !seen[word] {
+				seen[word] = true
+				final.Hits = append(final.Hits, word)
+			}
+		}
+		if res.Action > final.Action {
+			final.Action = res.Action
+		}
+		if res.Action == ActionBlock {
+			return final
+		}
+		final.Content = res.Content
+	}
+	return final
+}
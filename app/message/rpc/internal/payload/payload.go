@@ -0,0 +1,314 @@
+package payload
+
+// payload.go - 结构化消息内容
+//
+// 非文字消息的 content 字段存储对应类型的 JSON：
+//   2-图片 {"url","width","height","thumbnailUrl"}，兼容旧客户端直接发送图片 URL
+//   3-文件 {"url","name","size","mime"}，兼容上传接口返回的 filename、mimeType 字段名
+//   4-语音 {"url","duration"}，兼容旧客户端直接发送语音 URL
+//   5-视频 {"url","duration","width","height","thumbnailUrl"}
+//   6-位置 {"latitude","longitude","name","address"}
+//   7-名片 {"userId","nickname","avatar"}
+//   8-群投票（仅由 CreatePoll 写入，见 poll.go）
+//   10-群系统消息（仅由服务端写入，见 system.go）
+//
+// 发送时用 Validate 校验，读取时用 Parse 解析为 MessagePayload 返回给客户端
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+
+	"SkyeIM/app/message/rpc/message"
+)
+
+// 消息内容类型（与 im_message.content_type 一致）
+const (
+	TypeText     = 1
+	TypeImage    = 2
+	TypeFile     = 3
+	TypeVoice    = 4
+	TypeVideo    = 5
+	TypeLocation = 6
+	TypeContact  = 7
+	TypePoll     = 8
+	TypeSystem   = 10
+)
+
+var (
+	ErrEmptyContent   = errors.New("消息内容不能为空")
+	ErrUnsupported    = errors.New("不支持的消息类型")
+	ErrInvalidFormat  = errors.New("消息内容格式错误")
+	ErrInvalidURL     = errors.New("资源地址无效")
+	ErrInvalidSize    = errors.New("尺寸或大小无效")
+	ErrInvalidFile    = errors.New("文件名不能为空")
+	ErrInvalidLength  = errors.New("时长无效")
+	ErrInvalidCoord   = errors.New("经纬度无效")
+	ErrInvalidContact = errors.New("名片用户无效")
+)
+
+type image struct {
+	Url          string `json:"url"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	ThumbnailUrl string `json:"thumbnailUrl"`
+}
+
+type file struct {
+	Url      string `json:"url"`
+	Name     string `json:"name"`
+	Filename string `json:"filename"` // 上传接口返回的字段名，同 name
+	Size     int64  `json:"size"`
+	Mime     string `json:"mime"`
+	MimeType string `json:"mimeType"` // 上传接口返回的字段名，同 mime
+}
+
+type voice struct {
+	Url      string `json:"url"`
+	Duration int32  `json:"duration"`
+}
+
+type video struct {
+	Url          string `json:"url"`
+	Duration     int32  `json:"duration"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	ThumbnailUrl string `json:"thumbnailUrl"`
+}
+
+type location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+}
+
+type contact struct {
+	UserId   int64  `json:"userId"`
+	Nickname string `json:"nickname"`
+	Avatar   string `json:"avatar"`
+}
+
+// Validate 校验 content 是否符合 contentType 对应的格式
+func Validate(contentType int32, content string) error {
+	if strings.TrimSpace(content) == "" {
+		return ErrEmptyContent
+	}
+
+	switch contentType {
+	case TypeText:
+		return nil
+	case TypeImage:
+		p, err := decodeImage(content)
+		if err != nil {
+			return ErrInvalidFormat
+		}
+		if !validURL(p.Url) || (p.ThumbnailUrl != "" && !validURL(p.ThumbnailUrl)) {
+			return ErrInvalidURL
+		}
+		if p.Width < 0 || p.Height < 0 {
+			return ErrInvalidSize
+		}
+	case TypeFile:
+		p, err := decodeFile(content)
+		if err != nil {
+			return ErrInvalidFormat
+		}
+		if !validURL(p.Url) {
+			return ErrInvalidURL
+		}
+		if strings.TrimSpace(p.Name) == "" {
+			return ErrInvalidFile
+		}
+		if p.Size <= 0 {
+			return ErrInvalidSize
+		}
+	case TypeVoice:
+		if u, ok := bareURL(content); ok {
+			// 旧格式只有地址，没有时长
+			if !validURL(u) {
+				return ErrInvalidURL
+			}
+			return nil
+		}
+		var p voice
+		if err := json.Unmarshal([]byte(content), &p); err != nil {
+			return ErrInvalidFormat
+		}
+		if !validURL(p.Url) {
+			return ErrInvalidURL
+		}
+		if p.Duration <= 0 {
+			return ErrInvalidLength
+		}
+	case TypeVideo:
+		var p video
+		if err := json.Unmarshal([]byte(content), &p); err != nil {
+			return ErrInvalidFormat
+		}
+		if !validURL(p.Url) || (p.ThumbnailUrl != "" && !validURL(p.ThumbnailUrl)) {
+			return ErrInvalidURL
+		}
+		if p.Duration <= 0 {
+			return ErrInvalidLength
+		}
+		if p.Width < 0 || p.Height < 0 {
+			return ErrInvalidSize
+		}
+	case TypeLocation:
+		var p location
+		if err := json.Unmarshal([]byte(content), &p); err != nil {
+			return ErrInvalidFormat
+		}
+		if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+			return ErrInvalidCoord
+		}
+	case TypeContact:
+		var p contact
+		if err := json.Unmarshal([]byte(content), &p); err != nil {
+			return ErrInvalidFormat
+		}
+		if p.UserId <= 0 {
+			return ErrInvalidContact
+		}
+	default:
+		return ErrUnsupported
+	}
+	return nil
+}
+
+// Parse 将 content 解析为结构化内容，文字消息或无法解析（如历史数据）时返回 nil
+func Parse(contentType int32, content string) *message.MessagePayload {
+	switch contentType {
+	case TypeImage:
+		p, err := decodeImage(content)
+		if err != nil {
+			return nil
+		}
+		return &message.MessagePayload{Image: &message.ImagePayload{
+			Url:          p.Url,
+			Width:        p.Width,
+			Height:       p.Height,
+			ThumbnailUrl: p.ThumbnailUrl,
+		}}
+	case TypeFile:
+		p, err := decodeFile(content)
+		if err != nil {
+			return nil
+		}
+		return &message.MessagePayload{File: &message.FilePayload{
+			Url:  p.Url,
+			Name: p.Name,
+			Size: p.Size,
+			Mime: p.Mime,
+		}}
+	case TypeVoice:
+		p, err := decodeVoice(content)
+		if err != nil {
+			return nil
+		}
+		return &message.MessagePayload{Voice: &message.VoicePayload{
+			Url:      p.Url,
+			Duration: p.Duration,
+		}}
+	case TypeVideo:
+		var p video
+		if json.Unmarshal([]byte(content), &p) != nil {
+			return nil
+		}
+		return &message.MessagePayload{Video: &message.VideoPayload{
+			Url:          p.Url,
+			Duration:     p.Duration,
+			Width:        p.Width,
+			Height:       p.Height,
+			ThumbnailUrl: p.ThumbnailUrl,
+		}}
+	case TypeLocation:
+		var p location
+		if json.Unmarshal([]byte(content), &p) != nil {
+			return nil
+		}
+		return &message.MessagePayload{Location: &message.LocationPayload{
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+			Name:      p.Name,
+			Address:   p.Address,
+		}}
+	case TypeContact:
+		var p contact
+		if json.Unmarshal([]byte(content), &p) != nil {
+			return nil
+		}
+		return &message.MessagePayload{Contact: &message.ContactPayload{
+			UserId:   p.UserId,
+			Nickname: p.Nickname,
+			Avatar:   p.Avatar,
+		}}
+	case TypePoll:
+		if p := parsePoll(content); p != nil {
+			return &message.MessagePayload{Poll: p}
+		}
+	case TypeSystem:
+		if p := parseSystem(content); p != nil {
+			return &message.MessagePayload{System: p}
+		}
+	}
+	return nil
+}
+
+// decodeImage 解析图片内容，content 不是 JSON 对象时按旧格式视为图片地址
+func decodeImage(content string) (image, error) {
+	if u, ok := bareURL(content); ok {
+		return image{Url: u}, nil
+	}
+	var p image
+	err := json.Unmarshal([]byte(content), &p)
+	return p, err
+}
+
+// decodeFile 解析文件内容，filename、mimeType 作为 name、mime 的别名
+func decodeFile(content string) (file, error) {
+	var p file
+	if err := json.Unmarshal([]byte(content), &p); err != nil {
+		return p, err
+	}
+	if p.Name == "" {
+		p.Name = p.Filename
+	}
+	if p.Mime == "" {
+		p.Mime = p.MimeType
+	}
+	return p, nil
+}
+
+// decodeVoice 解析语音内容，content 不是 JSON 对象时按旧格式视为语音地址
+func decodeVoice(content string) (voice, error) {
+	if u, ok := bareURL(content); ok {
+		return voice{Url: u}, nil
+	}
+	var p voice
+	err := json.Unmarshal([]byte(content), &p)
+	return p, err
+}
+
+// bareURL 旧客户端直接把资源地址作为 content，不是 JSON 对象时返回去掉首尾空白的内容
+func bareURL(content string) (string, bool) {
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "{") {
+		return "", false
+	}
+	return content, true
+}
+
+// validURL 资源地址必须是 http/https
+func validURL(raw string) bool {
+	if raw == "" {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
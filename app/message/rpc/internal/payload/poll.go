@@ -0,0 +1,47 @@
+package payload
+
+// poll.go - 群投票消息
+//
+// 投票消息（content_type=8）的 content 存储发起时的投票定义：
+//   {"question","options":[],"multiple","anonymous","deadline"}
+// 投票状态和计票保存在 im_poll / im_poll_vote，客户端通过 GetPoll 获取
+
+import (
+	"encoding/json"
+
+	"SkyeIM/app/message/rpc/message"
+)
+
+// Poll 投票消息内容
+type Poll struct {
+	Question  string   `json:"question"`
+	Options   []string `json:"options"`
+	Multiple  bool     `json:"multiple"`
+	Anonymous bool     `json:"anonymous"`
+	Deadline  int64    `json:"deadline,omitempty"` // 截止时间戳，0 表示不限
+}
+
+// Encode 序列化为 content
+func (p *Poll) Encode() (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// parsePoll 解析投票消息内容
+func parsePoll(content string) *message.PollPayload {
+	var p Poll
+	if json.Unmarshal([]byte(content), &p) != nil || p.Question == "" {
+		return nil
+	}
+
+	return &message.PollPayload{
+		Question:  p.Question,
+		Options:   p.Options,
+		Multiple:  p.Multiple,
+		Anonymous: p.Anonymous,
+		Deadline:  p.Deadline,
+	}
+}
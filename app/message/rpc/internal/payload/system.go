@@ -0,0 +1,130 @@
+package payload
+
+// system.go - 群系统消息
+//
+// 群成员变动等事件写入群消息时间线（content_type=10），content 存储结构化事件：
+//   {"event","operator":{"userId","name"},"targets":[{"userId","name"}],"params":{}}
+// 名称为写入时的快照，读取时按事件模板渲染为展示文本，客户端也可以按 event 自行渲染
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"SkyeIM/app/message/rpc/message"
+)
+
+// 群系统消息事件
+const (
+	EventGroupCreate         = "groupCreate"         // 创建群聊
+	EventMemberInvite        = "memberInvite"        // 邀请成员加入
+	EventMemberJoin          = "memberJoin"          // 成员加入（接受邀请、入群申请通过、邀请链接、自由加入）
+	EventMemberQuit          = "memberQuit"          // 成员退出
+	EventMemberKick          = "memberKick"          // 成员被移出
+	EventAdminAdd            = "adminAdd"            // 设为管理员
+	EventAdminRemove         = "adminRemove"         // 取消管理员
+	EventMemberMute          = "memberMute"          // 禁言
+	EventMemberUnmute        = "memberUnmute"        // 解除禁言
+	EventMuteAllOn           = "muteAllOn"           // 开启全员禁言
+	EventMuteAllOff          = "muteAllOff"          // 关闭全员禁言
+	EventAnnouncementPublish = "announcementPublish" // 发布群公告
+	EventOwnerTransfer       = "ownerTransfer"       // 转让群主
+	EventGroupDismiss        = "groupDismiss"        // 解散群聊
+)
+
+// systemTemplates 事件展示模板，{operator} 为操作者，{targets} 为被操作的成员
+var systemTemplates = map[string]string{
+	EventGroupCreate:         "{operator} 创建了群聊",
+	EventMemberInvite:        "{operator} 邀请 {targets} 加入了群聊",
+	EventMemberJoin:          "{targets} 加入了群聊",
+	EventMemberQuit:          "{operator} 退出了群聊",
+	EventMemberKick:          "{operator} 将 {targets} 移出了群聊",
+	EventAdminAdd:            "{operator} 将 {targets} 设为管理员",
+	EventAdminRemove:         "{operator} 取消了 {targets} 的管理员身份",
+	EventMemberMute:          "{operator} 将 {targets} 禁言",
+	EventMemberUnmute:        "{operator} 解除了 {targets} 的禁言",
+	EventMuteAllOn:           "{operator} 开启了全员禁言",
+	EventMuteAllOff:          "{operator} 关闭了全员禁言",
+	EventAnnouncementPublish: "{operator} 发布了群公告",
+	EventOwnerTransfer:       "{operator} 将群主转让给 {targets}",
+	EventGroupDismiss:        "{operator} 解散了群聊",
+}
+
+// SystemUser 系统消息中的用户（名称为写入时的快照）
+type SystemUser struct {
+	UserId int64  `json:"userId"`
+	Name   string `json:"name"`
+}
+
+// System 群系统消息内容
+type System struct {
+	Event    string            `json:"event"`
+	Operator *SystemUser       `json:"operator,omitempty"`
+	Targets  []SystemUser      `json:"targets,omitempty"`
+	Params   map[string]string `json:"params,omitempty"`
+}
+
+// ValidEvent 是否为支持的系统消息事件
+func ValidEvent(event string) bool {
+	_, ok := systemTemplates[event]
+	return ok
+}
+
+// Encode 序列化为 content
+func (s *System) Encode() (string, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Render 按事件模板渲染展示文本
+func (s *System) Render() string {
+	tpl, ok := systemTemplates[s.Event]
+	if !ok {
+		return "[系统消息]"
+	}
+
+	operator := ""
+	if s.Operator != nil {
+		operator = displayName(*s.Operator)
+	}
+	targets := make([]string, 0, len(s.Targets))
+	for _, t := range s.Targets {
+		targets = append(targets, displayName(t))
+	}
+
+	return strings.NewReplacer(
+		"{operator}", operator,
+		"{targets}", strings.Join(targets, "、"),
+	).Replace(tpl)
+}
+
+// parseSystem 解析系统消息内容
+func parseSystem(content string) *message.SystemPayload {
+	var s System
+	if json.Unmarshal([]byte(content), &s) != nil || s.Event == "" {
+		return nil
+	}
+
+	p := &message.SystemPayload{
+		Event:  s.Event,
+		Params: s.Params,
+		Text:   s.Render(),
+	}
+	if s.Operator != nil {
+		p.OperatorId = s.Operator.UserId
+	}
+	for _, t := range s.Targets {
+		p.TargetIds = append(p.TargetIds, t.UserId)
+	}
+	return p
+}
+
+func displayName(u SystemUser) string {
+	if u.Name != "" {
+		return u.Name
+	}
+	return fmt.Sprintf("用户%d", u.UserId)
+}
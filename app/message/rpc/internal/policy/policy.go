@@ -0,0 +1,93 @@
+package policy
+
+// policy.go - 私聊发送策略
+//
+// 发送私聊消息前校验双方关系：
+// 1. 任意一方拉黑对方（im_friend.status = 2）时拒绝发送
+// 2. 非好友默认拒绝发送，开启 AllowStrangerMessage 后允许陌生人消息
+//
+// 拒绝时返回带 reason 的 gRPC 错误（见 common/rpcerr），ws 层据此返回 ACK 失败原因
+
+import (
+	"context"
+
+	"SkyeIM/app/friend/rpc/friendclient"
+	"SkyeIM/common/rpcerr"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+)
+
+// 拒绝原因（ACK reason）
+const (
+	ReasonBlocked       = "blocked"         // 发送者已拉黑对方
+	ReasonBlockedByPeer = "blocked_by_peer" // 发送者被对方拉黑
+	ReasonNotFriend     = "not_friend"      // 双方不是好友且不允许陌生人消息
+	ReasonCheckFailed   = "check_failed"    // 关系校验失败
+)
+
+// 好友关系状态（与 im_friend.status 一致）
+const (
+	friendStatusNone    = 0
+	friendStatusNormal  = 1
+	friendStatusBlocked = 2
+)
+
+// PrivateChecker 私聊发送策略校验
+type PrivateChecker struct {
+	friendRpc     friendclient.Friend
+	allowStranger bool
+}
+
+func NewPrivateChecker(friendRpc friendclient.Friend, allowStranger bool) *PrivateChecker {
+	return &PrivateChecker{
+		friendRpc:     friendRpc,
+		allowStranger: allowStranger,
+	}
+}
+
+// CheckSend 校验 fromUserId 是否可以给 toUserId 发送私聊消息
+func (c *PrivateChecker) CheckSend(ctx context.Context, fromUserId, toUserId int64) error {
+	// 给自己发消息（如文件传输）不做关系校验
+	if fromUserId == toUserId {
+		return nil
+	}
+
+	// 好友关系是双向两条记录，需要分别查询双方视角的状态
+	outgoing, err := c.friendStatus(ctx, fromUserId, toUserId)
+	if err != nil {
+		return err
+	}
+	incoming, err := c.friendStatus(ctx, toUserId, fromUserId)
+	if err != nil {
+		return err
+	}
+
+	if outgoing == friendStatusBlocked {
+		return rpcerr.New(codes.PermissionDenied, ReasonBlocked, "你已将对方拉黑")
+	}
+	if incoming == friendStatusBlocked {
+		return rpcerr.New(codes.PermissionDenied, ReasonBlockedByPeer, "消息已被对方拒收")
+	}
+
+	if outgoing == friendStatusNormal && incoming == friendStatusNormal {
+		return nil
+	}
+	if c.allowStranger {
+		return nil
+	}
+	return rpcerr.New(codes.PermissionDenied, ReasonNotFriend, "对方不是你的好友")
+}
+
+// friendStatus 查询 userId 视角下与 friendId 的关系状态
+func (c *PrivateChecker) friendStatus(ctx context.Context, userId, friendId int64) (int64, error) {
+	resp, err := c.friendRpc.IsFriend(ctx, &friendclient.IsFriendReq{
+		UserId:   userId,
+		FriendId: friendId,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询好友关系失败: userId=%d, friendId=%d, err=%v", userId, friendId, err)
+		return friendStatusNone, rpcerr.New(codes.Internal, ReasonCheckFailed, "好友关系校验失败")
+	}
+	return resp.Status, nil
+}
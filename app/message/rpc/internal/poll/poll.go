@@ -0,0 +1,123 @@
+package poll
+
+// poll.go - 群投票计票与实时推送
+//
+// 每个投票人在 im_poll_vote 中一条记录（options 为所选选项序号的 JSON），
+// 计票时读取该投票的全部记录汇总，投票、撤回和结束后将最新结果推送给群成员
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EventPollUpdate 投票计票变化（投票、撤回、结束）
+const EventPollUpdate = "poll_update"
+
+// IsClosed 投票是否已结束（已到截止时间但尚未被扫描任务结束的也视为已结束）
+func IsClosed(p *model.ImPoll, now time.Time) bool {
+	return p.Status == model.PollStatusClosed || (p.Deadline.Valid && !now.Before(p.Deadline.Time))
+}
+
+// DecodeOptions 解析选项列表
+func DecodeOptions(data string) []string {
+	var options []string
+	if err := json.Unmarshal([]byte(data), &options); err != nil {
+		logx.Errorf("解析投票选项失败: %s, err=%v", data, err)
+	}
+	return options
+}
+
+// DecodeVotes 解析投票人选择的选项序号
+func DecodeVotes(data string) []int32 {
+	var votes []int32
+	if err := json.Unmarshal([]byte(data), &votes); err != nil {
+		logx.Errorf("解析投票记录失败: %s, err=%v", data, err)
+	}
+	return votes
+}
+
+// BuildInfo 汇总投票结果，userId 不为 0 时返回该用户的选择
+// 匿名投票不返回投票人
+func BuildInfo(ctx context.Context, svcCtx *svc.ServiceContext, p *model.ImPoll, userId int64) (*message.PollInfo, error) {
+	votes, err := svcCtx.ImPollVoteModel.FindByPoll(ctx, p.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := DecodeOptions(p.Options)
+	options := make([]*message.PollOption, len(texts))
+	for i, text := range texts {
+		options[i] = &message.PollOption{Index: int32(i), Text: text}
+	}
+
+	info := &message.PollInfo{
+		MsgId:      p.MsgId,
+		GroupId:    p.GroupId,
+		CreatorId:  int64(p.CreatorId),
+		Question:   p.Question,
+		Options:    options,
+		Multiple:   p.Multiple == 1,
+		Anonymous:  p.Anonymous == 1,
+		Closed:     IsClosed(p, time.Now()),
+		VoterCount: int64(len(votes)),
+		CreatedAt:  p.CreatedAt.Unix(),
+	}
+	if p.Deadline.Valid {
+		info.Deadline = p.Deadline.Time.Unix()
+	}
+	if p.ClosedAt.Valid {
+		info.ClosedAt = p.ClosedAt.Time.Unix()
+	}
+
+	for _, vote := range votes {
+		selected := DecodeVotes(vote.Options)
+		for _, idx := range selected {
+			if idx < 0 || int(idx) >= len(options) {
+				continue
+			}
+			options[idx].Count++
+			if !info.Anonymous {
+				options[idx].VoterIds = append(options[idx].VoterIds, int64(vote.UserId))
+			}
+		}
+		if userId != 0 && int64(vote.UserId) == userId {
+			info.MyVotes = selected
+		}
+	}
+	return info, nil
+}
+
+// PushUpdate 将最新计票结果推送给群成员，失败只记录日志（客户端打开投票时重新拉取）
+func PushUpdate(ctx context.Context, svcCtx *svc.ServiceContext, info *message.PollInfo) {
+	options := make([]map[string]interface{}, 0, len(info.Options))
+	for _, opt := range info.Options {
+		voterIds := opt.VoterIds
+		if voterIds == nil {
+			voterIds = []int64{}
+		}
+		options = append(options, map[string]interface{}{
+			"index":    opt.Index,
+			"text":     opt.Text,
+			"count":    opt.Count,
+			"voterIds": voterIds,
+		})
+	}
+
+	if err := svcCtx.WsPushClient.PushGroupEvent(info.GroupId, EventPollUpdate, map[string]interface{}{
+		"msgId":      info.MsgId,
+		"groupId":    info.GroupId,
+		"options":    options,
+		"voterCount": info.VoterCount,
+		"closed":     info.Closed,
+		"closedAt":   info.ClosedAt,
+	}); err != nil {
+		logx.WithContext(ctx).Errorf("推送投票结果失败: msgId=%s, err=%v", info.MsgId, err)
+	}
+}
@@ -0,0 +1,99 @@
+package poll
+
+// worker.go - 群投票截止任务
+//
+// 定时扫描已到截止时间仍在进行中的投票：
+// 1. 条件更新结束投票（进行中 -> 已结束），多实例部署时每个投票只会被一个实例结束
+// 2. 通过 WebSocket 推送最终计票结果（poll_update，closed=true）
+//
+// 截止后到被扫描结束之间的投票请求由 IsClosed 拒绝
+
+import (
+	"context"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type Worker struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	batch    int64
+	done     chan struct{}
+}
+
+func NewWorker(svcCtx *svc.ServiceContext) *Worker {
+	return &Worker{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.PollScanInterval) * time.Second,
+		batch:    int64(svcCtx.Config.PollBatchSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动扫描循环（阻塞，由 ServiceGroup 管理）
+func (w *Worker) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.scan()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+// scan 结束一批到期的投票，批次满时继续处理下一批
+func (w *Worker) scan() {
+	ctx := context.Background()
+
+	for {
+		due, err := w.svcCtx.ImPollModel.FindDue(ctx, time.Now(), w.batch)
+		if err != nil {
+			logx.Errorf("[Poll] 查询到期投票失败: %v", err)
+			return
+		}
+
+		for _, p := range due {
+			w.close(ctx, p)
+		}
+
+		if int64(len(due)) < w.batch {
+			return
+		}
+	}
+}
+
+// close 结束单个投票并推送最终结果
+func (w *Worker) close(ctx context.Context, p *model.ImPoll) {
+	now := time.Now()
+	closed, err := w.svcCtx.ImPollModel.Close(ctx, p, now)
+	if err != nil {
+		logx.Errorf("[Poll] 结束投票失败: msgId=%s, err=%v", p.MsgId, err)
+		return
+	}
+	if !closed {
+		// 已被其他实例结束
+		return
+	}
+
+	p.Status = model.PollStatusClosed
+	p.ClosedAt.Time, p.ClosedAt.Valid = now, true
+	info, err := BuildInfo(ctx, w.svcCtx, p, 0)
+	if err != nil {
+		logx.Errorf("[Poll] 汇总投票结果失败: msgId=%s, err=%v", p.MsgId, err)
+		return
+	}
+	PushUpdate(ctx, w.svcCtx, info)
+}
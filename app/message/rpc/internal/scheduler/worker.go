@@ -0,0 +1,221 @@
+package scheduler
+
+// worker.go - 定时消息调度任务
+//
+// 定时扫描已到发送时间的定时消息：
+// 1. 条件更新领取消息（待发送 -> 发送中），多实例部署时每条消息只会被一个实例投递，
+//    领取后重新读取，按用户最后一次修改的内容投递
+// 2. 走与 SendMessage / SendGroupMessage 相同的发送流程（Seq、成员、禁言、好友关系校验）
+// 3. 推送给在线接收者，并通知发送者投递结果
+//
+// 投递使用创建时生成的 msg_id，实例异常退出后重新投递是幂等的
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/logic"
+	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// EventScheduledMessage 定时消息投递结果通知
+	EventScheduledMessage = "scheduled_message"
+
+	// staleSendingTimeout 发送中超过该时长视为实例异常退出，恢复为待发送
+	staleSendingTimeout = 5 * time.Minute
+)
+
+type Worker struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	batch    int64
+	done     chan struct{}
+}
+
+func NewWorker(svcCtx *svc.ServiceContext) *Worker {
+	return &Worker{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.ScheduleScanInterval) * time.Second,
+		batch:    int64(svcCtx.Config.ScheduleBatchSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动扫描循环（阻塞，由 ServiceGroup 管理）
+func (w *Worker) Start() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.scan()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+// scan 投递一批到期的定时消息，批次满时继续处理下一批
+func (w *Worker) scan() {
+	ctx := context.Background()
+
+	if n, err := w.svcCtx.ImScheduledMessageModel.ResetStale(ctx, time.Now().Add(-staleSendingTimeout)); err != nil {
+		logx.Errorf("[Scheduler] 恢复超时定时消息失败: %v", err)
+	} else if n > 0 {
+		logx.Infof("[Scheduler] 恢复 %d 条超时未完成的定时消息", n)
+	}
+
+	for {
+		due, err := w.svcCtx.ImScheduledMessageModel.FindDue(ctx, time.Now(), w.batch)
+		if err != nil {
+			logx.Errorf("[Scheduler] 查询到期定时消息失败: %v", err)
+			return
+		}
+
+		for _, data := range due {
+			claimed, err := w.svcCtx.ImScheduledMessageModel.Claim(ctx, data, time.Now())
+			if err != nil {
+				// 数据库异常时结束本轮扫描，避免反复查询到同一批消息
+				logx.Errorf("[Scheduler] 领取定时消息失败: id=%d, err=%v", data.Id, err)
+				return
+			}
+			if !claimed {
+				// 已被其他实例领取、已被用户取消或改到以后发送
+				continue
+			}
+
+			// 查询与领取之间用户可能修改过内容，按领取后的最新数据投递
+			claimedData, err := w.svcCtx.ImScheduledMessageModel.FindOne(ctx, data.Id)
+			if err != nil {
+				// 保持发送中，超时后由 ResetStale 恢复为待发送
+				logx.Errorf("[Scheduler] 查询已领取的定时消息失败: id=%d, err=%v", data.Id, err)
+				return
+			}
+			w.deliver(ctx, claimedData)
+		}
+
+		if int64(len(due)) < w.batch {
+			return
+		}
+	}
+}
+
+// deliver 投递单条定时消息并记录结果
+func (w *Worker) deliver(ctx context.Context, data *model.ImScheduledMessage) {
+	var (
+		messageId int64
+		err       error
+	)
+	if data.ChatType == 2 {
+		messageId, err = w.deliverGroup(ctx, data)
+	} else {
+		messageId, err = w.deliverPrivate(ctx, data)
+	}
+
+	if err != nil {
+		logx.Errorf("[Scheduler] 定时消息投递失败: id=%d, err=%v", data.Id, err)
+		data.Status = model.ScheduledStatusFailed
+		data.FailReason = status.Convert(err).Message()
+	} else {
+		data.Status = model.ScheduledStatusSent
+		data.MessageId = uint64(messageId)
+		data.FailReason = ""
+	}
+
+	if ok, err := w.svcCtx.ImScheduledMessageModel.Finish(ctx, data); err != nil {
+		logx.Errorf("[Scheduler] 更新定时消息状态失败: id=%d, err=%v", data.Id, err)
+	} else if !ok {
+		// 投递超时已被恢复为待发送，下次重新投递时按 msg_id 幂等返回原消息
+		logx.Infof("[Scheduler] 定时消息已不在发送中，跳过状态更新: id=%d", data.Id)
+	}
+
+	// 通知发送者投递结果
+	if pushErr := w.svcCtx.WsPushClient.PushToUser(int64(data.FromUserId), EventScheduledMessage, map[string]interface{}{
+		"id":         data.Id,
+		"msgId":      data.MsgId,
+		"status":     data.Status,
+		"messageId":  data.MessageId,
+		"failReason": data.FailReason,
+	}); pushErr != nil {
+		logx.Errorf("[Scheduler] 推送定时消息结果失败: id=%d, err=%v", data.Id, pushErr)
+	}
+}
+
+// deliverPrivate 投递私聊定时消息
+func (w *Worker) deliverPrivate(ctx context.Context, data *model.ImScheduledMessage) (int64, error) {
+	resp, err := logic.NewSendMessageLogic(ctx, w.svcCtx).SendMessage(&message.SendMessageReq{
+		MsgId:       data.MsgId,
+		FromUserId:  int64(data.FromUserId),
+		ToUserId:    int64(data.ToUserId),
+		Content:     data.Content,
+		ContentType: int32(data.ContentType),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// 推送格式与 WebSocket 实时私聊消息一致；同时推送给发送者的其他在线设备
+	chat := map[string]interface{}{
+		"msgId":       data.MsgId,
+		"fromUserId":  data.FromUserId,
+		"toUserId":    data.ToUserId,
+		"content":     resp.Content,
+		"contentType": data.ContentType,
+		"createdAt":   resp.CreatedAt,
+		"seq":         resp.Seq,
+	}
+	for _, userId := range []uint64{data.ToUserId, data.FromUserId} {
+		if err := w.svcCtx.WsPushClient.PushToUser(int64(userId), "chat", chat); err != nil {
+			logx.Errorf("[Scheduler] 推送定时私聊消息失败: msgId=%s, userId=%d, err=%v", data.MsgId, userId, err)
+		}
+	}
+	return resp.Id, nil
+}
+
+// deliverGroup 投递群聊定时消息
+func (w *Worker) deliverGroup(ctx context.Context, data *model.ImScheduledMessage) (int64, error) {
+	var atUserIds []int64
+	if data.AtUserIds.Valid && data.AtUserIds.String != "" {
+		_ = json.Unmarshal([]byte(data.AtUserIds.String), &atUserIds)
+	}
+
+	resp, err := logic.NewSendGroupMessageLogic(ctx, w.svcCtx).SendGroupMessage(&message.SendGroupMessageReq{
+		MsgId:       data.MsgId,
+		FromUserId:  int64(data.FromUserId),
+		GroupId:     data.GroupId,
+		Content:     data.Content,
+		ContentType: int32(data.ContentType),
+		AtUserIds:   atUserIds,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// 推送格式与 WebSocket 实时群聊消息一致（群事件推送给全部成员，包括发送者）
+	if err := w.svcCtx.WsPushClient.PushGroupEvent(data.GroupId, "group_chat", map[string]interface{}{
+		"msgId":       data.MsgId,
+		"fromUserId":  data.FromUserId,
+		"groupId":     data.GroupId,
+		"content":     resp.Content,
+		"contentType": data.ContentType,
+		"createdAt":   resp.CreatedAt,
+		"seq":         resp.Seq,
+		"atUserIds":   atUserIds,
+	}); err != nil {
+		logx.Errorf("[Scheduler] 推送定时群消息失败: msgId=%s, err=%v", data.MsgId, err)
+	}
+	return resp.Id, nil
+}
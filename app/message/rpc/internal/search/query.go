@@ -0,0 +1,55 @@
+package search
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// ngramTokenSize 与 MySQL ngram_token_size 一致（默认 2），短于该长度的词无法命中全文索引
+	ngramTokenSize = 2
+	// maxTerms 单次搜索最多关键词数
+	maxTerms = 5
+	// maxKeywordLen 关键词最大长度（字符）
+	maxKeywordLen = 50
+)
+
+// booleanOperators 全文检索 BOOLEAN MODE 的运算符，关键词中出现时去掉，避免被当成语法
+const booleanOperators = `+-<>()~*"@`
+
+// query 解析后的关键词
+type query struct {
+	Terms   []string // 全部关键词（用于高亮）
+	Against string   // 全文检索表达式
+	Likes   []string // 需要 LIKE 匹配的短词
+}
+
+// parseKeyword 按空白拆分关键词，所有词都必须命中（AND）
+// 长度达到 ngram 分词长度的词走全文索引（短语匹配），单字走 LIKE
+func parseKeyword(keyword string) query {
+	var q query
+	var against []string
+	for _, field := range strings.Fields(keyword) {
+		term := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(booleanOperators, r) {
+				return -1
+			}
+			return r
+		}, field)
+		if term == "" {
+			continue
+		}
+
+		q.Terms = append(q.Terms, term)
+		if utf8.RuneCountInString(term) >= ngramTokenSize {
+			against = append(against, `+"`+term+`"`)
+		} else {
+			q.Likes = append(q.Likes, term)
+		}
+		if len(q.Terms) >= maxTerms {
+			break
+		}
+	}
+	q.Against = strings.Join(against, " ")
+	return q
+}
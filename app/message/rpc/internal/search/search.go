@@ -0,0 +1,199 @@
+package search
+
+// search.go - 聊天记录搜索
+//
+// im_message.content 建有 ngram 全文索引（ft_content），支持中文分词检索：
+// 1. 关键词按空白拆分，所有词都必须命中，单字词回退为 LIKE 匹配
+// 2. 只搜索用户参与的私聊和当前已加入的群聊，群聊消息从加入时间开始可见
+// 3. 支持按私聊对象、群组、发送者、时间范围、消息类型过滤
+// 4. 按消息ID倒序返回，使用上一页最后一条消息ID作为游标分页
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"SkyeIM/app/group/rpc/groupclient"
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/payload"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 50
+)
+
+// Params 搜索参数
+type Params struct {
+	UserId      int64
+	Keyword     string
+	PeerId      int64  // 只搜索与该用户的私聊
+	GroupId     string // 只搜索该群
+	SenderId    int64
+	StartTime   int64 // 起始时间戳（秒，包含）
+	EndTime     int64 // 结束时间戳（秒，不包含）
+	ContentType int32 // 0 表示文本消息
+	Cursor      int64
+	Limit       int64
+}
+
+// Hit 搜索命中的消息
+type Hit struct {
+	Message *model.ImMessage
+	Snippet string // 高亮摘要
+}
+
+// Result 搜索结果
+type Result struct {
+	Hits       []Hit
+	NextCursor int64
+	HasMore    bool
+}
+
+// Searcher 聊天记录搜索
+type Searcher struct {
+	messageModel model.ImMessageModel
+	groupRpc     groupclient.Group
+}
+
+func NewSearcher(messageModel model.ImMessageModel, groupRpc groupclient.Group) *Searcher {
+	return &Searcher{
+		messageModel: messageModel,
+		groupRpc:     groupRpc,
+	}
+}
+
+// Search 搜索用户可见的聊天记录
+func (s *Searcher) Search(ctx context.Context, p *Params) (*Result, error) {
+	keyword := strings.TrimSpace(p.Keyword)
+	if keyword == "" {
+		return nil, status.Error(codes.InvalidArgument, "搜索关键词不能为空")
+	}
+	if utf8.RuneCountInString(keyword) > maxKeywordLen {
+		return nil, status.Error(codes.InvalidArgument, "搜索关键词过长")
+	}
+	if p.PeerId > 0 && p.GroupId != "" {
+		return nil, status.Error(codes.InvalidArgument, "不能同时指定私聊对象和群组")
+	}
+	if p.StartTime > 0 && p.EndTime > 0 && p.StartTime >= p.EndTime {
+		return nil, status.Error(codes.InvalidArgument, "时间范围错误")
+	}
+
+	contentTypes, err := searchableTypes(p.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	q := parseKeyword(keyword)
+	if len(q.Terms) == 0 {
+		return &Result{}, nil
+	}
+
+	limit := p.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	cond := &model.MessageSearchCond{
+		UserId:       p.UserId,
+		Against:      q.Against,
+		Likes:        q.Likes,
+		PeerId:       p.PeerId,
+		SenderId:     p.SenderId,
+		ContentTypes: contentTypes,
+		Cursor:       p.Cursor,
+		Limit:        limit + 1, // 多查一条用于判断是否还有更多
+	}
+	if p.StartTime > 0 {
+		cond.StartTime = time.Unix(p.StartTime, 0)
+	}
+	if p.EndTime > 0 {
+		cond.EndTime = time.Unix(p.EndTime, 0)
+	}
+
+	// 指定群组时只搜该群，指定私聊对象时不搜群聊
+	if p.PeerId == 0 {
+		groups, err := s.joinedGroups(ctx, p.UserId, p.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		cond.Groups = groups
+	}
+	cond.IncludePrivate = p.GroupId == ""
+
+	messages, err := s.messageModel.SearchMessages(ctx, cond)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("搜索消息失败: userId=%d, keyword=%s, err=%v", p.UserId, keyword, err)
+		return nil, status.Error(codes.Internal, "搜索消息失败")
+	}
+
+	result := &Result{}
+	if int64(len(messages)) > limit {
+		messages = messages[:limit]
+		result.HasMore = true
+	}
+	for _, msg := range messages {
+		result.Hits = append(result.Hits, Hit{
+			Message: msg,
+			Snippet: snippet(searchableText(msg), q.Terms),
+		})
+	}
+	if len(messages) > 0 {
+		result.NextCursor = int64(messages[len(messages)-1].Id)
+	}
+	return result, nil
+}
+
+// joinedGroups 查询用户已加入的群组及加入时间，groupId 不为空时只返回该群（未加入时报错）
+func (s *Searcher) joinedGroups(ctx context.Context, userId int64, groupId string) ([]model.GroupSearchScope, error) {
+	resp, err := s.groupRpc.GetJoinedGroups(ctx, &groupclient.GetJoinedGroupsReq{UserId: userId})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询用户群组失败: userId=%d, err=%v", userId, err)
+		return nil, status.Error(codes.Internal, "查询群组失败")
+	}
+
+	var scopes []model.GroupSearchScope
+	for _, member := range resp.List {
+		if groupId != "" && member.GroupId != groupId {
+			continue
+		}
+		scopes = append(scopes, model.GroupSearchScope{
+			GroupId:  member.GroupId,
+			JoinedAt: time.Unix(member.JoinedAt, 0),
+		})
+	}
+	if groupId != "" && len(scopes) == 0 {
+		return nil, status.Error(codes.PermissionDenied, "您不是群成员")
+	}
+	return scopes, nil
+}
+
+// searchableTypes 可搜索的消息类型：文本搜索内容，文件搜索文件名
+func searchableTypes(contentType int32) ([]int64, error) {
+	switch contentType {
+	case 0, payload.TypeText:
+		return []int64{payload.TypeText}, nil
+	case payload.TypeFile:
+		return []int64{payload.TypeFile}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "该消息类型不支持搜索")
+	}
+}
+
+// searchableText 用于生成摘要的文本（文件消息取文件名）
+func searchableText(msg *model.ImMessage) string {
+	if msg.ContentType == payload.TypeFile {
+		if p := payload.Parse(payload.TypeFile, msg.Content); p != nil && p.File != nil {
+			return p.File.Name
+		}
+	}
+	return msg.Content
+}
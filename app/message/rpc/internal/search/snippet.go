@@ -0,0 +1,97 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	// snippetRadius 命中位置前保留的字符数
+	snippetRadius = 20
+	// snippetMaxLen 摘要最大长度（字符，不含省略号和高亮标签）
+	snippetMaxLen = 80
+
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+	ellipsis       = "…"
+)
+
+// snippet 截取第一个命中词附近的文本并用 <em></em> 包裹所有命中词
+// 原文中的 HTML 字符会被转义，客户端可以直接按 HTML 渲染
+func snippet(text string, terms []string) string {
+	runes := []rune(text)
+	lower := foldRunes(runes)
+
+	// 标记每个字符是否属于命中词
+	hit := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		t := foldRunes([]rune(term))
+		if len(t) == 0 {
+			continue
+		}
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if !equalRunes(lower[i:i+len(t)], t) {
+				continue
+			}
+			for j := i; j < i+len(t); j++ {
+				hit[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start := 0
+	if first > snippetRadius {
+		start = first - snippetRadius
+	}
+	end := start + snippetMaxLen
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(ellipsis)
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && hit[j] == hit[i] {
+			j++
+		}
+		segment := html.EscapeString(string(runes[i:j]))
+		if hit[i] {
+			b.WriteString(highlightOpen)
+			b.WriteString(segment)
+			b.WriteString(highlightClose)
+		} else {
+			b.WriteString(segment)
+		}
+		i = j
+	}
+	if end < len(runes) {
+		b.WriteString(ellipsis)
+	}
+	return b.String()
+}
+
+// foldRunes 逐字符转小写（保持下标与原文一致）
+func foldRunes(runes []rune) []rune {
+	out := make([]rune, len(runes))
+	for i, r := range runes {
+		out[i] = unicode.ToLower(r)
+	}
+	return out
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -35,13 +35,19 @@ func (s *MessageServer) SendGroupMessage(ctx context.Context, in *message.SendGr
 	return l.SendGroupMessage(in)
 }
 
-// 获取私聊历史消息列表（分页）
+// 写入群系统消息（成员变动等事件，供内部服务调用）
+func (s *MessageServer) SendGroupSystemMessage(ctx context.Context, in *message.SendGroupSystemMessageReq) (*message.SendGroupMessageResp, error) {
+	l := logic.NewSendGroupSystemMessageLogic(ctx, s.svcCtx)
+	return l.SendGroupSystemMessage(in)
+}
+
+// 获取私聊历史消息列表（分页，支持按消息或日期定位）
 func (s *MessageServer) GetMessageList(ctx context.Context, in *message.GetMessageListReq) (*message.GetMessageListResp, error) {
 	l := logic.NewGetMessageListLogic(ctx, s.svcCtx)
 	return l.GetMessageList(in)
 }
 
-// 获取群聊历史消息列表（分页）
+// 获取群聊历史消息列表（分页，支持按消息、Seq或日期定位）
 func (s *MessageServer) GetGroupMessageList(ctx context.Context, in *message.GetGroupMessageListReq) (*message.GetGroupMessageListResp, error) {
 	l := logic.NewGetGroupMessageListLogic(ctx, s.svcCtx)
 	return l.GetGroupMessageList(in)
@@ -71,7 +77,13 @@ func (s *MessageServer) GetGroupMessagesBySeq(ctx context.Context, in *message.G
 	return l.GetGroupMessagesBySeq(in)
 }
 
-// 模糊搜索消息内容
+// 获取大于指定Seq的私聊消息 (用于消息同步)
+func (s *MessageServer) GetPrivateMessagesBySeq(ctx context.Context, in *message.GetPrivateMessagesBySeqReq) (*message.GetPrivateMessagesBySeqResp, error) {
+	l := logic.NewGetPrivateMessagesBySeqLogic(ctx, s.svcCtx)
+	return l.GetPrivateMessagesBySeq(in)
+}
+
+// 搜索聊天记录（全文索引，仅限用户可见的会话）
 func (s *MessageServer) SearchMessage(ctx context.Context, in *message.SearchMessageReq) (*message.SearchMessageResp, error) {
 	l := logic.NewSearchMessageLogic(ctx, s.svcCtx)
 	return l.SearchMessage(in)
@@ -82,3 +94,189 @@ func (s *MessageServer) GetAtMeMessages(ctx context.Context, in *message.GetAtMe
 	l := logic.NewGetAtMeMessagesLogic(ctx, s.svcCtx)
 	return l.GetAtMeMessages(in)
 }
+
+// 创建定时消息
+func (s *MessageServer) CreateScheduledMessage(ctx context.Context, in *message.CreateScheduledMessageReq) (*message.CreateScheduledMessageResp, error) {
+	l := logic.NewCreateScheduledMessageLogic(ctx, s.svcCtx)
+	return l.CreateScheduledMessage(in)
+}
+
+// 修改待发送的定时消息
+func (s *MessageServer) UpdateScheduledMessage(ctx context.Context, in *message.UpdateScheduledMessageReq) (*message.UpdateScheduledMessageResp, error) {
+	l := logic.NewUpdateScheduledMessageLogic(ctx, s.svcCtx)
+	return l.UpdateScheduledMessage(in)
+}
+
+// 取消待发送的定时消息
+func (s *MessageServer) CancelScheduledMessage(ctx context.Context, in *message.CancelScheduledMessageReq) (*message.CancelScheduledMessageResp, error) {
+	l := logic.NewCancelScheduledMessageLogic(ctx, s.svcCtx)
+	return l.CancelScheduledMessage(in)
+}
+
+// 获取定时消息列表
+func (s *MessageServer) ListScheduledMessages(ctx context.Context, in *message.ListScheduledMessagesReq) (*message.ListScheduledMessagesResp, error) {
+	l := logic.NewListScheduledMessagesLogic(ctx, s.svcCtx)
+	return l.ListScheduledMessages(in)
+}
+
+// 创建聊天记录导出任务
+func (s *MessageServer) CreateExportJob(ctx context.Context, in *message.CreateExportJobReq) (*message.CreateExportJobResp, error) {
+	l := logic.NewCreateExportJobLogic(ctx, s.svcCtx)
+	return l.CreateExportJob(in)
+}
+
+// 获取导出任务详情（已完成时返回下载链接）
+func (s *MessageServer) GetExportJob(ctx context.Context, in *message.GetExportJobReq) (*message.GetExportJobResp, error) {
+	l := logic.NewGetExportJobLogic(ctx, s.svcCtx)
+	return l.GetExportJob(in)
+}
+
+// 获取导出任务列表
+func (s *MessageServer) ListExportJobs(ctx context.Context, in *message.ListExportJobsReq) (*message.ListExportJobsResp, error) {
+	l := logic.NewListExportJobsLogic(ctx, s.svcCtx)
+	return l.ListExportJobs(in)
+}
+
+// 设置群消息保留期限（需要设置消息保留期限权限，默认仅群主）
+func (s *MessageServer) SetGroupRetention(ctx context.Context, in *message.SetGroupRetentionReq) (*message.SetGroupRetentionResp, error) {
+	l := logic.NewSetGroupRetentionLogic(ctx, s.svcCtx)
+	return l.SetGroupRetention(in)
+}
+
+// 获取群消息保留期限
+func (s *MessageServer) GetGroupRetention(ctx context.Context, in *message.GetGroupRetentionReq) (*message.GetGroupRetentionResp, error) {
+	l := logic.NewGetGroupRetentionLogic(ctx, s.svcCtx)
+	return l.GetGroupRetention(in)
+}
+
+// 获取所有会话的未读数汇总（私聊、群聊及群聊@我）
+func (s *MessageServer) GetUnreadSummary(ctx context.Context, in *message.GetUnreadSummaryReq) (*message.GetUnreadSummaryResp, error) {
+	l := logic.NewGetUnreadSummaryLogic(ctx, s.svcCtx)
+	return l.GetUnreadSummary(in)
+}
+
+// 收藏消息（保存内容快照，重复收藏返回已有的收藏）
+func (s *MessageServer) AddFavorite(ctx context.Context, in *message.AddFavoriteReq) (*message.AddFavoriteResp, error) {
+	l := logic.NewAddFavoriteLogic(ctx, s.svcCtx)
+	return l.AddFavorite(in)
+}
+
+// 修改收藏的标签和备注
+func (s *MessageServer) UpdateFavorite(ctx context.Context, in *message.UpdateFavoriteReq) (*message.UpdateFavoriteResp, error) {
+	l := logic.NewUpdateFavoriteLogic(ctx, s.svcCtx)
+	return l.UpdateFavorite(in)
+}
+
+// 删除收藏
+func (s *MessageServer) DeleteFavorite(ctx context.Context, in *message.DeleteFavoriteReq) (*message.DeleteFavoriteResp, error) {
+	l := logic.NewDeleteFavoriteLogic(ctx, s.svcCtx)
+	return l.DeleteFavorite(in)
+}
+
+// 查询收藏列表（支持关键词、标签、消息类型筛选）
+func (s *MessageServer) ListFavorites(ctx context.Context, in *message.ListFavoritesReq) (*message.ListFavoritesResp, error) {
+	l := logic.NewListFavoritesLogic(ctx, s.svcCtx)
+	return l.ListFavorites(in)
+}
+
+// 获取收藏使用过的标签
+func (s *MessageServer) ListFavoriteTags(ctx context.Context, in *message.ListFavoriteTagsReq) (*message.ListFavoriteTagsResp, error) {
+	l := logic.NewListFavoriteTagsLogic(ctx, s.svcCtx)
+	return l.ListFavoriteTags(in)
+}
+
+// 置顶消息（群聊需要置顶消息权限，私聊双方均可）
+func (s *MessageServer) PinMessage(ctx context.Context, in *message.PinMessageReq) (*message.PinMessageResp, error) {
+	l := logic.NewPinMessageLogic(ctx, s.svcCtx)
+	return l.PinMessage(in)
+}
+
+// 取消置顶消息
+func (s *MessageServer) UnpinMessage(ctx context.Context, in *message.UnpinMessageReq) (*message.UnpinMessageResp, error) {
+	l := logic.NewUnpinMessageLogic(ctx, s.svcCtx)
+	return l.UnpinMessage(in)
+}
+
+// 获取会话的置顶消息列表
+func (s *MessageServer) ListPinnedMessages(ctx context.Context, in *message.ListPinnedMessagesReq) (*message.ListPinnedMessagesResp, error) {
+	l := logic.NewListPinnedMessagesLogic(ctx, s.svcCtx)
+	return l.ListPinnedMessages(in)
+}
+
+// 发起群投票（写入一条投票消息）
+func (s *MessageServer) CreatePoll(ctx context.Context, in *message.CreatePollReq) (*message.CreatePollResp, error) {
+	l := logic.NewCreatePollLogic(ctx, s.svcCtx)
+	return l.CreatePoll(in)
+}
+
+// 投票（已投过票时覆盖原选择）
+func (s *MessageServer) VotePoll(ctx context.Context, in *message.VotePollReq) (*message.VotePollResp, error) {
+	l := logic.NewVotePollLogic(ctx, s.svcCtx)
+	return l.VotePoll(in)
+}
+
+// 撤回自己的投票
+func (s *MessageServer) RetractPollVote(ctx context.Context, in *message.RetractPollVoteReq) (*message.RetractPollVoteResp, error) {
+	l := logic.NewRetractPollVoteLogic(ctx, s.svcCtx)
+	return l.RetractPollVote(in)
+}
+
+// 获取投票详情和实时计票结果
+func (s *MessageServer) GetPoll(ctx context.Context, in *message.GetPollReq) (*message.GetPollResp, error) {
+	l := logic.NewGetPollLogic(ctx, s.svcCtx)
+	return l.GetPoll(in)
+}
+
+// 发送群话题回复（不进入群主时间线，不累加群未读数）
+func (s *MessageServer) SendThreadReply(ctx context.Context, in *message.SendThreadReplyReq) (*message.SendThreadReplyResp, error) {
+	l := logic.NewSendThreadReplyLogic(ctx, s.svcCtx)
+	return l.SendThreadReply(in)
+}
+
+// 获取话题的回复列表（按话题内 Seq 升序）
+func (s *MessageServer) GetThreadReplies(ctx context.Context, in *message.GetThreadRepliesReq) (*message.GetThreadRepliesResp, error) {
+	l := logic.NewGetThreadRepliesLogic(ctx, s.svcCtx)
+	return l.GetThreadReplies(in)
+}
+
+// 关注话题（有新回复时收到通知）
+func (s *MessageServer) FollowThread(ctx context.Context, in *message.FollowThreadReq) (*message.FollowThreadResp, error) {
+	l := logic.NewFollowThreadLogic(ctx, s.svcCtx)
+	return l.FollowThread(in)
+}
+
+// 取消关注话题
+func (s *MessageServer) UnfollowThread(ctx context.Context, in *message.FollowThreadReq) (*message.FollowThreadResp, error) {
+	l := logic.NewUnfollowThreadLogic(ctx, s.svcCtx)
+	return l.UnfollowThread(in)
+}
+
+// 添加群自定义敏感词（需要内容审核权限，已存在时更新处理动作）
+func (s *MessageServer) AddGroupSensitiveWords(ctx context.Context, in *message.GroupSensitiveWordsReq) (*message.GroupSensitiveWordsResp, error) {
+	l := logic.NewAddGroupSensitiveWordsLogic(ctx, s.svcCtx)
+	return l.AddGroupSensitiveWords(in)
+}
+
+// 删除群自定义敏感词（需要内容审核权限）
+func (s *MessageServer) RemoveGroupSensitiveWords(ctx context.Context, in *message.GroupSensitiveWordsReq) (*message.GroupSensitiveWordsResp, error) {
+	l := logic.NewRemoveGroupSensitiveWordsLogic(ctx, s.svcCtx)
+	return l.RemoveGroupSensitiveWords(in)
+}
+
+// 获取群自定义敏感词（需要内容审核权限）
+func (s *MessageServer) ListGroupSensitiveWords(ctx context.Context, in *message.ListGroupSensitiveWordsReq) (*message.GroupSensitiveWordsResp, error) {
+	l := logic.NewListGroupSensitiveWordsLogic(ctx, s.svcCtx)
+	return l.ListGroupSensitiveWords(in)
+}
+
+// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+func (s *MessageServer) ListModerationReviews(ctx context.Context, in *message.ListModerationReviewsReq) (*message.ListModerationReviewsResp, error) {
+	l := logic.NewListModerationReviewsLogic(ctx, s.svcCtx)
+	return l.ListModerationReviews(in)
+}
+
+// 处理送审消息：通过或删除消息
+func (s *MessageServer) ReviewModeration(ctx context.Context, in *message.ReviewModerationReq) (*message.ReviewModerationResp, error) {
+	l := logic.NewReviewModerationLogic(ctx, s.svcCtx)
+	return l.ReviewModeration(in)
+}
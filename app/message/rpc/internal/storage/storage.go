@@ -0,0 +1,135 @@
+package storage
+
+// storage.go - 导出文件存储
+//
+// 复用上传服务的 MinIO，导出文件存放在独立的私有桶中，
+// 下载时生成有时效的预签名链接，避免聊天记录被公开访问
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// presignRegion 预签名客户端固定 Region，避免生成链接时访问公共地址查询桶所在区域
+const presignRegion = "us-east-1"
+
+// Options 存储配置
+type Options struct {
+	Endpoint        string // 内部访问地址（上传使用）
+	PublicEndpoint  string // 公共访问地址（下载链接使用），为空时使用 Endpoint
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool
+	Bucket          string
+	LinkExpire      time.Duration // 下载链接有效期
+}
+
+// Storage 导出文件存储
+type Storage struct {
+	client        *minio.Client
+	presignClient *minio.Client
+	bucket        string
+	linkExpire    time.Duration
+	bucketReady   atomic.Bool
+}
+
+func MustNewStorage(o Options) *Storage {
+	creds := credentials.NewStaticV4(o.AccessKeyID, o.SecretAccessKey, "")
+
+	client, err := minio.New(o.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: o.UseSSL,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("创建 MinIO 客户端失败: %v", err))
+	}
+
+	publicHost, publicSSL := o.Endpoint, o.UseSSL
+	if o.PublicEndpoint != "" {
+		publicHost, publicSSL = parseEndpoint(o.PublicEndpoint, o.UseSSL)
+	}
+	presignClient, err := minio.New(publicHost, &minio.Options{
+		Creds:  creds,
+		Secure: publicSSL,
+		Region: presignRegion,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("创建 MinIO 预签名客户端失败: %v", err))
+	}
+
+	return &Storage{
+		client:        client,
+		presignClient: presignClient,
+		bucket:        o.Bucket,
+		linkExpire:    o.LinkExpire,
+	}
+}
+
+// Put 上传文件，返回文件大小
+func (s *Storage) Put(ctx context.Context, objectName, contentType string, data []byte) (int64, error) {
+	if err := s.ensureBucket(ctx); err != nil {
+		return 0, err
+	}
+
+	info, err := s.client.PutObject(ctx, s.bucket, objectName, bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return 0, fmt.Errorf("上传导出文件失败: %w", err)
+	}
+	return info.Size, nil
+}
+
+// DownloadURL 生成下载链接，返回链接及过期时间
+func (s *Storage) DownloadURL(ctx context.Context, objectName, filename string) (string, time.Time, error) {
+	params := url.Values{}
+	params.Set("response-content-disposition", fmt.Sprintf(`attachment; filename*=UTF-8''%s`, url.PathEscape(filename)))
+
+	expireAt := time.Now().Add(s.linkExpire)
+	u, err := s.presignClient.PresignedGetObject(ctx, s.bucket, objectName, s.linkExpire, params)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return u.String(), expireAt, nil
+}
+
+// ensureBucket 首次上传时创建私有桶（MinIO 暂不可用时不影响服务启动）
+func (s *Storage) ensureBucket(ctx context.Context) error {
+	if s.bucketReady.Load() {
+		return nil
+	}
+
+	exists, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return fmt.Errorf("检查存储桶失败: %w", err)
+	}
+	if !exists {
+		if err := s.client.MakeBucket(ctx, s.bucket, minio.MakeBucketOptions{}); err != nil {
+			// 多实例并发创建时可能已被其他实例创建
+			if resp := minio.ToErrorResponse(err); resp.Code != "BucketAlreadyOwnedByYou" {
+				return fmt.Errorf("创建存储桶失败: %w", err)
+			}
+		}
+	}
+	s.bucketReady.Store(true)
+	return nil
+}
+
+// parseEndpoint 解析公共访问地址，兼容带 http(s):// 前缀和不带前缀两种写法
+func parseEndpoint(endpoint string, defaultSSL bool) (string, bool) {
+	switch {
+	case strings.HasPrefix(endpoint, "https://"):
+		return strings.TrimSuffix(strings.TrimPrefix(endpoint, "https://"), "/"), true
+	case strings.HasPrefix(endpoint, "http://"):
+		return strings.TrimSuffix(strings.TrimPrefix(endpoint, "http://"), "/"), false
+	default:
+		return strings.TrimSuffix(endpoint, "/"), defaultSSL
+	}
+}
@@ -1,9 +1,19 @@
 package svc
 
 import (
+	"time"
+
+	"SkyeIM/app/friend/rpc/friendclient"
 	"SkyeIM/app/group/rpc/groupclient"
 	"SkyeIM/app/message/model"
 	"SkyeIM/app/message/rpc/internal/config"
+	"SkyeIM/app/message/rpc/internal/moderation"
+	"SkyeIM/app/message/rpc/internal/policy"
+	"SkyeIM/app/message/rpc/internal/search"
+	"SkyeIM/app/message/rpc/internal/storage"
+	"SkyeIM/app/user/rpc/userClient"
+	"SkyeIM/common/unread"
+	"SkyeIM/common/wspush"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -11,19 +21,102 @@ import (
 )
 
 type ServiceContext struct {
-	Config         config.Config
-	ImMessageModel model.ImMessageModel
-	GroupRpc       groupclient.Group
-	Redis          *redis.Redis
+	Config                    config.Config
+	ImMessageModel            model.ImMessageModel
+	ImScheduledMessageModel   model.ImScheduledMessageModel
+	ImExportJobModel          model.ImExportJobModel
+	ImGroupRetentionModel     model.ImGroupRetentionModel
+	ImFavoriteModel           model.ImFavoriteModel
+	ImPinnedMessageModel      model.ImPinnedMessageModel
+	ImPollModel               model.ImPollModel
+	ImPollVoteModel           model.ImPollVoteModel
+	ImThreadReplyModel        model.ImThreadReplyModel
+	ImThreadFollowerModel     model.ImThreadFollowerModel
+	ImGroupSensitiveWordModel model.ImGroupSensitiveWordModel
+	ImModerationReviewModel   model.ImModerationReviewModel
+	ImLinkPreviewModel        model.ImLinkPreviewModel
+	GroupRpc                  groupclient.Group
+	FriendRpc                 friendclient.Friend
+	UserRpc                   userClient.User
+	PrivatePolicy             *policy.PrivateChecker
+	WordFilter                *moderation.WordFilter
+	Moderator                 *moderation.Moderator
+	Searcher                  *search.Searcher
+	Redis                     *redis.Redis
+	UnreadCounter             *unread.Counter
+	WsPushClient              *wspush.WsPushClient
+	ExportStorage             *storage.Storage
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.MySQL.DataSource)
+	friendRpc := friendclient.NewFriend(zrpc.MustNewClient(c.FriendRpc))
+	groupRpc := groupclient.NewGroup(zrpc.MustNewClient(c.GroupRpc))
+	messageModel := newMessageModel(c, conn)
+	rds := redis.MustNewRedis(c.Cache[0].RedisConf)
+	groupWordModel := model.NewImGroupSensitiveWordModel(conn, c.Cache)
+	defaultAction, _ := moderation.ParseAction(c.Moderation.DefaultAction)
+	wordFilter := moderation.MustNewWordFilter(moderation.FilterOptions{
+		WordFile:       c.Moderation.WordFile,
+		DefaultAction:  defaultAction,
+		ReloadInterval: time.Duration(c.Moderation.ReloadInterval) * time.Second,
+		GroupCacheTTL:  time.Duration(c.Moderation.GroupCacheSeconds) * time.Second,
+	}, groupWordModel)
 
 	return &ServiceContext{
-		Config:         c,
-		ImMessageModel: model.NewImMessageModel(conn, c.Cache),
-		GroupRpc:       groupclient.NewGroup(zrpc.MustNewClient(c.GroupRpc)),
-		Redis:          redis.MustNewRedis(c.Cache[0].RedisConf),
+		Config:                    c,
+		ImMessageModel:            messageModel,
+		ImScheduledMessageModel:   model.NewImScheduledMessageModel(conn, c.Cache),
+		ImExportJobModel:          model.NewImExportJobModel(conn, c.Cache),
+		ImGroupRetentionModel:     model.NewImGroupRetentionModel(conn, c.Cache),
+		ImFavoriteModel:           model.NewImFavoriteModel(conn, c.Cache),
+		ImPinnedMessageModel:      model.NewImPinnedMessageModel(conn, c.Cache),
+		ImPollModel:               model.NewImPollModel(conn, c.Cache),
+		ImPollVoteModel:           model.NewImPollVoteModel(conn, c.Cache),
+		ImThreadReplyModel:        model.NewImThreadReplyModel(conn, c.Cache),
+		ImThreadFollowerModel:     model.NewImThreadFollowerModel(conn, c.Cache),
+		ImGroupSensitiveWordModel: groupWordModel,
+		ImModerationReviewModel:   model.NewImModerationReviewModel(conn, c.Cache),
+		ImLinkPreviewModel:        model.NewImLinkPreviewModel(conn, c.Cache),
+		GroupRpc:                  groupRpc,
+		FriendRpc:                 friendRpc,
+		UserRpc:                   userClient.NewUser(zrpc.MustNewClient(c.UserRpc)),
+		PrivatePolicy:             policy.NewPrivateChecker(friendRpc, c.AllowStrangerMessage),
+		WordFilter:                wordFilter,
+		Moderator:                 moderation.NewModerator(wordFilter),
+		Searcher:                  search.NewSearcher(messageModel, groupRpc),
+		Redis:                     rds,
+		UnreadCounter:             unread.NewCounter(rds),
+		WsPushClient:              wspush.NewWsPushClient(c.WsServiceUrl, c.WsPushSecret),
+		ExportStorage: storage.MustNewStorage(storage.Options{
+			Endpoint:        c.Export.MinIO.Endpoint,
+			PublicEndpoint:  c.Export.MinIO.PublicEndpoint,
+			AccessKeyID:     c.Export.MinIO.AccessKeyID,
+			SecretAccessKey: c.Export.MinIO.SecretAccessKey,
+			UseSSL:          c.Export.MinIO.UseSSL,
+			Bucket:          c.Export.MinIO.Bucket,
+			LinkExpire:      time.Duration(c.Export.LinkExpire) * time.Second,
+		}),
+	}
+}
+
+// newMessageModel 配置了分片时按会话路由到分片表，否则使用 im_message 单表
+func newMessageModel(c config.Config, conn sqlx.SqlConn) model.ImMessageModel {
+	if c.Sharding.Count <= 0 {
+		return model.NewImMessageModel(conn, c.Cache)
 	}
+
+	conns := []sqlx.SqlConn{conn}
+	if len(c.Sharding.DataSources) > 0 {
+		conns = make([]sqlx.SqlConn, 0, len(c.Sharding.DataSources))
+		for _, ds := range c.Sharding.DataSources {
+			conns = append(conns, sqlx.NewMysql(ds))
+		}
+	}
+
+	return model.NewShardedImMessageModel(model.ShardOptions{
+		Count:  c.Sharding.Count,
+		Conns:  conns,
+		IdConn: conn,
+	}, c.Cache)
 }
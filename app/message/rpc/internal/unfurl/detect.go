@@ -0,0 +1,31 @@
+package unfurl
+
+// detect.go - 识别文字消息中的链接
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// maxURLLength 超过该长度的链接不生成预览（im_link_preview.url 字段长度）
+const maxURLLength = 2048
+
+var urlPattern = regexp.MustCompile(`(?i)https?://[^\s<>"'\x{3000}-\x{303f}\x{ff00}-\x{ffef}]+`)
+
+// FindURL 返回文本中第一个可以生成预览的 http/https 链接，没有时返回空字符串
+// 链接末尾的标点（如句号、右括号）不计入链接
+func FindURL(text string) string {
+	for _, raw := range urlPattern.FindAllString(text, -1) {
+		raw = strings.TrimRight(raw, ".,;:!?)]}")
+		if len(raw) > maxURLLength {
+			continue
+		}
+		u, err := url.Parse(raw)
+		if err != nil || u.Hostname() == "" || u.User != nil {
+			continue
+		}
+		return raw
+	}
+	return ""
+}
@@ -0,0 +1,225 @@
+package unfurl
+
+// fetcher.go - 抓取网页元数据
+//
+// HTTPFetcher 的 SSRF 防护：
+// 1. 只允许 http/https 和常用端口，拒绝带用户名密码的链接，不使用环境变量中的代理
+// 2. 在建立连接时校验实际连接的 IP（而不是只校验域名解析结果），防止 DNS 重绑定绕过；
+//    回环、内网、链路本地、组播及其他保留地址一律拒绝，重定向后的地址同样经过校验
+// 3. 限制重定向次数、总超时和读取的响应大小，只解析 HTML 响应
+//
+// 本地测试时可开启 AllowPrivateNetworks 访问 127.0.0.1 上的测试服务
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+var (
+	ErrInvalidURL       = errors.New("链接无效")
+	ErrForbiddenAddress = errors.New("禁止访问的地址")
+	ErrNotHTML          = errors.New("不是网页")
+	ErrNoMetadata       = errors.New("没有可用的预览信息")
+)
+
+// Preview 链接预览信息
+type Preview struct {
+	Url         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Image       string `json:"image"`
+	SiteName    string `json:"siteName"`
+}
+
+// Fetcher 抓取链接的预览信息
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (*Preview, error)
+}
+
+// FetcherOptions HTTP 抓取配置
+type FetcherOptions struct {
+	Timeout              time.Duration // 单次抓取总超时（含重定向）
+	MaxBodyBytes         int64         // 最多读取的响应字节数
+	MaxRedirects         int           // 最多跟随的重定向次数
+	UserAgent            string
+	AllowPrivateNetworks bool // 允许访问内网地址和任意端口（仅用于本地测试）
+}
+
+// allowedPorts 允许访问的端口
+var allowedPorts = map[string]bool{"": true, "80": true, "443": true, "8080": true, "8443": true}
+
+// reservedNets net.IP 内置判断之外的保留地址段
+var reservedNets = mustParseCIDRs(
+	"0.0.0.0/8",       // 本网络
+	"100.64.0.0/10",   // 运营商级 NAT
+	"192.0.0.0/24",    // IETF 协议分配
+	"192.0.2.0/24",    // 文档示例
+	"198.18.0.0/15",   // 基准测试
+	"198.51.100.0/24", // 文档示例
+	"203.0.113.0/24",  // 文档示例
+	"240.0.0.0/4",     // 保留
+	"64:ff9b::/96",    // NAT64（可映射到内网 IPv4）
+	"2001:db8::/32",   // 文档示例
+)
+
+// HTTPFetcher 通过 HTTP 抓取网页的 OpenGraph / Twitter Card 元数据
+type HTTPFetcher struct {
+	opts   FetcherOptions
+	client *http.Client
+}
+
+func NewHTTPFetcher(opts FetcherOptions) *HTTPFetcher {
+	f := &HTTPFetcher{opts: opts}
+
+	dialer := &net.Dialer{
+		Timeout: opts.Timeout,
+		Control: f.checkDial,
+	}
+	f.client = &http.Client{
+		Timeout: opts.Timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil, // 经代理访问时无法校验目标地址
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   opts.Timeout,
+			ResponseHeaderTimeout: opts.Timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > opts.MaxRedirects {
+				return fmt.Errorf("重定向次数过多")
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+// Fetch 抓取网页并解析预览信息
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("响应状态码 %d", resp.StatusCode)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, ErrNotHTML
+	}
+
+	// 按响应声明的编码转换为 UTF-8（国内站点常见 GBK）
+	body, err := charset.NewReader(io.LimitReader(resp.Body, f.opts.MaxBodyBytes), contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := parseMetadata(body, resp.Request.URL)
+	if preview.Title == "" && preview.Description == "" && preview.Image == "" {
+		return nil, ErrNoMetadata
+	}
+	if preview.Url == "" {
+		preview.Url = rawURL
+	}
+	return preview, nil
+}
+
+// checkURL 校验协议、端口和用户信息（地址在建立连接时校验）
+func (f *HTTPFetcher) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrInvalidURL
+	}
+	if u.Hostname() == "" || u.User != nil {
+		return ErrInvalidURL
+	}
+	if !f.opts.AllowPrivateNetworks && !allowedPorts[u.Port()] {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// checkDial 建立连接前校验实际连接的 IP
+func (f *HTTPFetcher) checkDial(network, address string, _ syscall.RawConn) error {
+	if f.opts.AllowPrivateNetworks {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrForbiddenAddress
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// isPublicIP 是否为公网地址
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	// IPv4 映射的 IPv6 地址按 IPv4 判断
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	for _, n := range reservedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
+
+// truncate 按字符截断，避免超过数据库字段长度
+func truncate(s string, max int) string {
+	s = strings.TrimSpace(s)
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
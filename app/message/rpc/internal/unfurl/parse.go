@@ -0,0 +1,122 @@
+package unfurl
+
+// parse.go - 解析网页 <head> 中的预览元数据
+//
+// 优先级：OpenGraph（og:*）> Twitter Card（twitter:*）> <title> / <meta name="description">
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// 预览字段的最大长度（与 im_link_preview 字段长度一致）
+const (
+	maxTitleLen       = 255
+	maxDescriptionLen = 1024
+	maxSiteNameLen    = 255
+)
+
+// parseMetadata 读取到 </head> 或 <body> 为止，base 为最终页面地址（用于解析相对地址）
+func parseMetadata(r io.Reader, base *url.URL) *Preview {
+	meta := make(map[string]string)
+	var title string
+	inTitle := false
+
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return buildPreview(meta, title, base)
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = string(z.Text())
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				return buildPreview(meta, title, base)
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Body:
+				return buildPreview(meta, title, base)
+			case atom.Title:
+				inTitle = tt == html.StartTagToken
+			case atom.Meta:
+				if !hasAttr {
+					continue
+				}
+				var key, content string
+				for {
+					k, v, more := z.TagAttr()
+					switch string(k) {
+					case "property", "name":
+						if key == "" {
+							key = strings.ToLower(strings.TrimSpace(string(v)))
+						}
+					case "content":
+						content = string(v)
+					}
+					if !more {
+						break
+					}
+				}
+				if key != "" && content != "" {
+					if _, ok := meta[key]; !ok {
+						meta[key] = content
+					}
+				}
+			}
+		}
+	}
+}
+
+// buildPreview 按优先级合并元数据
+func buildPreview(meta map[string]string, title string, base *url.URL) *Preview {
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := strings.TrimSpace(meta[k]); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	return &Preview{
+		Url:         resolveURL(base, first("og:url")),
+		Title:       truncate(firstNonEmpty(first("og:title", "twitter:title"), title), maxTitleLen),
+		Description: truncate(first("og:description", "twitter:description", "description"), maxDescriptionLen),
+		Image:       resolveURL(base, first("og:image:secure_url", "og:image", "twitter:image", "twitter:image:src")),
+		SiteName:    truncate(first("og:site_name", "application-name"), maxSiteNameLen),
+	}
+}
+
+// resolveURL 将相对地址解析为绝对地址，只保留 http/https 地址
+func resolveURL(base *url.URL, ref string) string {
+	if ref == "" || base == nil {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.String()) > maxURLLength {
+		return ""
+	}
+	return u.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
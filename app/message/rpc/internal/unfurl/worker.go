@@ -0,0 +1,232 @@
+package unfurl
+
+// worker.go - 链接预览抓取任务
+//
+// 发送文字消息时识别其中的第一个链接并写入 im_link_preview（待抓取），本任务定时扫描：
+// 1. 条件更新领取记录（待抓取 -> 抓取中），多实例部署时每条记录只会被一个实例处理
+// 2. 同一链接的抓取结果在 Redis 中缓存（抓取失败同样缓存较短时间），避免热门链接被重复抓取
+// 3. 保存预览信息，通过 WebSocket 推送 link_preview 事件，客户端据此为消息补充预览卡片
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"SkyeIM/app/message/model"
+	"SkyeIM/app/message/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// EventLinkPreview 消息链接预览抓取完成
+	EventLinkPreview = "link_preview"
+
+	// staleFetchingTimeout 抓取中超过该时长视为实例异常退出，恢复为待抓取
+	staleFetchingTimeout = 5 * time.Minute
+	// cacheKeyPrefix 链接抓取结果缓存，key 为链接的 SHA1
+	cacheKeyPrefix = "unfurl:url:"
+	// maxFailCacheSeconds 抓取失败结果的最长缓存时间（秒）
+	maxFailCacheSeconds = 300
+)
+
+type Worker struct {
+	svcCtx      *svc.ServiceContext
+	fetcher     Fetcher
+	interval    time.Duration
+	batch       int64
+	concurrency int
+	timeout     time.Duration
+	cacheTTL    int
+	done        chan struct{}
+}
+
+// NewWorker 使用配置创建 HTTP 抓取器
+func NewWorker(svcCtx *svc.ServiceContext) *Worker {
+	c := svcCtx.Config.Unfurl
+	return NewWorkerWithFetcher(svcCtx, NewHTTPFetcher(FetcherOptions{
+		Timeout:              time.Duration(c.Timeout) * time.Second,
+		MaxBodyBytes:         c.MaxBodyKB << 10,
+		MaxRedirects:         c.MaxRedirects,
+		UserAgent:            c.UserAgent,
+		AllowPrivateNetworks: c.AllowPrivateNetworks,
+	}))
+}
+
+// NewWorkerWithFetcher 使用指定的抓取器（如访问本地测试服务的抓取器）
+func NewWorkerWithFetcher(svcCtx *svc.ServiceContext, fetcher Fetcher) *Worker {
+	c := svcCtx.Config.Unfurl
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &Worker{
+		svcCtx:      svcCtx,
+		fetcher:     fetcher,
+		interval:    time.Duration(c.ScanInterval) * time.Second,
+		batch:       int64(c.BatchSize),
+		concurrency: concurrency,
+		timeout:     time.Duration(c.Timeout) * time.Second,
+		cacheTTL:    c.CacheSeconds,
+		done:        make(chan struct{}),
+	}
+}
+
+// Start 启动扫描循环（阻塞，由 ServiceGroup 管理）
+func (w *Worker) Start() {
+	if !w.svcCtx.Config.Unfurl.Enabled {
+		<-w.done
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.scan()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (w *Worker) Stop() {
+	close(w.done)
+}
+
+// scan 领取并并发处理一批待抓取的链接
+func (w *Worker) scan() {
+	ctx := context.Background()
+
+	if n, err := w.svcCtx.ImLinkPreviewModel.ResetStale(ctx, time.Now().Add(-staleFetchingTimeout)); err != nil {
+		logx.Errorf("[Unfurl] 恢复超时抓取记录失败: %v", err)
+	} else if n > 0 {
+		logx.Infof("[Unfurl] 恢复 %d 条超时未完成的抓取记录", n)
+	}
+
+	pending, err := w.svcCtx.ImLinkPreviewModel.FindPending(ctx, w.batch)
+	if err != nil {
+		logx.Errorf("[Unfurl] 查询待抓取链接失败: %v", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, w.concurrency)
+	for _, row := range pending {
+		claimed, err := w.svcCtx.ImLinkPreviewModel.Claim(ctx, row)
+		if err != nil {
+			logx.Errorf("[Unfurl] 领取抓取记录失败: msgId=%s, err=%v", row.MsgId, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(row *model.ImLinkPreview) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			w.process(ctx, row)
+		}(row)
+	}
+	wg.Wait()
+}
+
+// process 抓取单条链接并保存结果
+func (w *Worker) process(ctx context.Context, row *model.ImLinkPreview) {
+	preview, err := w.lookup(ctx, row.Url)
+	if err != nil {
+		logx.Infof("[Unfurl] 链接无法生成预览: msgId=%s, url=%s, err=%v", row.MsgId, row.Url, err)
+		row.Status = model.LinkPreviewStatusFailed
+	} else {
+		row.Status = model.LinkPreviewStatusDone
+		row.Title = preview.Title
+		row.Description = preview.Description
+		row.Image = preview.Image
+		row.SiteName = preview.SiteName
+	}
+
+	if err := w.svcCtx.ImLinkPreviewModel.Update(ctx, row); err != nil {
+		logx.Errorf("[Unfurl] 保存链接预览失败: msgId=%s, err=%v", row.MsgId, err)
+		return
+	}
+	if row.Status == model.LinkPreviewStatusDone {
+		w.push(row)
+	}
+}
+
+// lookup 优先读取缓存，未命中时抓取并写入缓存
+// 预览中的 url 始终为消息中的原始链接，客户端据此匹配消息内容
+func (w *Worker) lookup(ctx context.Context, rawURL string) (*Preview, error) {
+	sum := sha1.Sum([]byte(rawURL))
+	key := cacheKeyPrefix + hex.EncodeToString(sum[:])
+
+	if cached, err := w.svcCtx.Redis.GetCtx(ctx, key); err == nil && cached != "" {
+		var preview Preview
+		if json.Unmarshal([]byte(cached), &preview) == nil {
+			if preview.Title == "" && preview.Description == "" && preview.Image == "" {
+				return nil, ErrNoMetadata
+			}
+			return &preview, nil
+		}
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	preview, err := w.fetcher.Fetch(fetchCtx, rawURL)
+
+	ttl := w.cacheTTL
+	cached := &Preview{Url: rawURL}
+	if err != nil {
+		ttl = min(ttl, maxFailCacheSeconds)
+	} else {
+		preview.Url = rawURL
+		cached = preview
+	}
+	if data, marshalErr := json.Marshal(cached); marshalErr == nil && ttl > 0 {
+		if cacheErr := w.svcCtx.Redis.SetexCtx(ctx, key, string(data), ttl); cacheErr != nil {
+			logx.Errorf("[Unfurl] 缓存链接预览失败: url=%s, err=%v", rawURL, cacheErr)
+		}
+	}
+	return preview, err
+}
+
+// push 推送预览给会话成员，失败只记录日志（客户端拉取历史消息时会带上预览）
+func (w *Worker) push(row *model.ImLinkPreview) {
+	data := map[string]interface{}{
+		"msgId":      row.MsgId,
+		"chatType":   row.ChatType,
+		"fromUserId": row.FromUserId,
+		"toUserId":   row.ToUserId,
+		"groupId":    row.GroupId,
+		"linkPreview": map[string]interface{}{
+			"url":         row.Url,
+			"title":       row.Title,
+			"description": row.Description,
+			"image":       row.Image,
+			"siteName":    row.SiteName,
+		},
+	}
+
+	if row.ChatType == 2 {
+		if err := w.svcCtx.WsPushClient.PushGroupEvent(row.GroupId, EventLinkPreview, data); err != nil {
+			logx.Errorf("[Unfurl] 推送群消息链接预览失败: msgId=%s, err=%v", row.MsgId, err)
+		}
+		return
+	}
+
+	for _, userId := range []uint64{row.FromUserId, row.ToUserId} {
+		if err := w.svcCtx.WsPushClient.PushToUser(int64(userId), EventLinkPreview, data); err != nil {
+			logx.Errorf("[Unfurl] 推送链接预览失败: msgId=%s, userId=%d, err=%v", row.MsgId, userId, err)
+		}
+	}
+}
@@ -4,9 +4,15 @@ import (
 	"flag"
 	"fmt"
 
+	"SkyeIM/app/message/rpc/internal/archive"
 	"SkyeIM/app/message/rpc/internal/config"
+	"SkyeIM/app/message/rpc/internal/expiry"
+	"SkyeIM/app/message/rpc/internal/export"
+	"SkyeIM/app/message/rpc/internal/poll"
+	"SkyeIM/app/message/rpc/internal/scheduler"
 	"SkyeIM/app/message/rpc/internal/server"
 	"SkyeIM/app/message/rpc/internal/svc"
+	"SkyeIM/app/message/rpc/internal/unfurl"
 	"SkyeIM/app/message/rpc/message"
 
 	"github.com/zeromicro/go-zero/core/conf"
@@ -32,8 +38,19 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
+
+	// RPC 服务与后台任务统一管理启停
+	group := service.NewServiceGroup()
+	defer group.Stop()
+	group.Add(s)
+	group.Add(expiry.NewWorker(ctx))
+	group.Add(scheduler.NewWorker(ctx))
+	group.Add(export.NewWorker(ctx))
+	group.Add(archive.NewWorker(ctx))
+	group.Add(poll.NewWorker(ctx))
+	group.Add(ctx.WordFilter)
+	group.Add(unfurl.NewWorker(ctx))
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
@@ -26,9 +26,11 @@ type MessageClient interface {
 	SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error)
 	// 发送群聊消息
 	SendGroupMessage(ctx context.Context, in *SendGroupMessageReq, opts ...grpc.CallOption) (*SendGroupMessageResp, error)
-	// 获取私聊历史消息列表（分页）
+	// 写入群系统消息（成员变动等事件，供内部服务调用）
+	SendGroupSystemMessage(ctx context.Context, in *SendGroupSystemMessageReq, opts ...grpc.CallOption) (*SendGroupMessageResp, error)
+	// 获取私聊历史消息列表（分页，支持按消息或日期定位）
 	GetMessageList(ctx context.Context, in *GetMessageListReq, opts ...grpc.CallOption) (*GetMessageListResp, error)
-	// 获取群聊历史消息列表（分页）
+	// 获取群聊历史消息列表（分页，支持按消息、Seq或日期定位）
 	GetGroupMessageList(ctx context.Context, in *GetGroupMessageListReq, opts ...grpc.CallOption) (*GetGroupMessageListResp, error)
 	// 标记私聊消息为已读
 	MarkAsRead(ctx context.Context, in *MarkAsReadReq, opts ...grpc.CallOption) (*MarkAsReadResp, error)
@@ -38,10 +40,74 @@ type MessageClient interface {
 	GetUnreadMessages(ctx context.Context, in *GetUnreadMessagesReq, opts ...grpc.CallOption) (*GetUnreadMessagesResp, error)
 	// 获取大于指定Seq的群聊消息 (用于消息同步)
 	GetGroupMessagesBySeq(ctx context.Context, in *GetGroupMessagesBySeqReq, opts ...grpc.CallOption) (*GetGroupMessagesBySeqResp, error)
-	// 模糊搜索消息内容
+	// 获取大于指定Seq的私聊消息 (用于消息同步)
+	GetPrivateMessagesBySeq(ctx context.Context, in *GetPrivateMessagesBySeqReq, opts ...grpc.CallOption) (*GetPrivateMessagesBySeqResp, error)
+	// 搜索聊天记录（全文索引，仅限用户可见的会话）
 	SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error)
 	// 获取@我的消息列表
 	GetAtMeMessages(ctx context.Context, in *GetAtMeMessagesReq, opts ...grpc.CallOption) (*GetAtMeMessagesResp, error)
+	// 创建定时消息
+	CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageReq, opts ...grpc.CallOption) (*CreateScheduledMessageResp, error)
+	// 修改待发送的定时消息
+	UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageReq, opts ...grpc.CallOption) (*UpdateScheduledMessageResp, error)
+	// 取消待发送的定时消息
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*CancelScheduledMessageResp, error)
+	// 获取定时消息列表
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesReq, opts ...grpc.CallOption) (*ListScheduledMessagesResp, error)
+	// 创建聊天记录导出任务
+	CreateExportJob(ctx context.Context, in *CreateExportJobReq, opts ...grpc.CallOption) (*CreateExportJobResp, error)
+	// 获取导出任务详情（已完成时返回下载链接）
+	GetExportJob(ctx context.Context, in *GetExportJobReq, opts ...grpc.CallOption) (*GetExportJobResp, error)
+	// 获取导出任务列表
+	ListExportJobs(ctx context.Context, in *ListExportJobsReq, opts ...grpc.CallOption) (*ListExportJobsResp, error)
+	// 设置群消息保留期限（需要设置消息保留期限权限，默认仅群主）
+	SetGroupRetention(ctx context.Context, in *SetGroupRetentionReq, opts ...grpc.CallOption) (*SetGroupRetentionResp, error)
+	// 获取群消息保留期限
+	GetGroupRetention(ctx context.Context, in *GetGroupRetentionReq, opts ...grpc.CallOption) (*GetGroupRetentionResp, error)
+	// 获取所有会话的未读数汇总（私聊、群聊及群聊@我）
+	GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error)
+	// 收藏消息（保存内容快照，重复收藏返回已有的收藏）
+	AddFavorite(ctx context.Context, in *AddFavoriteReq, opts ...grpc.CallOption) (*AddFavoriteResp, error)
+	// 修改收藏的标签和备注
+	UpdateFavorite(ctx context.Context, in *UpdateFavoriteReq, opts ...grpc.CallOption) (*UpdateFavoriteResp, error)
+	// 删除收藏
+	DeleteFavorite(ctx context.Context, in *DeleteFavoriteReq, opts ...grpc.CallOption) (*DeleteFavoriteResp, error)
+	// 查询收藏列表（支持关键词、标签、消息类型筛选）
+	ListFavorites(ctx context.Context, in *ListFavoritesReq, opts ...grpc.CallOption) (*ListFavoritesResp, error)
+	// 获取收藏使用过的标签
+	ListFavoriteTags(ctx context.Context, in *ListFavoriteTagsReq, opts ...grpc.CallOption) (*ListFavoriteTagsResp, error)
+	// 置顶消息（群聊需要置顶消息权限，私聊双方均可）
+	PinMessage(ctx context.Context, in *PinMessageReq, opts ...grpc.CallOption) (*PinMessageResp, error)
+	// 取消置顶消息
+	UnpinMessage(ctx context.Context, in *UnpinMessageReq, opts ...grpc.CallOption) (*UnpinMessageResp, error)
+	// 获取会话的置顶消息列表
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesReq, opts ...grpc.CallOption) (*ListPinnedMessagesResp, error)
+	// 发起群投票（写入一条投票消息）
+	CreatePoll(ctx context.Context, in *CreatePollReq, opts ...grpc.CallOption) (*CreatePollResp, error)
+	// 投票（已投过票时覆盖原选择）
+	VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error)
+	// 撤回自己的投票
+	RetractPollVote(ctx context.Context, in *RetractPollVoteReq, opts ...grpc.CallOption) (*RetractPollVoteResp, error)
+	// 获取投票详情和实时计票结果
+	GetPoll(ctx context.Context, in *GetPollReq, opts ...grpc.CallOption) (*GetPollResp, error)
+	// 发送群话题回复（不进入群主时间线，不累加群未读数）
+	SendThreadReply(ctx context.Context, in *SendThreadReplyReq, opts ...grpc.CallOption) (*SendThreadReplyResp, error)
+	// 获取话题的回复列表（按话题内 Seq 升序）
+	GetThreadReplies(ctx context.Context, in *GetThreadRepliesReq, opts ...grpc.CallOption) (*GetThreadRepliesResp, error)
+	// 关注话题（有新回复时收到通知）
+	FollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error)
+	// 取消关注话题
+	UnfollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error)
+	// 添加群自定义敏感词（需要内容审核权限，已存在时更新处理动作）
+	AddGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error)
+	// 删除群自定义敏感词（需要内容审核权限）
+	RemoveGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error)
+	// 获取群自定义敏感词（需要内容审核权限）
+	ListGroupSensitiveWords(ctx context.Context, in *ListGroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error)
+	// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+	ListModerationReviews(ctx context.Context, in *ListModerationReviewsReq, opts ...grpc.CallOption) (*ListModerationReviewsResp, error)
+	// 处理送审消息：通过或删除消息
+	ReviewModeration(ctx context.Context, in *ReviewModerationReq, opts ...grpc.CallOption) (*ReviewModerationResp, error)
 }
 
 type messageClient struct {
@@ -70,6 +136,15 @@ func (c *messageClient) SendGroupMessage(ctx context.Context, in *SendGroupMessa
 	return out, nil
 }
 
+func (c *messageClient) SendGroupSystemMessage(ctx context.Context, in *SendGroupSystemMessageReq, opts ...grpc.CallOption) (*SendGroupMessageResp, error) {
+	out := new(SendGroupMessageResp)
+	err := c.cc.Invoke(ctx, "/message.Message/SendGroupSystemMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messageClient) GetMessageList(ctx context.Context, in *GetMessageListReq, opts ...grpc.CallOption) (*GetMessageListResp, error) {
 	out := new(GetMessageListResp)
 	err := c.cc.Invoke(ctx, "/message.Message/GetMessageList", in, out, opts...)
@@ -124,6 +199,15 @@ func (c *messageClient) GetGroupMessagesBySeq(ctx context.Context, in *GetGroupM
 	return out, nil
 }
 
+func (c *messageClient) GetPrivateMessagesBySeq(ctx context.Context, in *GetPrivateMessagesBySeqReq, opts ...grpc.CallOption) (*GetPrivateMessagesBySeqResp, error) {
+	out := new(GetPrivateMessagesBySeqResp)
+	err := c.cc.Invoke(ctx, "/message.Message/GetPrivateMessagesBySeq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messageClient) SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error) {
 	out := new(SearchMessageResp)
 	err := c.cc.Invoke(ctx, "/message.Message/SearchMessage", in, out, opts...)
@@ -142,6 +226,285 @@ func (c *messageClient) GetAtMeMessages(ctx context.Context, in *GetAtMeMessages
 	return out, nil
 }
 
+func (c *messageClient) CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageReq, opts ...grpc.CallOption) (*CreateScheduledMessageResp, error) {
+	out := new(CreateScheduledMessageResp)
+	err := c.cc.Invoke(ctx, "/message.Message/CreateScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageReq, opts ...grpc.CallOption) (*UpdateScheduledMessageResp, error) {
+	out := new(UpdateScheduledMessageResp)
+	err := c.cc.Invoke(ctx, "/message.Message/UpdateScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*CancelScheduledMessageResp, error) {
+	out := new(CancelScheduledMessageResp)
+	err := c.cc.Invoke(ctx, "/message.Message/CancelScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesReq, opts ...grpc.CallOption) (*ListScheduledMessagesResp, error) {
+	out := new(ListScheduledMessagesResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CreateExportJob(ctx context.Context, in *CreateExportJobReq, opts ...grpc.CallOption) (*CreateExportJobResp, error) {
+	out := new(CreateExportJobResp)
+	err := c.cc.Invoke(ctx, "/message.Message/CreateExportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetExportJob(ctx context.Context, in *GetExportJobReq, opts ...grpc.CallOption) (*GetExportJobResp, error) {
+	out := new(GetExportJobResp)
+	err := c.cc.Invoke(ctx, "/message.Message/GetExportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListExportJobs(ctx context.Context, in *ListExportJobsReq, opts ...grpc.CallOption) (*ListExportJobsResp, error) {
+	out := new(ListExportJobsResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListExportJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) SetGroupRetention(ctx context.Context, in *SetGroupRetentionReq, opts ...grpc.CallOption) (*SetGroupRetentionResp, error) {
+	out := new(SetGroupRetentionResp)
+	err := c.cc.Invoke(ctx, "/message.Message/SetGroupRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetGroupRetention(ctx context.Context, in *GetGroupRetentionReq, opts ...grpc.CallOption) (*GetGroupRetentionResp, error) {
+	out := new(GetGroupRetentionResp)
+	err := c.cc.Invoke(ctx, "/message.Message/GetGroupRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error) {
+	out := new(GetUnreadSummaryResp)
+	err := c.cc.Invoke(ctx, "/message.Message/GetUnreadSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) AddFavorite(ctx context.Context, in *AddFavoriteReq, opts ...grpc.CallOption) (*AddFavoriteResp, error) {
+	out := new(AddFavoriteResp)
+	err := c.cc.Invoke(ctx, "/message.Message/AddFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) UpdateFavorite(ctx context.Context, in *UpdateFavoriteReq, opts ...grpc.CallOption) (*UpdateFavoriteResp, error) {
+	out := new(UpdateFavoriteResp)
+	err := c.cc.Invoke(ctx, "/message.Message/UpdateFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) DeleteFavorite(ctx context.Context, in *DeleteFavoriteReq, opts ...grpc.CallOption) (*DeleteFavoriteResp, error) {
+	out := new(DeleteFavoriteResp)
+	err := c.cc.Invoke(ctx, "/message.Message/DeleteFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListFavorites(ctx context.Context, in *ListFavoritesReq, opts ...grpc.CallOption) (*ListFavoritesResp, error) {
+	out := new(ListFavoritesResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListFavoriteTags(ctx context.Context, in *ListFavoriteTagsReq, opts ...grpc.CallOption) (*ListFavoriteTagsResp, error) {
+	out := new(ListFavoriteTagsResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListFavoriteTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) PinMessage(ctx context.Context, in *PinMessageReq, opts ...grpc.CallOption) (*PinMessageResp, error) {
+	out := new(PinMessageResp)
+	err := c.cc.Invoke(ctx, "/message.Message/PinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) UnpinMessage(ctx context.Context, in *UnpinMessageReq, opts ...grpc.CallOption) (*UnpinMessageResp, error) {
+	out := new(UnpinMessageResp)
+	err := c.cc.Invoke(ctx, "/message.Message/UnpinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesReq, opts ...grpc.CallOption) (*ListPinnedMessagesResp, error) {
+	out := new(ListPinnedMessagesResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListPinnedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) CreatePoll(ctx context.Context, in *CreatePollReq, opts ...grpc.CallOption) (*CreatePollResp, error) {
+	out := new(CreatePollResp)
+	err := c.cc.Invoke(ctx, "/message.Message/CreatePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error) {
+	out := new(VotePollResp)
+	err := c.cc.Invoke(ctx, "/message.Message/VotePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) RetractPollVote(ctx context.Context, in *RetractPollVoteReq, opts ...grpc.CallOption) (*RetractPollVoteResp, error) {
+	out := new(RetractPollVoteResp)
+	err := c.cc.Invoke(ctx, "/message.Message/RetractPollVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetPoll(ctx context.Context, in *GetPollReq, opts ...grpc.CallOption) (*GetPollResp, error) {
+	out := new(GetPollResp)
+	err := c.cc.Invoke(ctx, "/message.Message/GetPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) SendThreadReply(ctx context.Context, in *SendThreadReplyReq, opts ...grpc.CallOption) (*SendThreadReplyResp, error) {
+	out := new(SendThreadReplyResp)
+	err := c.cc.Invoke(ctx, "/message.Message/SendThreadReply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetThreadReplies(ctx context.Context, in *GetThreadRepliesReq, opts ...grpc.CallOption) (*GetThreadRepliesResp, error) {
+	out := new(GetThreadRepliesResp)
+	err := c.cc.Invoke(ctx, "/message.Message/GetThreadReplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) FollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error) {
+	out := new(FollowThreadResp)
+	err := c.cc.Invoke(ctx, "/message.Message/FollowThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) UnfollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error) {
+	out := new(FollowThreadResp)
+	err := c.cc.Invoke(ctx, "/message.Message/UnfollowThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) AddGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error) {
+	out := new(GroupSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, "/message.Message/AddGroupSensitiveWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) RemoveGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error) {
+	out := new(GroupSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, "/message.Message/RemoveGroupSensitiveWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListGroupSensitiveWords(ctx context.Context, in *ListGroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error) {
+	out := new(GroupSensitiveWordsResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListGroupSensitiveWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ListModerationReviews(ctx context.Context, in *ListModerationReviewsReq, opts ...grpc.CallOption) (*ListModerationReviewsResp, error) {
+	out := new(ListModerationReviewsResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ListModerationReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ReviewModeration(ctx context.Context, in *ReviewModerationReq, opts ...grpc.CallOption) (*ReviewModerationResp, error) {
+	out := new(ReviewModerationResp)
+	err := c.cc.Invoke(ctx, "/message.Message/ReviewModeration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServer is the server API for Message service.
 // All implementations must embed UnimplementedMessageServer
 // for forward compatibility
@@ -150,9 +513,11 @@ type MessageServer interface {
 	SendMessage(context.Context, *SendMessageReq) (*SendMessageResp, error)
 	// 发送群聊消息
 	SendGroupMessage(context.Context, *SendGroupMessageReq) (*SendGroupMessageResp, error)
-	// 获取私聊历史消息列表（分页）
+	// 写入群系统消息（成员变动等事件，供内部服务调用）
+	SendGroupSystemMessage(context.Context, *SendGroupSystemMessageReq) (*SendGroupMessageResp, error)
+	// 获取私聊历史消息列表（分页，支持按消息或日期定位）
 	GetMessageList(context.Context, *GetMessageListReq) (*GetMessageListResp, error)
-	// 获取群聊历史消息列表（分页）
+	// 获取群聊历史消息列表（分页，支持按消息、Seq或日期定位）
 	GetGroupMessageList(context.Context, *GetGroupMessageListReq) (*GetGroupMessageListResp, error)
 	// 标记私聊消息为已读
 	MarkAsRead(context.Context, *MarkAsReadReq) (*MarkAsReadResp, error)
@@ -162,10 +527,74 @@ type MessageServer interface {
 	GetUnreadMessages(context.Context, *GetUnreadMessagesReq) (*GetUnreadMessagesResp, error)
 	// 获取大于指定Seq的群聊消息 (用于消息同步)
 	GetGroupMessagesBySeq(context.Context, *GetGroupMessagesBySeqReq) (*GetGroupMessagesBySeqResp, error)
-	// 模糊搜索消息内容
+	// 获取大于指定Seq的私聊消息 (用于消息同步)
+	GetPrivateMessagesBySeq(context.Context, *GetPrivateMessagesBySeqReq) (*GetPrivateMessagesBySeqResp, error)
+	// 搜索聊天记录（全文索引，仅限用户可见的会话）
 	SearchMessage(context.Context, *SearchMessageReq) (*SearchMessageResp, error)
 	// 获取@我的消息列表
 	GetAtMeMessages(context.Context, *GetAtMeMessagesReq) (*GetAtMeMessagesResp, error)
+	// 创建定时消息
+	CreateScheduledMessage(context.Context, *CreateScheduledMessageReq) (*CreateScheduledMessageResp, error)
+	// 修改待发送的定时消息
+	UpdateScheduledMessage(context.Context, *UpdateScheduledMessageReq) (*UpdateScheduledMessageResp, error)
+	// 取消待发送的定时消息
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageReq) (*CancelScheduledMessageResp, error)
+	// 获取定时消息列表
+	ListScheduledMessages(context.Context, *ListScheduledMessagesReq) (*ListScheduledMessagesResp, error)
+	// 创建聊天记录导出任务
+	CreateExportJob(context.Context, *CreateExportJobReq) (*CreateExportJobResp, error)
+	// 获取导出任务详情（已完成时返回下载链接）
+	GetExportJob(context.Context, *GetExportJobReq) (*GetExportJobResp, error)
+	// 获取导出任务列表
+	ListExportJobs(context.Context, *ListExportJobsReq) (*ListExportJobsResp, error)
+	// 设置群消息保留期限（需要设置消息保留期限权限，默认仅群主）
+	SetGroupRetention(context.Context, *SetGroupRetentionReq) (*SetGroupRetentionResp, error)
+	// 获取群消息保留期限
+	GetGroupRetention(context.Context, *GetGroupRetentionReq) (*GetGroupRetentionResp, error)
+	// 获取所有会话的未读数汇总（私聊、群聊及群聊@我）
+	GetUnreadSummary(context.Context, *GetUnreadSummaryReq) (*GetUnreadSummaryResp, error)
+	// 收藏消息（保存内容快照，重复收藏返回已有的收藏）
+	AddFavorite(context.Context, *AddFavoriteReq) (*AddFavoriteResp, error)
+	// 修改收藏的标签和备注
+	UpdateFavorite(context.Context, *UpdateFavoriteReq) (*UpdateFavoriteResp, error)
+	// 删除收藏
+	DeleteFavorite(context.Context, *DeleteFavoriteReq) (*DeleteFavoriteResp, error)
+	// 查询收藏列表（支持关键词、标签、消息类型筛选）
+	ListFavorites(context.Context, *ListFavoritesReq) (*ListFavoritesResp, error)
+	// 获取收藏使用过的标签
+	ListFavoriteTags(context.Context, *ListFavoriteTagsReq) (*ListFavoriteTagsResp, error)
+	// 置顶消息（群聊需要置顶消息权限，私聊双方均可）
+	PinMessage(context.Context, *PinMessageReq) (*PinMessageResp, error)
+	// 取消置顶消息
+	UnpinMessage(context.Context, *UnpinMessageReq) (*UnpinMessageResp, error)
+	// 获取会话的置顶消息列表
+	ListPinnedMessages(context.Context, *ListPinnedMessagesReq) (*ListPinnedMessagesResp, error)
+	// 发起群投票（写入一条投票消息）
+	CreatePoll(context.Context, *CreatePollReq) (*CreatePollResp, error)
+	// 投票（已投过票时覆盖原选择）
+	VotePoll(context.Context, *VotePollReq) (*VotePollResp, error)
+	// 撤回自己的投票
+	RetractPollVote(context.Context, *RetractPollVoteReq) (*RetractPollVoteResp, error)
+	// 获取投票详情和实时计票结果
+	GetPoll(context.Context, *GetPollReq) (*GetPollResp, error)
+	// 发送群话题回复（不进入群主时间线，不累加群未读数）
+	SendThreadReply(context.Context, *SendThreadReplyReq) (*SendThreadReplyResp, error)
+	// 获取话题的回复列表（按话题内 Seq 升序）
+	GetThreadReplies(context.Context, *GetThreadRepliesReq) (*GetThreadRepliesResp, error)
+	// 关注话题（有新回复时收到通知）
+	FollowThread(context.Context, *FollowThreadReq) (*FollowThreadResp, error)
+	// 取消关注话题
+	UnfollowThread(context.Context, *FollowThreadReq) (*FollowThreadResp, error)
+	// 添加群自定义敏感词（需要内容审核权限，已存在时更新处理动作）
+	AddGroupSensitiveWords(context.Context, *GroupSensitiveWordsReq) (*GroupSensitiveWordsResp, error)
+	// 删除群自定义敏感词（需要内容审核权限）
+	RemoveGroupSensitiveWords(context.Context, *GroupSensitiveWordsReq) (*GroupSensitiveWordsResp, error)
+	// 获取群自定义敏感词（需要内容审核权限）
+	ListGroupSensitiveWords(context.Context, *ListGroupSensitiveWordsReq) (*GroupSensitiveWordsResp, error)
+	// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+	ListModerationReviews(context.Context, *ListModerationReviewsReq) (*ListModerationReviewsResp, error)
+	// 处理送审消息：通过或删除消息
+	ReviewModeration(context.Context, *ReviewModerationReq) (*ReviewModerationResp, error)
 	mustEmbedUnimplementedMessageServer()
 }
 
@@ -179,6 +608,9 @@ func (UnimplementedMessageServer) SendMessage(context.Context, *SendMessageReq)
 func (UnimplementedMessageServer) SendGroupMessage(context.Context, *SendGroupMessageReq) (*SendGroupMessageResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendGroupMessage not implemented")
 }
+func (UnimplementedMessageServer) SendGroupSystemMessage(context.Context, *SendGroupSystemMessageReq) (*SendGroupMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGroupSystemMessage not implemented")
+}
 func (UnimplementedMessageServer) GetMessageList(context.Context, *GetMessageListReq) (*GetMessageListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessageList not implemented")
 }
@@ -197,12 +629,108 @@ func (UnimplementedMessageServer) GetUnreadMessages(context.Context, *GetUnreadM
 func (UnimplementedMessageServer) GetGroupMessagesBySeq(context.Context, *GetGroupMessagesBySeqReq) (*GetGroupMessagesBySeqResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMessagesBySeq not implemented")
 }
+func (UnimplementedMessageServer) GetPrivateMessagesBySeq(context.Context, *GetPrivateMessagesBySeqReq) (*GetPrivateMessagesBySeqResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateMessagesBySeq not implemented")
+}
 func (UnimplementedMessageServer) SearchMessage(context.Context, *SearchMessageReq) (*SearchMessageResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchMessage not implemented")
 }
 func (UnimplementedMessageServer) GetAtMeMessages(context.Context, *GetAtMeMessagesReq) (*GetAtMeMessagesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAtMeMessages not implemented")
 }
+func (UnimplementedMessageServer) CreateScheduledMessage(context.Context, *CreateScheduledMessageReq) (*CreateScheduledMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledMessage not implemented")
+}
+func (UnimplementedMessageServer) UpdateScheduledMessage(context.Context, *UpdateScheduledMessageReq) (*UpdateScheduledMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledMessage not implemented")
+}
+func (UnimplementedMessageServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageReq) (*CancelScheduledMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedMessageServer) ListScheduledMessages(context.Context, *ListScheduledMessagesReq) (*ListScheduledMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (UnimplementedMessageServer) CreateExportJob(context.Context, *CreateExportJobReq) (*CreateExportJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExportJob not implemented")
+}
+func (UnimplementedMessageServer) GetExportJob(context.Context, *GetExportJobReq) (*GetExportJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportJob not implemented")
+}
+func (UnimplementedMessageServer) ListExportJobs(context.Context, *ListExportJobsReq) (*ListExportJobsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportJobs not implemented")
+}
+func (UnimplementedMessageServer) SetGroupRetention(context.Context, *SetGroupRetentionReq) (*SetGroupRetentionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupRetention not implemented")
+}
+func (UnimplementedMessageServer) GetGroupRetention(context.Context, *GetGroupRetentionReq) (*GetGroupRetentionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupRetention not implemented")
+}
+func (UnimplementedMessageServer) GetUnreadSummary(context.Context, *GetUnreadSummaryReq) (*GetUnreadSummaryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadSummary not implemented")
+}
+func (UnimplementedMessageServer) AddFavorite(context.Context, *AddFavoriteReq) (*AddFavoriteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedMessageServer) UpdateFavorite(context.Context, *UpdateFavoriteReq) (*UpdateFavoriteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFavorite not implemented")
+}
+func (UnimplementedMessageServer) DeleteFavorite(context.Context, *DeleteFavoriteReq) (*DeleteFavoriteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavorite not implemented")
+}
+func (UnimplementedMessageServer) ListFavorites(context.Context, *ListFavoritesReq) (*ListFavoritesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedMessageServer) ListFavoriteTags(context.Context, *ListFavoriteTagsReq) (*ListFavoriteTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavoriteTags not implemented")
+}
+func (UnimplementedMessageServer) PinMessage(context.Context, *PinMessageReq) (*PinMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedMessageServer) UnpinMessage(context.Context, *UnpinMessageReq) (*UnpinMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedMessageServer) ListPinnedMessages(context.Context, *ListPinnedMessagesReq) (*ListPinnedMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedMessageServer) CreatePoll(context.Context, *CreatePollReq) (*CreatePollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoll not implemented")
+}
+func (UnimplementedMessageServer) VotePoll(context.Context, *VotePollReq) (*VotePollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedMessageServer) RetractPollVote(context.Context, *RetractPollVoteReq) (*RetractPollVoteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractPollVote not implemented")
+}
+func (UnimplementedMessageServer) GetPoll(context.Context, *GetPollReq) (*GetPollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoll not implemented")
+}
+func (UnimplementedMessageServer) SendThreadReply(context.Context, *SendThreadReplyReq) (*SendThreadReplyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendThreadReply not implemented")
+}
+func (UnimplementedMessageServer) GetThreadReplies(context.Context, *GetThreadRepliesReq) (*GetThreadRepliesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadReplies not implemented")
+}
+func (UnimplementedMessageServer) FollowThread(context.Context, *FollowThreadReq) (*FollowThreadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowThread not implemented")
+}
+func (UnimplementedMessageServer) UnfollowThread(context.Context, *FollowThreadReq) (*FollowThreadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowThread not implemented")
+}
+func (UnimplementedMessageServer) AddGroupSensitiveWords(context.Context, *GroupSensitiveWordsReq) (*GroupSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupSensitiveWords not implemented")
+}
+func (UnimplementedMessageServer) RemoveGroupSensitiveWords(context.Context, *GroupSensitiveWordsReq) (*GroupSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupSensitiveWords not implemented")
+}
+func (UnimplementedMessageServer) ListGroupSensitiveWords(context.Context, *ListGroupSensitiveWordsReq) (*GroupSensitiveWordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupSensitiveWords not implemented")
+}
+func (UnimplementedMessageServer) ListModerationReviews(context.Context, *ListModerationReviewsReq) (*ListModerationReviewsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationReviews not implemented")
+}
+func (UnimplementedMessageServer) ReviewModeration(context.Context, *ReviewModerationReq) (*ReviewModerationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewModeration not implemented")
+}
 func (UnimplementedMessageServer) mustEmbedUnimplementedMessageServer() {}
 
 // UnsafeMessageServer may be embedded to opt out of forward compatibility for this service.
@@ -252,6 +780,24 @@ func _Message_SendGroupMessage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Message_SendGroupSystemMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGroupSystemMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SendGroupSystemMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/SendGroupSystemMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SendGroupSystemMessage(ctx, req.(*SendGroupSystemMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Message_GetMessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMessageListReq)
 	if err := dec(in); err != nil {
@@ -360,31 +906,49 @@ func _Message_GetGroupMessagesBySeq_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Message_SearchMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchMessageReq)
+func _Message_GetPrivateMessagesBySeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivateMessagesBySeqReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessageServer).SearchMessage(ctx, in)
+		return srv.(MessageServer).GetPrivateMessagesBySeq(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/message.Message/SearchMessage",
+		FullMethod: "/message.Message/GetPrivateMessagesBySeq",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessageServer).SearchMessage(ctx, req.(*SearchMessageReq))
+		return srv.(MessageServer).GetPrivateMessagesBySeq(ctx, req.(*GetPrivateMessagesBySeqReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Message_GetAtMeMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAtMeMessagesReq)
+func _Message_SearchMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessageReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessageServer).GetAtMeMessages(ctx, in)
+		return srv.(MessageServer).SearchMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/SearchMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SearchMessage(ctx, req.(*SearchMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetAtMeMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAtMeMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetAtMeMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -396,6 +960,564 @@ func _Message_GetAtMeMessages_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Message_CreateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CreateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/CreateScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CreateScheduledMessage(ctx, req.(*CreateScheduledMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_UpdateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).UpdateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/UpdateScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).UpdateScheduledMessage(ctx, req.(*UpdateScheduledMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/CancelScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CreateExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CreateExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/CreateExportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CreateExportJob(ctx, req.(*CreateExportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/GetExportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetExportJob(ctx, req.(*GetExportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListExportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportJobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListExportJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListExportJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListExportJobs(ctx, req.(*ListExportJobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_SetGroupRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupRetentionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SetGroupRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/SetGroupRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SetGroupRetention(ctx, req.(*SetGroupRetentionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetGroupRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRetentionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetGroupRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/GetGroupRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetGroupRetention(ctx, req.(*GetGroupRetentionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetUnreadSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadSummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetUnreadSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/GetUnreadSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetUnreadSummary(ctx, req.(*GetUnreadSummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/AddFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).AddFavorite(ctx, req.(*AddFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_UpdateFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).UpdateFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/UpdateFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).UpdateFavorite(ctx, req.(*UpdateFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_DeleteFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).DeleteFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/DeleteFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).DeleteFavorite(ctx, req.(*DeleteFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListFavorites(ctx, req.(*ListFavoritesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListFavoriteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoriteTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListFavoriteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListFavoriteTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListFavoriteTags(ctx, req.(*ListFavoriteTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/PinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).PinMessage(ctx, req.(*PinMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/UnpinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).UnpinMessage(ctx, req.(*UnpinMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListPinnedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_CreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).CreatePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/CreatePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).CreatePoll(ctx, req.(*CreatePollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/VotePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).VotePoll(ctx, req.(*VotePollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_RetractPollVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractPollVoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).RetractPollVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/RetractPollVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).RetractPollVote(ctx, req.(*RetractPollVoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/GetPoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetPoll(ctx, req.(*GetPollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_SendThreadReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendThreadReplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SendThreadReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/SendThreadReply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SendThreadReply(ctx, req.(*SendThreadReplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetThreadReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRepliesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetThreadReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/GetThreadReplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetThreadReplies(ctx, req.(*GetThreadRepliesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_FollowThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowThreadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).FollowThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/FollowThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).FollowThread(ctx, req.(*FollowThreadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_UnfollowThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowThreadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).UnfollowThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/UnfollowThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).UnfollowThread(ctx, req.(*FollowThreadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_AddGroupSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).AddGroupSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/AddGroupSensitiveWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).AddGroupSensitiveWords(ctx, req.(*GroupSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_RemoveGroupSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).RemoveGroupSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/RemoveGroupSensitiveWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).RemoveGroupSensitiveWords(ctx, req.(*GroupSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListGroupSensitiveWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupSensitiveWordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListGroupSensitiveWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListGroupSensitiveWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListGroupSensitiveWords(ctx, req.(*ListGroupSensitiveWordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ListModerationReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ListModerationReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ListModerationReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ListModerationReviews(ctx, req.(*ListModerationReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ReviewModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewModerationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ReviewModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.Message/ReviewModeration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ReviewModeration(ctx, req.(*ReviewModerationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Message_ServiceDesc is the grpc.ServiceDesc for Message service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +1533,10 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendGroupMessage",
 			Handler:    _Message_SendGroupMessage_Handler,
 		},
+		{
+			MethodName: "SendGroupSystemMessage",
+			Handler:    _Message_SendGroupSystemMessage_Handler,
+		},
 		{
 			MethodName: "GetMessageList",
 			Handler:    _Message_GetMessageList_Handler,
@@ -435,6 +1561,10 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupMessagesBySeq",
 			Handler:    _Message_GetGroupMessagesBySeq_Handler,
 		},
+		{
+			MethodName: "GetPrivateMessagesBySeq",
+			Handler:    _Message_GetPrivateMessagesBySeq_Handler,
+		},
 		{
 			MethodName: "SearchMessage",
 			Handler:    _Message_SearchMessage_Handler,
@@ -443,6 +1573,130 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAtMeMessages",
 			Handler:    _Message_GetAtMeMessages_Handler,
 		},
+		{
+			MethodName: "CreateScheduledMessage",
+			Handler:    _Message_CreateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledMessage",
+			Handler:    _Message_UpdateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _Message_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _Message_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CreateExportJob",
+			Handler:    _Message_CreateExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJob",
+			Handler:    _Message_GetExportJob_Handler,
+		},
+		{
+			MethodName: "ListExportJobs",
+			Handler:    _Message_ListExportJobs_Handler,
+		},
+		{
+			MethodName: "SetGroupRetention",
+			Handler:    _Message_SetGroupRetention_Handler,
+		},
+		{
+			MethodName: "GetGroupRetention",
+			Handler:    _Message_GetGroupRetention_Handler,
+		},
+		{
+			MethodName: "GetUnreadSummary",
+			Handler:    _Message_GetUnreadSummary_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _Message_AddFavorite_Handler,
+		},
+		{
+			MethodName: "UpdateFavorite",
+			Handler:    _Message_UpdateFavorite_Handler,
+		},
+		{
+			MethodName: "DeleteFavorite",
+			Handler:    _Message_DeleteFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _Message_ListFavorites_Handler,
+		},
+		{
+			MethodName: "ListFavoriteTags",
+			Handler:    _Message_ListFavoriteTags_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _Message_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _Message_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _Message_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "CreatePoll",
+			Handler:    _Message_CreatePoll_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _Message_VotePoll_Handler,
+		},
+		{
+			MethodName: "RetractPollVote",
+			Handler:    _Message_RetractPollVote_Handler,
+		},
+		{
+			MethodName: "GetPoll",
+			Handler:    _Message_GetPoll_Handler,
+		},
+		{
+			MethodName: "SendThreadReply",
+			Handler:    _Message_SendThreadReply_Handler,
+		},
+		{
+			MethodName: "GetThreadReplies",
+			Handler:    _Message_GetThreadReplies_Handler,
+		},
+		{
+			MethodName: "FollowThread",
+			Handler:    _Message_FollowThread_Handler,
+		},
+		{
+			MethodName: "UnfollowThread",
+			Handler:    _Message_UnfollowThread_Handler,
+		},
+		{
+			MethodName: "AddGroupSensitiveWords",
+			Handler:    _Message_AddGroupSensitiveWords_Handler,
+		},
+		{
+			MethodName: "RemoveGroupSensitiveWords",
+			Handler:    _Message_RemoveGroupSensitiveWords_Handler,
+		},
+		{
+			MethodName: "ListGroupSensitiveWords",
+			Handler:    _Message_ListGroupSensitiveWords_Handler,
+		},
+		{
+			MethodName: "ListModerationReviews",
+			Handler:    _Message_ListModerationReviews_Handler,
+		},
+		{
+			MethodName: "ReviewModeration",
+			Handler:    _Message_ReviewModeration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message.proto",
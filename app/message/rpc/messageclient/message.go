@@ -14,36 +14,122 @@ import (
 )
 
 type (
-	GetAtMeMessagesReq        = message.GetAtMeMessagesReq
-	GetAtMeMessagesResp       = message.GetAtMeMessagesResp
-	GetGroupMessageListReq    = message.GetGroupMessageListReq
-	GetGroupMessageListResp   = message.GetGroupMessageListResp
-	GetGroupMessagesBySeqReq  = message.GetGroupMessagesBySeqReq
-	GetGroupMessagesBySeqResp = message.GetGroupMessagesBySeqResp
-	GetMessageListReq         = message.GetMessageListReq
-	GetMessageListResp        = message.GetMessageListResp
-	GetUnreadCountReq         = message.GetUnreadCountReq
-	GetUnreadCountResp        = message.GetUnreadCountResp
-	GetUnreadMessagesReq      = message.GetUnreadMessagesReq
-	GetUnreadMessagesResp     = message.GetUnreadMessagesResp
-	MarkAsReadReq             = message.MarkAsReadReq
-	MarkAsReadResp            = message.MarkAsReadResp
-	MessageInfo               = message.MessageInfo
-	SearchMessageReq          = message.SearchMessageReq
-	SearchMessageResp         = message.SearchMessageResp
-	SendGroupMessageReq       = message.SendGroupMessageReq
-	SendGroupMessageResp      = message.SendGroupMessageResp
-	SendMessageReq            = message.SendMessageReq
-	SendMessageResp           = message.SendMessageResp
+	AddFavoriteReq              = message.AddFavoriteReq
+	AddFavoriteResp             = message.AddFavoriteResp
+	CancelScheduledMessageReq   = message.CancelScheduledMessageReq
+	CancelScheduledMessageResp  = message.CancelScheduledMessageResp
+	ContactPayload              = message.ContactPayload
+	ConversationUnread          = message.ConversationUnread
+	CreateExportJobReq          = message.CreateExportJobReq
+	CreateExportJobResp         = message.CreateExportJobResp
+	CreatePollReq               = message.CreatePollReq
+	CreatePollResp              = message.CreatePollResp
+	CreateScheduledMessageReq   = message.CreateScheduledMessageReq
+	CreateScheduledMessageResp  = message.CreateScheduledMessageResp
+	DeleteFavoriteReq           = message.DeleteFavoriteReq
+	DeleteFavoriteResp          = message.DeleteFavoriteResp
+	ExportJobInfo               = message.ExportJobInfo
+	FavoriteInfo                = message.FavoriteInfo
+	FavoriteTag                 = message.FavoriteTag
+	FilePayload                 = message.FilePayload
+	FollowThreadReq             = message.FollowThreadReq
+	FollowThreadResp            = message.FollowThreadResp
+	GetAtMeMessagesReq          = message.GetAtMeMessagesReq
+	GetAtMeMessagesResp         = message.GetAtMeMessagesResp
+	GetExportJobReq             = message.GetExportJobReq
+	GetExportJobResp            = message.GetExportJobResp
+	GetGroupMessageListReq      = message.GetGroupMessageListReq
+	GetGroupMessageListResp     = message.GetGroupMessageListResp
+	GetGroupMessagesBySeqReq    = message.GetGroupMessagesBySeqReq
+	GetGroupMessagesBySeqResp   = message.GetGroupMessagesBySeqResp
+	GetGroupRetentionReq        = message.GetGroupRetentionReq
+	GetGroupRetentionResp       = message.GetGroupRetentionResp
+	GetMessageListReq           = message.GetMessageListReq
+	GetMessageListResp          = message.GetMessageListResp
+	GetPollReq                  = message.GetPollReq
+	GetPollResp                 = message.GetPollResp
+	GetPrivateMessagesBySeqReq  = message.GetPrivateMessagesBySeqReq
+	GetPrivateMessagesBySeqResp = message.GetPrivateMessagesBySeqResp
+	GetThreadRepliesReq         = message.GetThreadRepliesReq
+	GetThreadRepliesResp        = message.GetThreadRepliesResp
+	GetUnreadCountReq           = message.GetUnreadCountReq
+	GetUnreadCountResp          = message.GetUnreadCountResp
+	GetUnreadMessagesReq        = message.GetUnreadMessagesReq
+	GetUnreadMessagesResp       = message.GetUnreadMessagesResp
+	GetUnreadSummaryReq         = message.GetUnreadSummaryReq
+	GetUnreadSummaryResp        = message.GetUnreadSummaryResp
+	GroupRetentionInfo          = message.GroupRetentionInfo
+	GroupSensitiveWordsReq      = message.GroupSensitiveWordsReq
+	GroupSensitiveWordsResp     = message.GroupSensitiveWordsResp
+	ImagePayload                = message.ImagePayload
+	LinkPreview                 = message.LinkPreview
+	ListExportJobsReq           = message.ListExportJobsReq
+	ListExportJobsResp          = message.ListExportJobsResp
+	ListFavoriteTagsReq         = message.ListFavoriteTagsReq
+	ListFavoriteTagsResp        = message.ListFavoriteTagsResp
+	ListFavoritesReq            = message.ListFavoritesReq
+	ListFavoritesResp           = message.ListFavoritesResp
+	ListGroupSensitiveWordsReq  = message.ListGroupSensitiveWordsReq
+	ListModerationReviewsReq    = message.ListModerationReviewsReq
+	ListModerationReviewsResp   = message.ListModerationReviewsResp
+	ListPinnedMessagesReq       = message.ListPinnedMessagesReq
+	ListPinnedMessagesResp      = message.ListPinnedMessagesResp
+	ListScheduledMessagesReq    = message.ListScheduledMessagesReq
+	ListScheduledMessagesResp   = message.ListScheduledMessagesResp
+	LocationPayload             = message.LocationPayload
+	MarkAsReadReq               = message.MarkAsReadReq
+	MarkAsReadResp              = message.MarkAsReadResp
+	MessageInfo                 = message.MessageInfo
+	MessagePayload              = message.MessagePayload
+	ModerationReviewInfo        = message.ModerationReviewInfo
+	PinMessageReq               = message.PinMessageReq
+	PinMessageResp              = message.PinMessageResp
+	PinnedMessageInfo           = message.PinnedMessageInfo
+	PollInfo                    = message.PollInfo
+	PollOption                  = message.PollOption
+	PollPayload                 = message.PollPayload
+	RetractPollVoteReq          = message.RetractPollVoteReq
+	RetractPollVoteResp         = message.RetractPollVoteResp
+	ReviewModerationReq         = message.ReviewModerationReq
+	ReviewModerationResp        = message.ReviewModerationResp
+	ScheduledMessageInfo        = message.ScheduledMessageInfo
+	SearchHit                   = message.SearchHit
+	SearchMessageReq            = message.SearchMessageReq
+	SearchMessageResp           = message.SearchMessageResp
+	SendGroupMessageReq         = message.SendGroupMessageReq
+	SendGroupMessageResp        = message.SendGroupMessageResp
+	SendGroupSystemMessageReq   = message.SendGroupSystemMessageReq
+	SendMessageReq              = message.SendMessageReq
+	SendMessageResp             = message.SendMessageResp
+	SendThreadReplyReq          = message.SendThreadReplyReq
+	SendThreadReplyResp         = message.SendThreadReplyResp
+	SensitiveWordInfo           = message.SensitiveWordInfo
+	SetGroupRetentionReq        = message.SetGroupRetentionReq
+	SetGroupRetentionResp       = message.SetGroupRetentionResp
+	SystemPayload               = message.SystemPayload
+	ThreadReplyInfo             = message.ThreadReplyInfo
+	ThreadSummary               = message.ThreadSummary
+	UnpinMessageReq             = message.UnpinMessageReq
+	UnpinMessageResp            = message.UnpinMessageResp
+	UpdateFavoriteReq           = message.UpdateFavoriteReq
+	UpdateFavoriteResp          = message.UpdateFavoriteResp
+	UpdateScheduledMessageReq   = message.UpdateScheduledMessageReq
+	UpdateScheduledMessageResp  = message.UpdateScheduledMessageResp
+	VideoPayload                = message.VideoPayload
+	VoicePayload                = message.VoicePayload
+	VotePollReq                 = message.VotePollReq
+	VotePollResp                = message.VotePollResp
 
 	Message interface {
 		// 发送私聊消息（存储到数据库）
 		SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error)
 		// 发送群聊消息
 		SendGroupMessage(ctx context.Context, in *SendGroupMessageReq, opts ...grpc.CallOption) (*SendGroupMessageResp, error)
-		// 获取私聊历史消息列表（分页）
+		// 写入群系统消息（成员变动等事件，供内部服务调用）
+		SendGroupSystemMessage(ctx context.Context, in *SendGroupSystemMessageReq, opts ...grpc.CallOption) (*SendGroupMessageResp, error)
+		// 获取私聊历史消息列表（分页，支持按消息或日期定位）
 		GetMessageList(ctx context.Context, in *GetMessageListReq, opts ...grpc.CallOption) (*GetMessageListResp, error)
-		// 获取群聊历史消息列表（分页）
+		// 获取群聊历史消息列表（分页，支持按消息、Seq或日期定位）
 		GetGroupMessageList(ctx context.Context, in *GetGroupMessageListReq, opts ...grpc.CallOption) (*GetGroupMessageListResp, error)
 		// 标记私聊消息为已读
 		MarkAsRead(ctx context.Context, in *MarkAsReadReq, opts ...grpc.CallOption) (*MarkAsReadResp, error)
@@ -53,10 +139,74 @@ type (
 		GetUnreadMessages(ctx context.Context, in *GetUnreadMessagesReq, opts ...grpc.CallOption) (*GetUnreadMessagesResp, error)
 		// 获取大于指定Seq的群聊消息 (用于消息同步)
 		GetGroupMessagesBySeq(ctx context.Context, in *GetGroupMessagesBySeqReq, opts ...grpc.CallOption) (*GetGroupMessagesBySeqResp, error)
-		// 模糊搜索消息内容
+		// 获取大于指定Seq的私聊消息 (用于消息同步)
+		GetPrivateMessagesBySeq(ctx context.Context, in *GetPrivateMessagesBySeqReq, opts ...grpc.CallOption) (*GetPrivateMessagesBySeqResp, error)
+		// 搜索聊天记录（全文索引，仅限用户可见的会话）
 		SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error)
 		// 获取@我的消息列表
 		GetAtMeMessages(ctx context.Context, in *GetAtMeMessagesReq, opts ...grpc.CallOption) (*GetAtMeMessagesResp, error)
+		// 创建定时消息
+		CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageReq, opts ...grpc.CallOption) (*CreateScheduledMessageResp, error)
+		// 修改待发送的定时消息
+		UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageReq, opts ...grpc.CallOption) (*UpdateScheduledMessageResp, error)
+		// 取消待发送的定时消息
+		CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*CancelScheduledMessageResp, error)
+		// 获取定时消息列表
+		ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesReq, opts ...grpc.CallOption) (*ListScheduledMessagesResp, error)
+		// 创建聊天记录导出任务
+		CreateExportJob(ctx context.Context, in *CreateExportJobReq, opts ...grpc.CallOption) (*CreateExportJobResp, error)
+		// 获取导出任务详情（已完成时返回下载链接）
+		GetExportJob(ctx context.Context, in *GetExportJobReq, opts ...grpc.CallOption) (*GetExportJobResp, error)
+		// 获取导出任务列表
+		ListExportJobs(ctx context.Context, in *ListExportJobsReq, opts ...grpc.CallOption) (*ListExportJobsResp, error)
+		// 设置群消息保留期限（需要设置消息保留期限权限，默认仅群主）
+		SetGroupRetention(ctx context.Context, in *SetGroupRetentionReq, opts ...grpc.CallOption) (*SetGroupRetentionResp, error)
+		// 获取群消息保留期限
+		GetGroupRetention(ctx context.Context, in *GetGroupRetentionReq, opts ...grpc.CallOption) (*GetGroupRetentionResp, error)
+		// 获取所有会话的未读数汇总（私聊、群聊及群聊@我）
+		GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error)
+		// 收藏消息（保存内容快照，重复收藏返回已有的收藏）
+		AddFavorite(ctx context.Context, in *AddFavoriteReq, opts ...grpc.CallOption) (*AddFavoriteResp, error)
+		// 修改收藏的标签和备注
+		UpdateFavorite(ctx context.Context, in *UpdateFavoriteReq, opts ...grpc.CallOption) (*UpdateFavoriteResp, error)
+		// 删除收藏
+		DeleteFavorite(ctx context.Context, in *DeleteFavoriteReq, opts ...grpc.CallOption) (*DeleteFavoriteResp, error)
+		// 查询收藏列表（支持关键词、标签、消息类型筛选）
+		ListFavorites(ctx context.Context, in *ListFavoritesReq, opts ...grpc.CallOption) (*ListFavoritesResp, error)
+		// 获取收藏使用过的标签
+		ListFavoriteTags(ctx context.Context, in *ListFavoriteTagsReq, opts ...grpc.CallOption) (*ListFavoriteTagsResp, error)
+		// 置顶消息（群聊需要置顶消息权限，私聊双方均可）
+		PinMessage(ctx context.Context, in *PinMessageReq, opts ...grpc.CallOption) (*PinMessageResp, error)
+		// 取消置顶消息
+		UnpinMessage(ctx context.Context, in *UnpinMessageReq, opts ...grpc.CallOption) (*UnpinMessageResp, error)
+		// 获取会话的置顶消息列表
+		ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesReq, opts ...grpc.CallOption) (*ListPinnedMessagesResp, error)
+		// 发起群投票（写入一条投票消息）
+		CreatePoll(ctx context.Context, in *CreatePollReq, opts ...grpc.CallOption) (*CreatePollResp, error)
+		// 投票（已投过票时覆盖原选择）
+		VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error)
+		// 撤回自己的投票
+		RetractPollVote(ctx context.Context, in *RetractPollVoteReq, opts ...grpc.CallOption) (*RetractPollVoteResp, error)
+		// 获取投票详情和实时计票结果
+		GetPoll(ctx context.Context, in *GetPollReq, opts ...grpc.CallOption) (*GetPollResp, error)
+		// 发送群话题回复（不进入群主时间线，不累加群未读数）
+		SendThreadReply(ctx context.Context, in *SendThreadReplyReq, opts ...grpc.CallOption) (*SendThreadReplyResp, error)
+		// 获取话题的回复列表（按话题内 Seq 升序）
+		GetThreadReplies(ctx context.Context, in *GetThreadRepliesReq, opts ...grpc.CallOption) (*GetThreadRepliesResp, error)
+		// 关注话题（有新回复时收到通知）
+		FollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error)
+		// 取消关注话题
+		UnfollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error)
+		// 添加群自定义敏感词（需要内容审核权限，已存在时更新处理动作）
+		AddGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error)
+		// 删除群自定义敏感词（需要内容审核权限）
+		RemoveGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error)
+		// 获取群自定义敏感词（需要内容审核权限）
+		ListGroupSensitiveWords(ctx context.Context, in *ListGroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error)
+		// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+		ListModerationReviews(ctx context.Context, in *ListModerationReviewsReq, opts ...grpc.CallOption) (*ListModerationReviewsResp, error)
+		// 处理送审消息：通过或删除消息
+		ReviewModeration(ctx context.Context, in *ReviewModerationReq, opts ...grpc.CallOption) (*ReviewModerationResp, error)
 	}
 
 	defaultMessage struct {
@@ -82,13 +232,19 @@ func (m *defaultMessage) SendGroupMessage(ctx context.Context, in *SendGroupMess
 	return client.SendGroupMessage(ctx, in, opts...)
 }
 
-// 获取私聊历史消息列表（分页）
+// 写入群系统消息（成员变动等事件，供内部服务调用）
+func (m *defaultMessage) SendGroupSystemMessage(ctx context.Context, in *SendGroupSystemMessageReq, opts ...grpc.CallOption) (*SendGroupMessageResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.SendGroupSystemMessage(ctx, in, opts...)
+}
+
+// 获取私聊历史消息列表（分页，支持按消息或日期定位）
 func (m *defaultMessage) GetMessageList(ctx context.Context, in *GetMessageListReq, opts ...grpc.CallOption) (*GetMessageListResp, error) {
 	client := message.NewMessageClient(m.cli.Conn())
 	return client.GetMessageList(ctx, in, opts...)
 }
 
-// 获取群聊历史消息列表（分页）
+// 获取群聊历史消息列表（分页，支持按消息、Seq或日期定位）
 func (m *defaultMessage) GetGroupMessageList(ctx context.Context, in *GetGroupMessageListReq, opts ...grpc.CallOption) (*GetGroupMessageListResp, error) {
 	client := message.NewMessageClient(m.cli.Conn())
 	return client.GetGroupMessageList(ctx, in, opts...)
@@ -118,7 +274,13 @@ func (m *defaultMessage) GetGroupMessagesBySeq(ctx context.Context, in *GetGroup
 	return client.GetGroupMessagesBySeq(ctx, in, opts...)
 }
 
-// 模糊搜索消息内容
+// 获取大于指定Seq的私聊消息 (用于消息同步)
+func (m *defaultMessage) GetPrivateMessagesBySeq(ctx context.Context, in *GetPrivateMessagesBySeqReq, opts ...grpc.CallOption) (*GetPrivateMessagesBySeqResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.GetPrivateMessagesBySeq(ctx, in, opts...)
+}
+
+// 搜索聊天记录（全文索引，仅限用户可见的会话）
 func (m *defaultMessage) SearchMessage(ctx context.Context, in *SearchMessageReq, opts ...grpc.CallOption) (*SearchMessageResp, error) {
 	client := message.NewMessageClient(m.cli.Conn())
 	return client.SearchMessage(ctx, in, opts...)
@@ -129,3 +291,189 @@ func (m *defaultMessage) GetAtMeMessages(ctx context.Context, in *GetAtMeMessage
 	client := message.NewMessageClient(m.cli.Conn())
 	return client.GetAtMeMessages(ctx, in, opts...)
 }
+
+// 创建定时消息
+func (m *defaultMessage) CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageReq, opts ...grpc.CallOption) (*CreateScheduledMessageResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.CreateScheduledMessage(ctx, in, opts...)
+}
+
+// 修改待发送的定时消息
+func (m *defaultMessage) UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageReq, opts ...grpc.CallOption) (*UpdateScheduledMessageResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.UpdateScheduledMessage(ctx, in, opts...)
+}
+
+// 取消待发送的定时消息
+func (m *defaultMessage) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*CancelScheduledMessageResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.CancelScheduledMessage(ctx, in, opts...)
+}
+
+// 获取定时消息列表
+func (m *defaultMessage) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesReq, opts ...grpc.CallOption) (*ListScheduledMessagesResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListScheduledMessages(ctx, in, opts...)
+}
+
+// 创建聊天记录导出任务
+func (m *defaultMessage) CreateExportJob(ctx context.Context, in *CreateExportJobReq, opts ...grpc.CallOption) (*CreateExportJobResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.CreateExportJob(ctx, in, opts...)
+}
+
+// 获取导出任务详情（已完成时返回下载链接）
+func (m *defaultMessage) GetExportJob(ctx context.Context, in *GetExportJobReq, opts ...grpc.CallOption) (*GetExportJobResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.GetExportJob(ctx, in, opts...)
+}
+
+// 获取导出任务列表
+func (m *defaultMessage) ListExportJobs(ctx context.Context, in *ListExportJobsReq, opts ...grpc.CallOption) (*ListExportJobsResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListExportJobs(ctx, in, opts...)
+}
+
+// 设置群消息保留期限（需要设置消息保留期限权限，默认仅群主）
+func (m *defaultMessage) SetGroupRetention(ctx context.Context, in *SetGroupRetentionReq, opts ...grpc.CallOption) (*SetGroupRetentionResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.SetGroupRetention(ctx, in, opts...)
+}
+
+// 获取群消息保留期限
+func (m *defaultMessage) GetGroupRetention(ctx context.Context, in *GetGroupRetentionReq, opts ...grpc.CallOption) (*GetGroupRetentionResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.GetGroupRetention(ctx, in, opts...)
+}
+
+// 获取所有会话的未读数汇总（私聊、群聊及群聊@我）
+func (m *defaultMessage) GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.GetUnreadSummary(ctx, in, opts...)
+}
+
+// 收藏消息（保存内容快照，重复收藏返回已有的收藏）
+func (m *defaultMessage) AddFavorite(ctx context.Context, in *AddFavoriteReq, opts ...grpc.CallOption) (*AddFavoriteResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.AddFavorite(ctx, in, opts...)
+}
+
+// 修改收藏的标签和备注
+func (m *defaultMessage) UpdateFavorite(ctx context.Context, in *UpdateFavoriteReq, opts ...grpc.CallOption) (*UpdateFavoriteResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.UpdateFavorite(ctx, in, opts...)
+}
+
+// 删除收藏
+func (m *defaultMessage) DeleteFavorite(ctx context.Context, in *DeleteFavoriteReq, opts ...grpc.CallOption) (*DeleteFavoriteResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.DeleteFavorite(ctx, in, opts...)
+}
+
+// 查询收藏列表（支持关键词、标签、消息类型筛选）
+func (m *defaultMessage) ListFavorites(ctx context.Context, in *ListFavoritesReq, opts ...grpc.CallOption) (*ListFavoritesResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListFavorites(ctx, in, opts...)
+}
+
+// 获取收藏使用过的标签
+func (m *defaultMessage) ListFavoriteTags(ctx context.Context, in *ListFavoriteTagsReq, opts ...grpc.CallOption) (*ListFavoriteTagsResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListFavoriteTags(ctx, in, opts...)
+}
+
+// 置顶消息（群聊需要置顶消息权限，私聊双方均可）
+func (m *defaultMessage) PinMessage(ctx context.Context, in *PinMessageReq, opts ...grpc.CallOption) (*PinMessageResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.PinMessage(ctx, in, opts...)
+}
+
+// 取消置顶消息
+func (m *defaultMessage) UnpinMessage(ctx context.Context, in *UnpinMessageReq, opts ...grpc.CallOption) (*UnpinMessageResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.UnpinMessage(ctx, in, opts...)
+}
+
+// 获取会话的置顶消息列表
+func (m *defaultMessage) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesReq, opts ...grpc.CallOption) (*ListPinnedMessagesResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListPinnedMessages(ctx, in, opts...)
+}
+
+// 发起群投票（写入一条投票消息）
+func (m *defaultMessage) CreatePoll(ctx context.Context, in *CreatePollReq, opts ...grpc.CallOption) (*CreatePollResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.CreatePoll(ctx, in, opts...)
+}
+
+// 投票（已投过票时覆盖原选择）
+func (m *defaultMessage) VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.VotePoll(ctx, in, opts...)
+}
+
+// 撤回自己的投票
+func (m *defaultMessage) RetractPollVote(ctx context.Context, in *RetractPollVoteReq, opts ...grpc.CallOption) (*RetractPollVoteResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.RetractPollVote(ctx, in, opts...)
+}
+
+// 获取投票详情和实时计票结果
+func (m *defaultMessage) GetPoll(ctx context.Context, in *GetPollReq, opts ...grpc.CallOption) (*GetPollResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.GetPoll(ctx, in, opts...)
+}
+
+// 发送群话题回复（不进入群主时间线，不累加群未读数）
+func (m *defaultMessage) SendThreadReply(ctx context.Context, in *SendThreadReplyReq, opts ...grpc.CallOption) (*SendThreadReplyResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.SendThreadReply(ctx, in, opts...)
+}
+
+// 获取话题的回复列表（按话题内 Seq 升序）
+func (m *defaultMessage) GetThreadReplies(ctx context.Context, in *GetThreadRepliesReq, opts ...grpc.CallOption) (*GetThreadRepliesResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.GetThreadReplies(ctx, in, opts...)
+}
+
+// 关注话题（有新回复时收到通知）
+func (m *defaultMessage) FollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.FollowThread(ctx, in, opts...)
+}
+
+// 取消关注话题
+func (m *defaultMessage) UnfollowThread(ctx context.Context, in *FollowThreadReq, opts ...grpc.CallOption) (*FollowThreadResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.UnfollowThread(ctx, in, opts...)
+}
+
+// 添加群自定义敏感词（需要内容审核权限，已存在时更新处理动作）
+func (m *defaultMessage) AddGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.AddGroupSensitiveWords(ctx, in, opts...)
+}
+
+// 删除群自定义敏感词（需要内容审核权限）
+func (m *defaultMessage) RemoveGroupSensitiveWords(ctx context.Context, in *GroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.RemoveGroupSensitiveWords(ctx, in, opts...)
+}
+
+// 获取群自定义敏感词（需要内容审核权限）
+func (m *defaultMessage) ListGroupSensitiveWords(ctx context.Context, in *ListGroupSensitiveWordsReq, opts ...grpc.CallOption) (*GroupSensitiveWordsResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListGroupSensitiveWords(ctx, in, opts...)
+}
+
+// 获取送审消息（有内容审核权限的成员查看本群，平台审核人员可查看全部）
+func (m *defaultMessage) ListModerationReviews(ctx context.Context, in *ListModerationReviewsReq, opts ...grpc.CallOption) (*ListModerationReviewsResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ListModerationReviews(ctx, in, opts...)
+}
+
+// 处理送审消息：通过或删除消息
+func (m *defaultMessage) ReviewModeration(ctx context.Context, in *ReviewModerationReq, opts ...grpc.CallOption) (*ReviewModerationResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.ReviewModeration(ctx, in, opts...)
+}
@@ -0,0 +1,407 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"SkyeIM/app/message/model"
+
+	"github.com/zeromicro/go-zero/core/stores/builder"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	// stagingSuffix 暂存表后缀
+	stagingSuffix = "_new"
+	// oldSuffix 替换后原分片表的后缀
+	oldSuffix = "_old"
+	// upsertChunk 单条 insert 语句写入的最大行数（避免超过占位符数量上限）
+	upsertChunk = 500
+	// sampleLimit 校验报告中每类问题最多列出的消息ID
+	sampleLimit = 10
+)
+
+var (
+	messageFields = builder.RawFieldNames(&model.ImMessage{})
+	messageRows   = strings.Join(messageFields, ",")
+)
+
+type (
+	table struct {
+		conn sqlx.SqlConn
+		name string
+	}
+
+	resharder struct {
+		primary     sqlx.SqlConn
+		sourceConns []sqlx.SqlConn
+		targetConns []sqlx.SqlConn
+		from        int
+		to          int
+		batch       int64
+		fromId      uint64
+	}
+
+	// report 校验结果
+	report struct {
+		count   int64
+		samples []string
+	}
+)
+
+// copy 将源表数据写入目标分片暂存表，并推进消息ID分配表
+func (r *resharder) copy(ctx context.Context) error {
+	sources := r.sources()
+	for i := 0; i < r.to; i++ {
+		if err := createTable(ctx, sources[0], r.staging(i)); err != nil {
+			return err
+		}
+	}
+
+	var maxId uint64
+	for _, src := range sources {
+		var copied int
+		err := r.scan(ctx, src, func(rows []*model.ImMessage) error {
+			for i, list := range r.groupByTarget(rows) {
+				if err := upsert(ctx, r.staging(i), list); err != nil {
+					return fmt.Errorf("写入 %s 失败: %w", r.staging(i).name, err)
+				}
+			}
+			copied += len(rows)
+			maxId = max(maxId, rows[len(rows)-1].Id)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("[copy] %s -> %d 个分片: %d 条\n", src.name, r.to, copied)
+	}
+
+	// 分片存储的消息ID由分配表逐条递增分配，必须大于已有的所有消息ID
+	_, err := r.primary.ExecCtx(ctx, "insert into `im_message_id_alloc` (`biz`, `max_id`) values ('im_message', ?) "+
+		"on duplicate key update `max_id` = greatest(`max_id`, values(`max_id`))", maxId)
+	if err != nil {
+		return fmt.Errorf("推进消息ID分配表失败: %w", err)
+	}
+
+	fmt.Printf("[copy] 完成，已分配的消息ID不小于 %d，请执行 verify 校验\n", maxId)
+	return nil
+}
+
+// verify 双向校验源表与目标分片暂存表
+func (r *resharder) verify(ctx context.Context) error {
+	var (
+		sources                               = r.sources()
+		sourceTotal, targetTotal              int64
+		missing, mismatched, misrouted, extra report
+	)
+
+	// 源表 -> 暂存表：每条消息都存在于会话所在的分片且内容一致
+	for _, src := range sources {
+		err := r.scan(ctx, src, func(rows []*model.ImMessage) error {
+			sourceTotal += int64(len(rows))
+			for i, list := range r.groupByTarget(rows) {
+				dst := r.staging(i)
+				found, err := findByIds(ctx, dst, messageIds(list))
+				if err != nil {
+					return fmt.Errorf("查询 %s 失败: %w", dst.name, err)
+				}
+				for _, row := range list {
+					got, ok := found[row.Id]
+					switch {
+					case !ok:
+						missing.add(fmt.Sprintf("%s#%d", src.name, row.Id))
+					case !sameRow(row, got):
+						mismatched.add(fmt.Sprintf("%s#%d", dst.name, row.Id))
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// 暂存表 -> 源表：没有路由错误的行，也没有源表中不存在的行
+	for i := 0; i < r.to; i++ {
+		dst := r.staging(i)
+		err := r.scan(ctx, dst, func(rows []*model.ImMessage) error {
+			targetTotal += int64(len(rows))
+			bySource := make(map[int][]uint64)
+			for _, row := range rows {
+				key := model.ConversationKey(row)
+				if model.ShardIndex(key, r.to) != i {
+					misrouted.add(fmt.Sprintf("%s#%d", dst.name, row.Id))
+				}
+				si := r.sourceIndex(key)
+				bySource[si] = append(bySource[si], row.Id)
+			}
+			for si, ids := range bySource {
+				found, err := findByIds(ctx, sources[si], ids)
+				if err != nil {
+					return fmt.Errorf("查询 %s 失败: %w", sources[si].name, err)
+				}
+				for _, id := range ids {
+					if _, ok := found[id]; !ok {
+						extra.add(fmt.Sprintf("%s#%d", dst.name, id))
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("[verify] 源表 %d 条，目标分片 %d 条\n", sourceTotal, targetTotal)
+	missing.print("目标分片缺失")
+	mismatched.print("内容不一致")
+	misrouted.print("分片路由错误")
+	extra.print("源表中不存在")
+
+	if missing.count+mismatched.count+misrouted.count+extra.count > 0 {
+		return fmt.Errorf("数据不一致，请重新执行 copy 后再校验")
+	}
+	fmt.Println("[verify] 数据一致，可以执行 swap")
+	return nil
+}
+
+// swap 暂存表替换为正式分片表，同一数据库中的分片在一条 RENAME TABLE 语句中原子替换
+func (r *resharder) swap(ctx context.Context) error {
+	byConn := make(map[int][]int)
+	for i := 0; i < r.to; i++ {
+		ci := i % len(r.targetConns)
+		byConn[ci] = append(byConn[ci], i)
+	}
+
+	for ci, shards := range byConn {
+		conn := r.targetConns[ci]
+		var renames []string
+		for _, i := range shards {
+			name := model.ShardTableName(i)
+			if ok, err := tableExists(ctx, conn, name+stagingSuffix); err != nil {
+				return err
+			} else if !ok {
+				return fmt.Errorf("暂存表 %s 不存在，请先执行 copy", name+stagingSuffix)
+			}
+			if ok, err := tableExists(ctx, conn, name+oldSuffix); err != nil {
+				return err
+			} else if ok {
+				return fmt.Errorf("%s 已存在，请确认上一次迁移的数据不再需要后手动删除", name+oldSuffix)
+			}
+
+			if ok, err := tableExists(ctx, conn, name); err != nil {
+				return err
+			} else if ok {
+				renames = append(renames, fmt.Sprintf("`%s` to `%s`", name, name+oldSuffix))
+			}
+			renames = append(renames, fmt.Sprintf("`%s` to `%s`", name+stagingSuffix, name))
+		}
+
+		if _, err := conn.ExecCtx(ctx, "rename table "+strings.Join(renames, ", ")); err != nil {
+			return fmt.Errorf("替换分片表失败: %w", err)
+		}
+		fmt.Printf("[swap] rename table %s\n", strings.Join(renames, ", "))
+	}
+
+	fmt.Printf("[swap] 完成，请将 Sharding.Count 修改为 %d 后重启 message-rpc；原分片表保留为 *%s\n", r.to, oldSuffix)
+	return nil
+}
+
+// sources 源表：单表或全部源分片
+func (r *resharder) sources() []table {
+	if r.from == 0 {
+		return []table{{conn: r.sourceConns[0], name: "im_message"}}
+	}
+
+	tables := make([]table, r.from)
+	for i := range tables {
+		tables[i] = table{conn: r.sourceConns[i%len(r.sourceConns)], name: model.ShardTableName(i)}
+	}
+	return tables
+}
+
+// sourceIndex 会话在源表中的位置
+func (r *resharder) sourceIndex(key string) int {
+	if r.from == 0 {
+		return 0
+	}
+	return model.ShardIndex(key, r.from)
+}
+
+// staging 目标分片的暂存表
+func (r *resharder) staging(i int) table {
+	return table{conn: r.targetConns[i%len(r.targetConns)], name: model.ShardTableName(i) + stagingSuffix}
+}
+
+// groupByTarget 按目标分片分组
+func (r *resharder) groupByTarget(rows []*model.ImMessage) map[int][]*model.ImMessage {
+	groups := make(map[int][]*model.ImMessage)
+	for _, row := range rows {
+		i := model.ShardIndex(model.ConversationKey(row), r.to)
+		groups[i] = append(groups[i], row)
+	}
+	return groups
+}
+
+// scan 按消息ID正序分批读取
+func (r *resharder) scan(ctx context.Context, t table, fn func(rows []*model.ImMessage) error) error {
+	lastId := r.fromId
+	for {
+		var rows []*model.ImMessage
+		query := fmt.Sprintf("select %s from `%s` where `id` > ? order by `id` asc limit ?", messageRows, t.name)
+		if err := t.conn.QueryRowsCtx(ctx, &rows, query, lastId, r.batch); err != nil {
+			return fmt.Errorf("读取 %s 失败: %w", t.name, err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := fn(rows); err != nil {
+			return err
+		}
+		if int64(len(rows)) < r.batch {
+			return nil
+		}
+		lastId = rows[len(rows)-1].Id
+	}
+}
+
+// createTable 按源表结构创建目标表（源表与目标表可能不在同一个数据库）
+func createTable(ctx context.Context, src, dst table) error {
+	db, err := src.conn.RawDB()
+	if err != nil {
+		return err
+	}
+
+	var name, ddl string
+	if err := db.QueryRowContext(ctx, fmt.Sprintf("show create table `%s`", src.name)).Scan(&name, &ddl); err != nil {
+		return fmt.Errorf("读取 %s 表结构失败: %w", src.name, err)
+	}
+
+	prefix := fmt.Sprintf("CREATE TABLE `%s`", src.name)
+	if !strings.HasPrefix(ddl, prefix) {
+		return fmt.Errorf("无法解析 %s 表结构", src.name)
+	}
+	ddl = fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s`", dst.name) + strings.TrimPrefix(ddl, prefix)
+	if _, err := dst.conn.ExecCtx(ctx, ddl); err != nil {
+		return fmt.Errorf("创建 %s 失败: %w", dst.name, err)
+	}
+	return nil
+}
+
+// upsert 写入目标表，已存在的行以源数据覆盖（重复执行 copy 可以同步源表的更新）
+func upsert(ctx context.Context, t table, rows []*model.ImMessage) error {
+	var updates []string
+	for _, field := range messageFields {
+		if field != "`id`" {
+			updates = append(updates, fmt.Sprintf("%s = values(%s)", field, field))
+		}
+	}
+	placeholder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(messageFields)), ",") + ")"
+
+	for start := 0; start < len(rows); start += upsertChunk {
+		chunk := rows[start:min(start+upsertChunk, len(rows))]
+		values := make([]string, 0, len(chunk))
+		args := make([]any, 0, len(chunk)*len(messageFields))
+		for _, row := range chunk {
+			values = append(values, placeholder)
+			args = append(args, fieldValues(row)...)
+		}
+
+		query := fmt.Sprintf("insert into `%s` (%s) values %s on duplicate key update %s",
+			t.name, messageRows, strings.Join(values, ","), strings.Join(updates, ","))
+		if _, err := t.conn.ExecCtx(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findByIds 按消息ID批量查询
+func findByIds(ctx context.Context, t table, ids []uint64) (map[uint64]*model.ImMessage, error) {
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	var rows []*model.ImMessage
+	query := fmt.Sprintf("select %s from `%s` where `id` in (%s)", messageRows, t.name, strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+	if err := t.conn.QueryRowsCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	found := make(map[uint64]*model.ImMessage, len(rows))
+	for _, row := range rows {
+		found[row.Id] = row
+	}
+	return found, nil
+}
+
+// tableExists 表是否存在于当前数据库
+func tableExists(ctx context.Context, conn sqlx.SqlConn, name string) (bool, error) {
+	var count int64
+	err := conn.QueryRowCtx(ctx, &count, "select count(*) from information_schema.tables where `table_schema` = database() and `table_name` = ?", name)
+	return count > 0, err
+}
+
+func messageIds(rows []*model.ImMessage) []uint64 {
+	ids := make([]uint64, len(rows))
+	for i, row := range rows {
+		ids[i] = row.Id
+	}
+	return ids
+}
+
+// fieldValues 按字段顺序（与 messageFields 一致）取出各列的值
+func fieldValues(row *model.ImMessage) []any {
+	v := reflect.ValueOf(row).Elem()
+	values := make([]any, v.NumField())
+	for i := range values {
+		values[i] = v.Field(i).Interface()
+	}
+	return values
+}
+
+// sameRow 逐列比较，时间按时刻比较（源库与目标库的时区设置可能不同）
+func sameRow(a, b *model.ImMessage) bool {
+	va, vb := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	for i := 0; i < va.NumField(); i++ {
+		x, y := va.Field(i).Interface(), vb.Field(i).Interface()
+		switch xv := x.(type) {
+		case time.Time:
+			if !xv.Equal(y.(time.Time)) {
+				return false
+			}
+		case sql.NullTime:
+			yv := y.(sql.NullTime)
+			if xv.Valid != yv.Valid || (xv.Valid && !xv.Time.Equal(yv.Time)) {
+				return false
+			}
+		default:
+			if x != y {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (r *report) add(sample string) {
+	r.count++
+	if len(r.samples) < sampleLimit {
+		r.samples = append(r.samples, sample)
+	}
+}
+
+func (r *report) print(title string) {
+	if r.count == 0 {
+		return
+	}
+	fmt.Printf("[verify] %s: %d 条，例如 %s\n", title, r.count, strings.Join(r.samples, ", "))
+}
@@ -18,30 +18,59 @@ package conn
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"SkyeIM/app/group/rpc/group"
 	"SkyeIM/app/message/rpc/message"
+	"SkyeIM/common/rpcerr"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
 )
 
 func (c *Client) sendAck(msgId string, status string, reason string, timestamp int64) {
+	c.sendAckMessage(&AckMessage{
+		MsgId:     msgId,
+		Status:    status,
+		Reason:    reason,
+		Timestamp: timestamp,
+	})
+}
+
+// sendSentAck 发送已保存 ACK，带上消息数据库ID和会话内Seq，客户端据此更新本地消息
+func (c *Client) sendSentAck(msgId string, id int64, seq uint64, timestamp int64) {
+	c.sendAckMessage(&AckMessage{
+		MsgId:     msgId,
+		Id:        id,
+		Seq:       seq,
+		Status:    "sent",
+		Timestamp: timestamp,
+	})
+}
+
+// sendFailedAck 发送失败 ACK，muteUntil 为禁言截止时间（仅 reason 为 muted 的限时禁言）
+func (c *Client) sendFailedAck(msgId string, reason string, muteUntil int64) {
+	c.sendAckMessage(&AckMessage{
+		MsgId:     msgId,
+		Status:    "failed",
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+		MuteUntil: muteUntil,
+	})
+}
+
+func (c *Client) sendAckMessage(ack *AckMessage) {
 	ackMsg := &Message{
 		Type: "ack",
-		Data: mustMarshal(&AckMessage{
-			MsgId:     msgId,
-			Status:    status,
-			Reason:    reason,
-			Timestamp: timestamp,
-		}),
+		Data: mustMarshal(ack),
 	}
 
 	select {
 	case c.send <- ackMsg:
 	default:
-		logx.Errorf("[Client] Failed to send ACK (%s) to user %d: send buffer full", status, c.UserId)
+		logx.Errorf("[Client] Failed to send ACK (%s) to user %d: send buffer full", ack.Status, c.UserId)
 	}
 }
 
@@ -90,20 +119,34 @@ func (c *Client) handleChatMessage(data json.RawMessage) {
 		ToUserId:    chatMsg.ToUserId,
 		Content:     chatMsg.Content,
 		ContentType: chatMsg.ContentType,
+		ExpireTtl:   chatMsg.ExpireTtl,
+		ExpireMode:  chatMsg.ExpireMode,
 	})
 
 	if err != nil {
 		logx.Errorf("[Client] User %d send message failed: %v", c.UserId, err)
-		c.sendAck(chatMsg.MsgId, "failed", "rpc_error", time.Now().Unix())
-		c.sendError(chatMsg.MsgId, "发送失败")
+		reason, errText := rpcFailure(err)
+		c.sendAck(chatMsg.MsgId, "failed", reason, time.Now().Unix())
+		c.sendError(chatMsg.MsgId, errText)
 		return
 	}
 
-	// 更新时间戳
+	// 更新时间戳和Seq，推送实际保存的内容（敏感词可能已被替换）
+	if resp.Content != "" {
+		chatMsg.Content = resp.Content
+	}
 	chatMsg.CreatedAt = resp.CreatedAt
+	chatMsg.Seq = resp.Seq
+	chatMsg.ExpireAt = resp.ExpireAt
 
 	// 发送 ACK 给发送者
-	c.sendAck(chatMsg.MsgId, "sent", "", resp.CreatedAt)
+	c.sendSentAck(chatMsg.MsgId, resp.Id, resp.Seq, resp.CreatedAt)
+
+	// 重复发送（客户端丢失ACK后重试）：原消息已投递过，不再重复推送
+	if resp.Duplicate {
+		logx.Infof("[Client] User %d resent message %s, acked with original", c.UserId, chatMsg.MsgId)
+		return
+	}
 
 	// 构造发送给接收者的消息
 	receiverMsg := &Message{
@@ -164,14 +207,6 @@ func (c *Client) handleGroupChatMessage(data json.RawMessage) {
 		return
 	}
 
-	// 检查是否被禁言
-	if checkResp.Member.Mute == 1 {
-		logx.Errorf("[Client] User %d is muted in group %s", c.UserId, groupMsg.GroupId)
-		c.sendAck(groupMsg.MsgId, "failed", "muted", time.Now().Unix())
-		c.sendError(groupMsg.MsgId, "您已被禁言")
-		return
-	}
-
 	// 存储群聊消息到数据库
 	resp, err := c.svcCtx.MessageRpc.SendGroupMessage(ctx, &message.SendGroupMessageReq{
 		MsgId:       groupMsg.MsgId,
@@ -180,21 +215,35 @@ func (c *Client) handleGroupChatMessage(data json.RawMessage) {
 		Content:     groupMsg.Content,
 		ContentType: groupMsg.ContentType,
 		AtUserIds:   groupMsg.AtUserIds,
+		ExpireTtl:   groupMsg.ExpireTtl,
 	})
 
 	if err != nil {
 		logx.Errorf("[Client] User %d send group message failed: %v", c.UserId, err)
-		c.sendAck(groupMsg.MsgId, "failed", "rpc_error", time.Now().Unix())
-		c.sendError(groupMsg.MsgId, "发送失败")
+		reason, errText := rpcFailure(err)
+		// 禁言由 Message RPC 校验，reason（muted/group_muted）和限时禁言的截止时间随错误返回
+		muteUntil, _ := strconv.ParseInt(rpcerr.Metadata(err)["muteUntil"], 10, 64)
+		c.sendFailedAck(groupMsg.MsgId, reason, muteUntil)
+		c.sendError(groupMsg.MsgId, errText)
 		return
 	}
 
-	// 更新时间戳和Seq
+	// 更新时间戳和Seq，推送实际保存的内容（敏感词可能已被替换）
+	if resp.Content != "" {
+		groupMsg.Content = resp.Content
+	}
 	groupMsg.CreatedAt = resp.CreatedAt
 	groupMsg.Seq = resp.Seq
+	groupMsg.ExpireAt = resp.ExpireAt
 
 	// 发送 ACK 给发送者
-	c.sendAck(groupMsg.MsgId, "sent", "", resp.CreatedAt)
+	c.sendSentAck(groupMsg.MsgId, resp.Id, resp.Seq, resp.CreatedAt)
+
+	// 重复发送（客户端丢失ACK后重试）：原消息已推送过，不再重复推送
+	if resp.Duplicate {
+		logx.Infof("[Client] User %d resent group message %s, acked with original", c.UserId, groupMsg.MsgId)
+		return
+	}
 
 	// 构造发送给群成员的消息
 	groupReceiverMsg := &Message{
@@ -252,6 +301,16 @@ func (c *Client) handleReadMessage(data json.RawMessage) {
 	})
 }
 
+// rpcFailure 将 RPC 错误转换为 ACK 失败原因和错误提示
+// 业务拒绝（如 blocked、not_friend）携带 reason，其余错误统一为 rpc_error
+func rpcFailure(err error) (reason string, errText string) {
+	reason = rpcerr.Reason(err)
+	if reason == "" {
+		return "rpc_error", "发送失败"
+	}
+	return reason, status.Convert(err).Message()
+}
+
 // mustMarshal JSON序列化，忽略错误
 func mustMarshal(v interface{}) json.RawMessage {
 	data, _ := json.Marshal(v)
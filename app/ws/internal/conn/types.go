@@ -16,6 +16,10 @@ type ChatMessage struct {
 	Content     string `json:"content"`
 	ContentType int32  `json:"contentType"`
 	CreatedAt   int64  `json:"createdAt,omitempty"`
+	Seq         uint64 `json:"seq,omitempty"`        // 会话内Seq（用于私聊增量同步）
+	ExpireTtl   int32  `json:"expireTtl,omitempty"`  // 阅后即焚时长(秒)
+	ExpireMode  int32  `json:"expireMode,omitempty"` // 1-发送后计时 2-阅读后计时
+	ExpireAt    int64  `json:"expireAt,omitempty"`   // 过期时间戳（发送后计时）
 }
 
 // GroupChatMessage 群聊消息数据
@@ -29,14 +33,19 @@ type GroupChatMessage struct {
 	Seq         uint64  `json:"seq,omitempty"`
 	AtUserIds   []int64 `json:"atUserIds,omitempty"` // 被@的用户ID列表，-1表示@全体
 	IsAtMe      bool    `json:"isAtMe,omitempty"`    // 是否@了当前用户
+	ExpireTtl   int32   `json:"expireTtl,omitempty"` // 阅后即焚时长(秒)，群聊仅支持发送后计时
+	ExpireAt    int64   `json:"expireAt,omitempty"`  // 过期时间戳
 }
 
 // AckMessage 确认消息
 type AckMessage struct {
 	MsgId     string `json:"msgId"`
-	Status    string `json:"status"` // sent, delivered, read, failed
+	Id        int64  `json:"id,omitempty"`  // 消息数据库ID（sent）
+	Seq       uint64 `json:"seq,omitempty"` // 会话内Seq（sent）
+	Status    string `json:"status"`        // sent, delivered, read, failed
 	Reason    string `json:"reason,omitempty"`
 	Timestamp int64  `json:"timestamp"`
+	MuteUntil int64  `json:"muteUntil,omitempty"` // 禁言截止时间戳（reason 为 muted 的限时禁言）
 }
 
 // GroupMessage 群组消息通道数据
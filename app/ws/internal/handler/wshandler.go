@@ -291,11 +291,12 @@ func (h *WsHandler) pushOfflineGroupMessages(client *conn.Client) {
 
 	for _, memberInfo := range groupResp.List {
 		// 跳过被禁言的用户？不需要，禁言也能看历史消息
-		// 获取大于 ReadSeq 的消息
+		// 获取大于 ReadSeq 的消息，每个群最多推送一页，其余由客户端通过 /group/sync 按 Seq 拉取
 		msgResp, err := h.svcCtx.MessageRpc.GetGroupMessagesBySeq(ctx, &message.GetGroupMessagesBySeqReq{
 			UserId:  client.UserId,
 			GroupId: memberInfo.GroupId,
 			Seq:     memberInfo.ReadSeq,
+			Limit:   200,
 		})
 
 		if err != nil {
@@ -0,0 +1,118 @@
+package groupperm
+
+// groupperm.go - 群组权限矩阵（公共）
+//
+// 每个群为管理员和普通成员分别保存一个权限位掩码（im_group.admin_permissions / member_permissions），
+// 由群主配置；群主始终拥有全部权限。解散群组、转让群主、设置管理员和修改权限配置只有群主可以操作，
+// 不在权限矩阵中。group-rpc 通过 CheckMembership 返回用户的有效权限，其他服务按位判断即可。
+
+import "fmt"
+
+// 群成员角色
+const (
+	RoleOwner  = 1 // 群主
+	RoleAdmin  = 2 // 管理员
+	RoleMember = 3 // 普通成员
+)
+
+// 权限位
+const (
+	Invite      int64 = 1 << iota // 邀请成员（邀请、直接拉人、邀请链接）
+	Kick                          // 移出成员
+	Mute                          // 禁言成员、全员禁言
+	EditInfo                      // 修改群信息和入群设置
+	AtAll                         // @全体成员
+	Pin                           // 置顶消息
+	Announce                      // 发布和管理群公告
+	ApproveJoin                   // 审批入群申请、创建免审核邀请链接
+	Moderate                      // 管理群敏感词、处理本群送审消息
+	Retention                     // 设置群消息保留期限
+)
+
+const (
+	// All 全部权限
+	All = Invite | Kick | Mute | EditInfo | AtAll | Pin | Announce | ApproveJoin | Moderate | Retention
+
+	// DefaultAdmin 管理员默认权限（设置消息保留期限默认只有群主可以操作）
+	DefaultAdmin = All &^ Retention
+
+	// DefaultMember 普通成员默认权限
+	DefaultMember = Invite
+)
+
+// permissionNames 权限位与 API 中使用的名称、展示名称，按权限位顺序
+var permissionNames = []struct {
+	perm  int64
+	name  string
+	label string
+}{
+	{Invite, "invite", "邀请成员"},
+	{Kick, "kick", "移出成员"},
+	{Mute, "mute", "禁言成员"},
+	{EditInfo, "editInfo", "修改群信息"},
+	{AtAll, "atAll", "@全体成员"},
+	{Pin, "pin", "置顶消息"},
+	{Announce, "announce", "管理群公告"},
+	{ApproveJoin, "approveJoin", "审批入群申请"},
+	{Moderate, "moderate", "管理敏感词和送审消息"},
+	{Retention, "retention", "设置消息保留期限"},
+}
+
+// Effective 计算角色的有效权限，群主拥有全部权限
+func Effective(role, adminPermissions, memberPermissions int64) int64 {
+	switch role {
+	case RoleOwner:
+		return All
+	case RoleAdmin:
+		return adminPermissions & All
+	case RoleMember:
+		return memberPermissions & All
+	default:
+		return 0
+	}
+}
+
+// Has 判断权限掩码是否包含 perm 的全部权限位
+func Has(permissions, perm int64) bool {
+	return permissions&perm == perm
+}
+
+// Label 权限的展示名称（用于错误提示）
+func Label(perm int64) string {
+	for _, p := range permissionNames {
+		if p.perm == perm {
+			return p.label
+		}
+	}
+	return "该操作"
+}
+
+// Names 权限掩码转换为名称列表
+func Names(permissions int64) []string {
+	names := make([]string, 0, len(permissionNames))
+	for _, p := range permissionNames {
+		if permissions&p.perm != 0 {
+			names = append(names, p.name)
+		}
+	}
+	return names
+}
+
+// Parse 名称列表转换为权限掩码，包含未知名称时返回错误
+func Parse(names []string) (int64, error) {
+	var permissions int64
+	for _, name := range names {
+		found := false
+		for _, p := range permissionNames {
+			if p.name == name {
+				permissions |= p.perm
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("未知的权限: %s", name)
+		}
+	}
+	return permissions, nil
+}
@@ -0,0 +1,59 @@
+package rpcerr
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain 错误所属域（写入 ErrorInfo.Domain）
+const Domain = "skyeim"
+
+// New 创建带业务原因的 gRPC 错误
+// reason 为机器可读的原因（如 not_friend、blocked），调用方可据此区分错误，msg 为展示给用户的文案
+func New(code codes.Code, reason, msg string) error {
+	return NewWithMetadata(code, reason, msg, nil)
+}
+
+// NewWithMetadata 创建带业务原因和附加信息的 gRPC 错误（如禁言截止时间）
+func NewWithMetadata(code codes.Code, reason, msg string, metadata map[string]string) error {
+	st := status.New(code, msg)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// Reason 从 gRPC 错误中提取业务原因，没有时返回空字符串
+func Reason(err error) string {
+	if info := errorInfo(err); info != nil {
+		return info.Reason
+	}
+	return ""
+}
+
+// Metadata 从 gRPC 错误中提取附加信息，没有时返回 nil
+func Metadata(err error) map[string]string {
+	if info := errorInfo(err); info != nil {
+		return info.Metadata
+	}
+	return nil
+}
+
+func errorInfo(err error) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package unread
+
+// unread.go - 会话未读计数（公共）
+//
+// 每个用户一个 Redis Hash（im:unread:{userId}），字段：
+//   p:{peerId}   私聊未读数
+//   g:{groupId}  群聊未读数
+//   a:{groupId}  群聊中@我（含@全体）的未读数
+//   p            私聊计数已从数据库完整重建的标记，存在时没有 p:{peerId} 字段的私聊未读数为 0
+//
+// 字段不存在表示计数未知，由 message-rpc 查询时从 MySQL 重建（只写入不存在的字段）。
+// 发送消息时只对已知的计数累加，避免计数未知时从 0 开始累加得到错误的值。
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// keyExpire 计数 Hash 的过期时间（秒），查询时刷新，长期不活跃的用户重新从数据库重建
+	keyExpire = 7 * 24 * 3600
+
+	// PrivateReadyField 私聊计数已完整重建的标记字段
+	PrivateReadyField = "p"
+)
+
+// incrScript 守卫字段或计数字段存在时才累加
+// KEYS[1] 计数 Hash; ARGV[1] 守卫字段; ARGV[2] 计数字段; ARGV[3] 增量
+var incrScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 1 or redis.call('HEXISTS', KEYS[1], ARGV[2]) == 1 then
+	return redis.call('HINCRBY', KEYS[1], ARGV[2], ARGV[3])
+end
+return 0
+`)
+
+// setScript 计数 Hash 存在时才覆盖字段，不创建没有过期时间的 Hash
+// KEYS[1] 计数 Hash; ARGV[1] 计数字段; ARGV[2] 计数
+var setScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+
+// Counter 未读计数
+type Counter struct {
+	rds *redis.Redis
+}
+
+func NewCounter(rds *redis.Redis) *Counter {
+	return &Counter{rds: rds}
+}
+
+// Key 用户的计数 Hash
+func Key(userId int64) string {
+	return fmt.Sprintf("im:unread:%d", userId)
+}
+
+// PrivateField 私聊未读数字段
+func PrivateField(peerId int64) string {
+	return fmt.Sprintf("p:%d", peerId)
+}
+
+// GroupField 群聊未读数字段
+func GroupField(groupId string) string {
+	return "g:" + groupId
+}
+
+// AtField 群聊@我的未读数字段
+func AtField(groupId string) string {
+	return "a:" + groupId
+}
+
+// IncrPrivate 收到一条私聊消息
+func (c *Counter) IncrPrivate(ctx context.Context, userId, peerId int64) error {
+	_, err := c.rds.ScriptRunCtx(ctx, incrScript, []string{Key(userId)}, PrivateReadyField, PrivateField(peerId), 1)
+	return err
+}
+
+// IncrGroup 群里收到一条消息，userIds 为除发送者外的群成员，atUserIds 为被@的成员
+// @我的计数与群未读数一起重建，以群未读数字段作为守卫
+func (c *Counter) IncrGroup(ctx context.Context, groupId string, userIds, atUserIds []int64) error {
+	groupField, atField := GroupField(groupId), AtField(groupId)
+	return c.rds.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		for _, uid := range userIds {
+			incrScript.Eval(ctx, pipe, []string{Key(uid)}, groupField, groupField, 1)
+		}
+		for _, uid := range atUserIds {
+			incrScript.Eval(ctx, pipe, []string{Key(uid)}, groupField, atField, 1)
+		}
+		return nil
+	})
+}
+
+// SetPrivate 覆盖私聊未读数（标记已读后按数据库的准确值回写）
+// 计数 Hash 不存在时不写入，下次查询时整体重建
+func (c *Counter) SetPrivate(ctx context.Context, userId, peerId, count int64) error {
+	_, err := c.rds.ScriptRunCtx(ctx, setScript, []string{Key(userId)}, PrivateField(peerId), strconv.FormatInt(count, 10))
+	return err
+}
+
+// ResetGroup 群已读进度变化后清除群计数，下次查询时按新的已读 Seq 重建
+func (c *Counter) ResetGroup(ctx context.Context, userId int64, groupId string) error {
+	_, err := c.rds.HdelCtx(ctx, Key(userId), GroupField(groupId), AtField(groupId))
+	return err
+}
+
+// Load 读取用户的全部计数字段并刷新过期时间
+func (c *Counter) Load(ctx context.Context, userId int64) (map[string]string, error) {
+	key := Key(userId)
+	fields, err := c.rds.HgetallCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) > 0 {
+		if err := c.rds.ExpireCtx(ctx, key, keyExpire); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+// Fill 写入从数据库重建的计数，已存在的字段不覆盖（重建期间可能已有新消息累加）
+func (c *Counter) Fill(ctx context.Context, userId int64, counts map[string]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	key := Key(userId)
+	return c.rds.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		for field, n := range counts {
+			pipe.HSetNX(ctx, key, field, n)
+		}
+		pipe.Expire(ctx, key, keyExpire*time.Second)
+		return nil
+	})
+}
+
+// Remove 删除不再需要的字段（如已退出的群）
+func (c *Counter) Remove(ctx context.Context, userId int64, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	_, err := c.rds.HdelCtx(ctx, Key(userId), fields...)
+	return err
+}
@@ -88,6 +88,13 @@ CREATE TABLE `im_group` (
   `member_count` int DEFAULT 0 COMMENT '当前成员数',
   `status` tinyint DEFAULT 1 COMMENT '状态: 1-正常 2-已解散',
   `invite_confirm_mode` tinyint DEFAULT 1 COMMENT '邀请确认模式: 0-直接加入 1-需要确认（默认）',
+  `mute_all` tinyint NOT NULL DEFAULT 0 COMMENT '全员禁言: 0-否 1-是（仅群主和管理员可以发言）',
+  `join_policy` tinyint NOT NULL DEFAULT 2 COMMENT '入群方式: 1-自由加入 2-需要审核（默认） 3-回答问题 4-仅限邀请 5-禁止加入',
+  `join_question` varchar(200) NOT NULL DEFAULT '' COMMENT '入群问题（join_policy=3时有效）',
+  `join_answer` varchar(200) NOT NULL DEFAULT '' COMMENT '入群问题答案: 为空时人工审核回答，否则自动校验',
+  `discoverable` tinyint NOT NULL DEFAULT 1 COMMENT '是否可被搜索: 0-否 1-是',
+  `admin_permissions` int NOT NULL DEFAULT 511 COMMENT '管理员权限位掩码: 1-邀请成员 2-移出成员 4-禁言 8-修改群信息 16-@全体成员 32-置顶消息 64-群公告 128-审批入群 256-内容审核 512-消息保留期限',
+  `member_permissions` int NOT NULL DEFAULT 1 COMMENT '普通成员权限位掩码（同管理员），群主始终拥有全部权限',
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
@@ -104,6 +111,7 @@ CREATE TABLE `im_group_member` (
   `role` tinyint DEFAULT 3 COMMENT '角色: 1-群主 2-管理员 3-普通成员',
   `nickname` varchar(50) DEFAULT NULL COMMENT '群昵称',
   `mute` tinyint DEFAULT 0 COMMENT '是否禁言: 0-否 1-是',
+  `mute_until` bigint NOT NULL DEFAULT 0 COMMENT '禁言截止时间戳(秒): 0-永久禁言（mute=1时有效），到期后自动解除',
   `read_seq` BIGINT UNSIGNED DEFAULT 0 COMMENT '已读消息Seq',
   `joined_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
@@ -135,6 +143,7 @@ CREATE TABLE `im_group_join_request` (
   `group_id` varchar(64) NOT NULL COMMENT '群组ID',
   `user_id` bigint unsigned NOT NULL COMMENT '申请人ID',
   `message` varchar(200) DEFAULT '' COMMENT '申请理由',
+  `answer` varchar(200) NOT NULL DEFAULT '' COMMENT '入群问题回答（回答问题入群且需人工审核时）',
   `status` tinyint NOT NULL DEFAULT 0 COMMENT '0-待处理 1-已同意 2-已拒绝',
   `handler_id` bigint unsigned DEFAULT NULL COMMENT '处理人ID（群主/管理员）',
   `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -145,6 +154,70 @@ CREATE TABLE `im_group_join_request` (
   UNIQUE KEY `uk_group_user_pending` (`group_id`, `user_id`, `status`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='入群申请表';
 
+-- 群公告表
+DROP TABLE IF EXISTS `im_group_announcement`;
+CREATE TABLE `im_group_announcement` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `group_id` varchar(64) NOT NULL COMMENT '群组ID',
+  `author_id` bigint unsigned NOT NULL COMMENT '发布人ID',
+  `editor_id` bigint unsigned NOT NULL COMMENT '最后编辑人ID',
+  `content` text NOT NULL COMMENT '公告内容（富文本，Markdown）',
+  `require_confirm` tinyint NOT NULL DEFAULT 0 COMMENT '是否需要成员确认: 0-否 1-是',
+  `pinned` tinyint NOT NULL DEFAULT 0 COMMENT '是否为当前置顶公告（每个群最多一条）',
+  `version` int NOT NULL DEFAULT 1 COMMENT '版本号，每次编辑加1',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '状态: 1-正常 2-已删除',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_group_status` (`group_id`, `status`, `pinned`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告表';
+
+-- 群公告编辑历史表
+DROP TABLE IF EXISTS `im_group_announcement_history`;
+CREATE TABLE `im_group_announcement_history` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `announcement_id` bigint unsigned NOT NULL COMMENT '公告ID',
+  `version` int NOT NULL COMMENT '版本号',
+  `editor_id` bigint unsigned NOT NULL COMMENT '编辑人ID',
+  `content` text NOT NULL COMMENT '该版本的公告内容',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_announcement_version` (`announcement_id`, `version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告编辑历史表';
+
+-- 群公告确认表
+DROP TABLE IF EXISTS `im_group_announcement_confirm`;
+CREATE TABLE `im_group_announcement_confirm` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `announcement_id` bigint unsigned NOT NULL COMMENT '公告ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '确认人ID',
+  `version` int NOT NULL COMMENT '确认时的公告版本（公告编辑后需重新确认）',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最近确认时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_announcement_user` (`announcement_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告确认表';
+
+-- 群邀请链接表
+DROP TABLE IF EXISTS `im_group_invite_link`;
+CREATE TABLE `im_group_invite_link` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `code` varchar(32) NOT NULL COMMENT '邀请码（随机生成，用于分享链接和二维码）',
+  `group_id` varchar(64) NOT NULL COMMENT '群组ID',
+  `creator_id` bigint unsigned NOT NULL COMMENT '创建人ID（群主/管理员）',
+  `expire_at` datetime NOT NULL COMMENT '过期时间',
+  `max_uses` int NOT NULL DEFAULT 0 COMMENT '最大使用次数，0 表示不限',
+  `use_count` int NOT NULL DEFAULT 0 COMMENT '已使用次数',
+  `auto_approve` tinyint NOT NULL DEFAULT 0 COMMENT '通过链接直接入群: 0-否（提交入群申请） 1-是（免审核）',
+  `status` tinyint NOT NULL DEFAULT 1 COMMENT '状态: 1-有效 2-已撤销',
+  `revoked_by` bigint unsigned NOT NULL DEFAULT 0 COMMENT '撤销人ID',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_code` (`code`),
+  KEY `idx_group_creator` (`group_id`, `creator_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群邀请链接表';
+
 -- ============================================
 -- 4. 消息模块
 -- ============================================
@@ -158,11 +231,14 @@ CREATE TABLE IF NOT EXISTS `im_message` (
     `to_user_id` BIGINT UNSIGNED DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
     `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
     `group_id` VARCHAR(64) DEFAULT NULL COMMENT '群组ID(群聊时使用)',
-    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消息序列号(用于群聊消息连续性校验和拉取偏移量)',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消息序列号(群聊为群内Seq,私聊为会话内Seq,用于消息连续性校验和拉取偏移量)',
     `content` TEXT NOT NULL COMMENT '消息内容',
-    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频',
-    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '消息状态: 0-未读/未处理 1-已读 2-撤回 3-删除',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片 10-群系统消息',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '消息状态: 0-未读/未处理 1-已读 2-撤回 3-删除 4-已销毁(阅后即焚) 5-已清理(超过保留期限)',
     `at_user_ids` TEXT COMMENT '被@的用户ID列表,JSON格式,如["123","456"],@all用特殊值"-1"',
+    `expire_ttl` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '阅后即焚时长(秒),0表示不过期',
+    `expire_mode` TINYINT NOT NULL DEFAULT 0 COMMENT '过期计时方式: 0-不过期 1-发送后计时 2-阅读后计时',
+    `expire_at` DATETIME DEFAULT NULL COMMENT '过期时间(阅读后计时的消息在已读时设置)',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`id`),
@@ -173,9 +249,252 @@ CREATE TABLE IF NOT EXISTS `im_message` (
     KEY `idx_conversation` (`from_user_id`, `to_user_id`, `created_at`),
     KEY `idx_unread` (`to_user_id`, `status`, `created_at`),
     KEY `idx_group_seq` (`group_id`, `seq`),
-    KEY `idx_at_users` (`group_id`, `chat_type`) USING BTREE
+    KEY `idx_private_seq` (`from_user_id`, `to_user_id`, `seq`),
+    KEY `idx_expire_at` (`expire_at`),
+    KEY `idx_created_at` (`created_at`),
+    KEY `idx_at_users` (`group_id`, `chat_type`) USING BTREE,
+    FULLTEXT KEY `ft_content` (`content`) WITH PARSER ngram COMMENT '全文检索(ngram分词,支持中文)'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='IM 消息主表(支持私聊与群聊)';
 
+-- 定时消息表
+DROP TABLE IF EXISTS `im_scheduled_message`;
+CREATE TABLE IF NOT EXISTS `im_scheduled_message` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '投递时使用的消息唯一标识(创建时生成,保证重复投递幂等)',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `to_user_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时使用)',
+    `content` TEXT NOT NULL COMMENT '消息内容',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型(同im_message)',
+    `at_user_ids` TEXT COMMENT '被@的用户ID列表,JSON格式',
+    `send_at` DATETIME NOT NULL COMMENT '计划发送时间',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-待发送 1-已发送 2-已取消 3-发送失败 4-发送中',
+    `message_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '发送成功后对应的im_message.id',
+    `fail_reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '发送失败原因',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_msg_id` (`msg_id`),
+    KEY `idx_user_status` (`from_user_id`, `status`, `send_at`),
+    KEY `idx_status_send_at` (`status`, `send_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='定时消息表';
+
+-- 聊天记录导出任务表
+DROP TABLE IF EXISTS `im_export_job`;
+CREATE TABLE IF NOT EXISTS `im_export_job` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '发起导出的用户ID',
+    `owner_id` BIGINT UNSIGNED NOT NULL COMMENT '会话所属用户ID(私聊导出时为会话一方,一般等于user_id)',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
+    `peer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '私聊对方ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `format` VARCHAR(16) NOT NULL COMMENT '导出格式: json/html/text',
+    `start_time` DATETIME DEFAULT NULL COMMENT '起始时间(包含),为空表示不限',
+    `end_time` DATETIME DEFAULT NULL COMMENT '结束时间(不包含),为空表示不限',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-排队中 1-导出中 2-已完成 3-失败',
+    `object_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '导出文件在对象存储中的路径',
+    `file_size` BIGINT NOT NULL DEFAULT 0 COMMENT '导出文件大小(字节)',
+    `message_count` INT NOT NULL DEFAULT 0 COMMENT '导出的消息条数',
+    `fail_reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_user_created` (`user_id`, `created_at`),
+    KEY `idx_status` (`status`, `updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='聊天记录导出任务表';
+
+-- 群消息保留策略表
+DROP TABLE IF EXISTS `im_group_retention`;
+CREATE TABLE IF NOT EXISTS `im_group_retention` (
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `retention_days` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '消息保留天数,0表示永久保留',
+    `updated_by` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后修改人ID(群主)',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`group_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群消息保留策略表(未设置的群使用全局默认策略)';
+
+-- 消息ID分配表
+DROP TABLE IF EXISTS `im_message_id_alloc`;
+CREATE TABLE IF NOT EXISTS `im_message_id_alloc` (
+    `biz` VARCHAR(32) NOT NULL COMMENT '业务标识',
+    `max_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '已分配的最大ID',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`biz`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息ID分配表(分片存储时统一按顺序分配消息ID)';
+
+INSERT IGNORE INTO `im_message_id_alloc` (`biz`, `max_id`) VALUES ('im_message', 0);
+
+-- 消息收藏表
+DROP TABLE IF EXISTS `im_favorite`;
+CREATE TABLE IF NOT EXISTS `im_favorite` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '收藏者ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '来源消息唯一标识',
+    `message_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '来源消息数据库ID(用于跳转回原会话)',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '来源会话类型: 1-私聊 2-群聊',
+    `peer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '私聊对方ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '来源消息Seq',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '原消息发送者ID',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片',
+    `content` TEXT NOT NULL COMMENT '消息内容快照(原消息撤回或清理后仍保留)',
+    `tags` VARCHAR(1024) NOT NULL DEFAULT '[]' COMMENT '标签列表,JSON格式,如["工作","合同"]',
+    `note` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `msg_created_at` DATETIME NOT NULL COMMENT '原消息发送时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '收藏时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_msg` (`user_id`, `msg_id`),
+    KEY `idx_user_id` (`user_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息收藏表';
+
+-- 置顶消息表
+DROP TABLE IF EXISTS `im_pinned_message`;
+CREATE TABLE IF NOT EXISTS `im_pinned_message` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `conversation_key` VARCHAR(80) NOT NULL COMMENT '会话标识(私聊为"小ID_大ID",群聊为"g_群组ID")',
+    `chat_type` TINYINT NOT NULL DEFAULT 1 COMMENT '聊天类型: 1-私聊 2-群聊',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '被置顶消息的唯一标识',
+    `message_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '被置顶消息的数据库ID(用于跳转定位)',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '被置顶消息的Seq',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '原消息发送者ID',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型: 1-文字 2-图片 3-文件 4-语音 5-视频 6-位置 7-名片',
+    `content` TEXT NOT NULL COMMENT '消息内容快照(原消息归档后仍可展示)',
+    `msg_created_at` DATETIME NOT NULL COMMENT '原消息发送时间',
+    `pinned_by` BIGINT UNSIGNED NOT NULL COMMENT '置顶操作者ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '置顶时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_conversation_msg` (`conversation_key`, `msg_id`),
+    KEY `idx_conversation` (`conversation_key`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='置顶消息表';
+
+-- 群投票表
+DROP TABLE IF EXISTS `im_poll`;
+CREATE TABLE IF NOT EXISTS `im_poll` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '投票消息的唯一标识(im_message.msg_id)',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `creator_id` BIGINT UNSIGNED NOT NULL COMMENT '发起人ID',
+    `question` VARCHAR(255) NOT NULL COMMENT '投票主题',
+    `options` TEXT NOT NULL COMMENT '选项列表,JSON格式',
+    `multiple` TINYINT NOT NULL DEFAULT 0 COMMENT '是否多选: 0-单选 1-多选',
+    `anonymous` TINYINT NOT NULL DEFAULT 0 COMMENT '是否匿名: 0-实名 1-匿名',
+    `deadline` DATETIME DEFAULT NULL COMMENT '截止时间(NULL表示不限)',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-进行中 1-已结束',
+    `closed_at` DATETIME DEFAULT NULL COMMENT '结束时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_status_deadline` (`status`, `deadline`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群投票表';
+
+-- 群投票记录表
+DROP TABLE IF EXISTS `im_poll_vote`;
+CREATE TABLE IF NOT EXISTS `im_poll_vote` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `poll_id` BIGINT UNSIGNED NOT NULL COMMENT '投票ID(im_poll.id)',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '投票人ID',
+    `options` VARCHAR(255) NOT NULL COMMENT '选择的选项序号,JSON格式',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_poll_user` (`poll_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群投票记录表';
+
+-- 群话题回复表
+DROP TABLE IF EXISTS `im_thread_reply`;
+CREATE TABLE IF NOT EXISTS `im_thread_reply` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '回复消息唯一标识(客户端生成)',
+    `root_msg_id` VARCHAR(64) NOT NULL COMMENT '话题根消息的唯一标识(im_message.msg_id)',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `seq` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '话题内序列号(每个话题独立递增)',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `content` TEXT NOT NULL COMMENT '消息内容',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型(同im_message)',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_root_seq` (`root_msg_id`, `seq`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群话题回复表';
+
+-- 群话题关注表
+DROP TABLE IF EXISTS `im_thread_follower`;
+CREATE TABLE IF NOT EXISTS `im_thread_follower` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `root_msg_id` VARCHAR(64) NOT NULL COMMENT '话题根消息的唯一标识',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '关注者ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '关注时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_root_user` (`root_msg_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群话题关注表';
+
+-- 群自定义敏感词表
+DROP TABLE IF EXISTS `im_group_sensitive_word`;
+CREATE TABLE IF NOT EXISTS `im_group_sensitive_word` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `group_id` VARCHAR(64) NOT NULL COMMENT '群组ID',
+    `word` VARCHAR(64) NOT NULL COMMENT '敏感词',
+    `action` TINYINT NOT NULL DEFAULT 1 COMMENT '命中后的处理: 1-替换为* 2-送审 3-拦截',
+    `created_by` BIGINT UNSIGNED NOT NULL COMMENT '添加人ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_group_word` (`group_id`, `word`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='群自定义敏感词表';
+
+-- 内容审核队列表
+DROP TABLE IF EXISTS `im_moderation_review`;
+CREATE TABLE IF NOT EXISTS `im_moderation_review` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '送审消息唯一标识',
+    `root_msg_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '话题根消息唯一标识(话题回复时有效)',
+    `chat_type` TINYINT NOT NULL COMMENT '聊天类型: 1-私聊 2-群聊',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `to_user_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `content` TEXT NOT NULL COMMENT '消息内容(已替换的敏感词保持替换后的内容)',
+    `content_type` TINYINT NOT NULL DEFAULT 1 COMMENT '消息内容类型',
+    `hit_words` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '命中的敏感词,JSON格式',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '审核状态: 0-待审核 1-已通过 2-已删除',
+    `reviewer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID',
+    `reviewed_at` DATETIME DEFAULT NULL COMMENT '审核时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_group_status` (`group_id`, `status`, `id`),
+    KEY `idx_status` (`status`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='内容审核队列表';
+
+-- 消息链接预览表
+DROP TABLE IF EXISTS `im_link_preview`;
+CREATE TABLE IF NOT EXISTS `im_link_preview` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '自增主键ID',
+    `msg_id` VARCHAR(64) NOT NULL COMMENT '消息唯一标识',
+    `chat_type` TINYINT NOT NULL COMMENT '聊天类型: 1-私聊 2-群聊',
+    `from_user_id` BIGINT UNSIGNED NOT NULL COMMENT '发送者ID',
+    `to_user_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '接收者ID(私聊时有效)',
+    `group_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '群组ID(群聊时有效)',
+    `url` VARCHAR(2048) NOT NULL COMMENT '消息中的链接',
+    `title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '标题',
+    `description` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '摘要',
+    `image` VARCHAR(2048) NOT NULL DEFAULT '' COMMENT '预览图地址',
+    `site_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '站点名称',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0-待抓取 1-抓取中 2-已完成 3-失败',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_msg_id` (`msg_id`),
+    KEY `idx_status` (`status`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息链接预览表';
+
 -- ============================================
 -- 初始化完成提示
 -- ============================================